@@ -15,20 +15,31 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/pkg/log/interceptor"
+	"github.com/apigee/registry/pkg/tlsconfig"
+	"github.com/apigee/registry/pkg/tracing"
 	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/authn"
+	"github.com/apigee/registry/server/registry/authz"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"gopkg.in/yaml.v3"
 )
 
@@ -37,24 +48,74 @@ var version = "dev"
 
 const prometheusPath = "/metrics"
 
+// defaultMaxMessageBytes is the message size limit when max_message_bytes is unset.
+// It is larger than the gRPC default of 4 MiB so that projects with large
+// specs can be exported and imported.
+const defaultMaxMessageBytes = 64 << 20
+
+// maxMessageBytes returns the configured message size limit.
+func maxMessageBytes() int {
+	if config.MaxMessageBytes > 0 {
+		return config.MaxMessageBytes
+	}
+	return defaultMaxMessageBytes
+}
+
 // ServerConfig is the top-level configuration structure.
 type ServerConfig struct {
 	// Server port. If unset or zero, an open port will be assigned.
-	Port       int              `yaml:"port"`
-	Database   DatabaseConfig   `yaml:"database"`
-	Logging    LoggingConfig    `yaml:"logging"`
-	Pubsub     PubsubConfig     `yaml:"pubsub"`
-	Monitoring MonitoringConfig `yaml:"monitoring"`
+	Port int `yaml:"port"`
+	// Largest message that the server and the gateway receive and send, such as
+	// ImportProject requests and ExportProject responses with project archives.
+	// If unset or zero, 64 MiB is used.
+	MaxMessageBytes int `yaml:"max_message_bytes"`
+	// Largest contents of specs and artifacts that upload streams accept.
+	// If unset or zero, 256 MiB is used.
+	MaxContentsBytes int                  `yaml:"max_contents_bytes"`
+	TLS              TLSConfig            `yaml:"tls"`
+	Database         DatabaseConfig       `yaml:"database"`
+	Logging          LoggingConfig        `yaml:"logging"`
+	Pubsub           PubsubConfig         `yaml:"pubsub"`
+	Webhook          WebhookConfig        `yaml:"webhook"`
+	Eventlog         EventlogConfig       `yaml:"eventlog"`
+	Deletion         DeletionConfig       `yaml:"deletion"`
+	Retention        RetentionConfig      `yaml:"retention"`
+	Blobstore        BlobstoreConfig      `yaml:"blobstore"`
+	Monitoring       MonitoringConfig     `yaml:"monitoring"`
+	Gateway          GatewayConfig        `yaml:"gateway"`
+	Tracing          TracingConfig        `yaml:"tracing"`
+	Shutdown         ShutdownConfig       `yaml:"shutdown"`
+	Authentication   AuthenticationConfig `yaml:"authentication"`
+	Authorization    AuthorizationConfig  `yaml:"authorization"`
+	Quotas           QuotasConfig         `yaml:"quotas"`
+}
+
+// TLSConfig holds configuration for serving with TLS.
+type TLSConfig struct {
+	// Enable TLS. The certificate and key files are loaded again when they change.
+	// Values: [ true, false ], default: false
+	Enable bool `yaml:"enable"`
+	// File of the PEM-encoded certificate chain of the server.
+	CertFile string `yaml:"cert_file"`
+	// File of the PEM-encoded private key of the server.
+	KeyFile string `yaml:"key_file"`
+	// File of PEM-encoded certificates of the authorities that issue client
+	// certificates. If set, client certificates are verified (mutual TLS).
+	ClientCAFile string `yaml:"client_ca_file"`
+	// Reject clients without valid certificates. Requires a client_ca_file.
+	// Values: [ true, false ], default: false
+	RequireClientCert bool `yaml:"require_client_cert"`
 }
 
 // DatabaseConfig holds database configuration.
 type DatabaseConfig struct {
 	// Driver for the database connection.
-	// Values: [ sqlite3, postgres, cloudsqlpostgres ]
+	// Values: [ sqlite3, postgres, cloudsqlpostgres, mysql ]
 	Driver string `yaml:"driver"`
 	// Config for the database connection. The format is a data source name (DSN).
 	// PostgreSQL Reference: See "Connection Strings" at https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
 	// SQLite Reference: See "URI filename examples" at https://www.sqlite.org/c3ref/open.html
+	// MySQL Reference: See "DSN (Data Source Name)" at https://github.com/go-sql-driver/mysql#dsn-data-source-name
 	Config string `yaml:"config"`
 }
 
@@ -78,10 +139,80 @@ type PubsubConfig struct {
 	Project string `yaml:"project"`
 }
 
+// WebhookConfig holds webhook (notification) configuration.
+type WebhookConfig struct {
+	// Enable posting event notifications to an HTTP endpoint.
+	// Values: [ true, false ]
+	Enable bool `yaml:"enable"`
+	// URL that receives notifications as JSON POST requests.
+	URL string `yaml:"url"`
+	// Secret used to sign requests with HMAC-SHA256. If unset, requests are unsigned.
+	Secret string `yaml:"secret"`
+	// Maximum number of delivery attempts for each notification.
+	// If unset or zero, the default (5) is used.
+	MaxAttempts int `yaml:"max_attempts"`
+}
+
+// EventlogConfig holds event log (notification) configuration.
+type EventlogConfig struct {
+	// Enable appending event notifications to a local file.
+	// Values: [ true, false ]
+	Enable bool `yaml:"enable"`
+	// Path of the file. Notifications are appended one JSON object per line.
+	Path string `yaml:"path"`
+}
+
+// DeletionConfig holds configuration for deleted APIs.
+type DeletionConfig struct {
+	// Time that deleted APIs are kept before they are permanently deleted.
+	// Until then, they can be restored with UndeleteApi.
+	// If unset or zero, APIs are permanently deleted immediately.
+	// Example: "168h"
+	PurgeWindow time.Duration `yaml:"purge_window"`
+}
+
+// RetentionConfig holds configuration for pruning spec and deployment revisions.
+type RetentionConfig struct {
+	// Time between deletions of the revisions that retention policies don't keep.
+	// Policies are "retention-policy" artifacts of projects and APIs.
+	// If unset or zero, revisions are only pruned by PruneApiRevisions calls.
+	// Example: "24h"
+	PruneInterval time.Duration `yaml:"prune_interval"`
+}
+
+// BlobstoreConfig holds configuration for storing spec and artifact contents.
+type BlobstoreConfig struct {
+	// Where contents are stored. External stores keep the contents and the
+	// database keeps their hashes, sizes and locations.
+	// Values: [ database, filesystem, s3 ], default: database
+	Type string `yaml:"type"`
+	// Directory of a filesystem store.
+	Path string `yaml:"path"`
+	// Bucket of an s3 store.
+	S3 S3Config `yaml:"s3"`
+}
+
+// S3Config holds configuration for an S3-compatible bucket, such as an AWS S3 or a MinIO bucket.
+type S3Config struct {
+	// URL of the service. If unset, the AWS endpoint of the region is used.
+	// Example: "http://localhost:9000"
+	Endpoint string `yaml:"endpoint"`
+	// Region of the bucket. If unset, "us-east-1" is used.
+	Region string `yaml:"region"`
+	// Name of the bucket, which must already exist.
+	Bucket string `yaml:"bucket"`
+	// Prefix of the names of stored objects.
+	Prefix string `yaml:"prefix"`
+	// Credentials used to sign requests. If unset, requests are unsigned.
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+}
+
 type MonitoringConfig struct {
 	// Enable Monitoring
 	// Values: [ true, false ], default: false
-	// Prometheus stats available at /metrics.
+	// Prometheus stats available at /metrics, liveness and readiness probes
+	// at /healthz and /readyz.
 	Enable bool `yaml:"enable"`
 	// Listener address if enabled.
 	// If unset or zero, an open port will be assigned.
@@ -89,6 +220,131 @@ type MonitoringConfig struct {
 	Address string `yaml:"address"`
 }
 
+// GatewayConfig holds configuration for serving the REST/JSON API.
+type GatewayConfig struct {
+	// Enable the REST/JSON gateway, which serves the HTTP mapping of the API
+	// by calling the gRPC server. With TLS enabled, it serves HTTPS.
+	// Liveness and readiness probes are also available at /healthz and /readyz.
+	// Values: [ true, false ], default: false
+	Enable bool `yaml:"enable"`
+	// Listener address if enabled.
+	// If unset or zero, an open port will be assigned.
+	// Example: ":8081" or ""
+	Address string `yaml:"address"`
+}
+
+// TracingConfig holds configuration for exporting OpenTelemetry traces of calls
+// and their database operations.
+type TracingConfig struct {
+	// Exporter of spans. If unset, calls are not traced.
+	// Values: [ otlp, stdout, file ]
+	Exporter string `yaml:"exporter"`
+	// Address of the OTLP collector. If unset, OTEL_EXPORTER_OTLP_ENDPOINT
+	// or "localhost:4317" is used.
+	Endpoint string `yaml:"endpoint"`
+	// Call the OTLP collector without TLS.
+	// Values: [ true, false ], default: false
+	Insecure bool `yaml:"insecure"`
+	// File that spans are appended to by the file exporter, one JSON object per span.
+	Path string `yaml:"path"`
+	// Fraction of calls that are traced when callers haven't sampled them.
+	// If unset or zero, all calls are traced.
+	// Example: 0.1
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// AuthenticationConfig holds configuration for authenticating callers with bearer tokens.
+type AuthenticationConfig struct {
+	// Enable validation of JSON Web Tokens (JWTs), such as OpenID Connect ID tokens,
+	// that callers send as bearer tokens.
+	// Values: [ true, false ], default: false
+	Enable bool `yaml:"enable"`
+	// Allow calls without bearer tokens. Calls with invalid tokens are always rejected.
+	// Values: [ true, false ], default: false
+	AllowUnauthenticated bool `yaml:"allow_unauthenticated"`
+	// Issuers of accepted tokens.
+	Issuers []IssuerConfig `yaml:"issuers"`
+}
+
+// IssuerConfig describes an issuer of accepted tokens.
+type IssuerConfig struct {
+	// Value of the "iss" claim of the tokens.
+	// Example: "https://accounts.google.com"
+	Issuer string `yaml:"issuer"`
+	// Accepted values of the "aud" claim. If unset, any audience is accepted.
+	Audiences []string `yaml:"audiences"`
+	// Path or http(s) URL of the JSON Web Key Set (JWKS) that verifies the tokens.
+	// Example: "https://www.googleapis.com/oauth2/v3/certs"
+	JWKS string `yaml:"jwks"`
+	// Claim that identifies callers. If unset, "sub" is used.
+	// Example: "email"
+	PrincipalClaim string `yaml:"principal_claim"`
+}
+
+// AuthorizationConfig holds configuration for authorizing callers.
+type AuthorizationConfig struct {
+	// Enable authorization of calls. When enabled, calls are denied
+	// unless a binding grants the required role to the caller.
+	// Values: [ true, false ], default: false
+	Enable bool `yaml:"enable"`
+	// Bindings that grant roles to callers.
+	Bindings []BindingConfig `yaml:"bindings"`
+}
+
+// BindingConfig grants a role to a caller.
+type BindingConfig struct {
+	// Identity of the caller: the principal claim of a bearer token or the
+	// first email address, URI or the common name of a TLS client certificate.
+	// "*" matches all callers, including callers without an identity.
+	Principal string `yaml:"principal"`
+	// Role that is granted. Viewers can get and list resources, editors can also
+	// change resources within projects and admins can also change projects
+	// and call server administration methods.
+	// Values: [ viewer, editor, admin ]
+	Role string `yaml:"role"`
+	// ID of the project that the role applies to.
+	// If unset or "*", the role applies to all projects.
+	Project string `yaml:"project"`
+	// ID of the API that the role is limited to. Requires a project.
+	// If unset, the role applies to all APIs of the project.
+	API string `yaml:"api"`
+}
+
+// QuotasConfig holds configuration for limiting projects and callers.
+type QuotasConfig struct {
+	// Limits that apply to every project.
+	LimitsConfig `yaml:",inline"`
+	// Limits of individual projects, keyed by project ID.
+	// Unset limits are taken from the limits of every project.
+	Projects map[string]LimitsConfig `yaml:"projects"`
+	// Calls per second allowed for each caller. Callers are identified by
+	// their principal or, without one, by their address.
+	// If unset or zero, calls are not rate limited.
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// Calls a caller can make at once before the rate applies.
+	// If unset or zero, requests_per_second rounded up is used.
+	RequestBurst int `yaml:"request_burst"`
+}
+
+// LimitsConfig holds the limits of a project. Unset or zero limits are unlimited.
+type LimitsConfig struct {
+	// Maximum number of APIs in the project.
+	MaxApis int64 `yaml:"max_apis"`
+	// Maximum number of revisions of each spec.
+	MaxSpecRevisions int64 `yaml:"max_spec_revisions"`
+	// Maximum total size in bytes of the spec and artifact contents in the project.
+	MaxBlobBytes int64 `yaml:"max_blob_bytes"`
+}
+
+// ShutdownConfig holds configuration for stopping the server.
+type ShutdownConfig struct {
+	// Time between reporting the server as not ready and stopping it, which
+	// gives load balancers time to stop sending it calls.
+	// If unset or zero, the server stops immediately.
+	// Example: "10s"
+	DrainPeriod time.Duration `yaml:"drain_period"`
+}
+
 // default configuration
 var config = ServerConfig{
 	Port: 8080,
@@ -104,10 +360,20 @@ var config = ServerConfig{
 		Enable:  false,
 		Project: "",
 	},
+	Webhook: WebhookConfig{
+		Enable: false,
+	},
+	Eventlog: EventlogConfig{
+		Enable: false,
+	},
 	Monitoring: MonitoringConfig{
 		Enable:  false,
 		Address: ":9090",
 	},
+	Gateway: GatewayConfig{
+		Enable:  false,
+		Address: ":8081",
+	},
 }
 
 func main() {
@@ -145,34 +411,100 @@ func main() {
 
 	// Use logging options from the server config.
 	var (
-		logOpts        = loggerOptions(config.Logging)
-		logger         = log.NewLogger(logOpts...)
-		logInterceptor = interceptor.CallLogger(logOpts...)
+		logOpts              = loggerOptions(config.Logging)
+		logger               = log.NewLogger(logOpts...)
+		logInterceptor       = interceptor.CallLogger(logOpts...)
+		logStreamInterceptor = interceptor.StreamCallLogger(logOpts...)
 	)
 
+	stopTracing, err := tracing.Start(context.Background(), tracingConfig())
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to start tracing")
+	}
+
+	sinks, err := notificationSinks()
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to create notification sinks")
+	}
+
 	registryServer, err := registry.New(registry.Config{
-		Database:  config.Database.Driver,
-		DBConfig:  config.Database.Config,
-		LogLevel:  config.Logging.Level,
-		LogFormat: config.Logging.Format,
-		Notify:    config.Pubsub.Enable,
-		ProjectID: config.Pubsub.Project,
-		NoMigrate: noMigrate,
+		Database:         config.Database.Driver,
+		DBConfig:         config.Database.Config,
+		LogLevel:         config.Logging.Level,
+		LogFormat:        config.Logging.Format,
+		Notify:           config.Pubsub.Enable,
+		ProjectID:        config.Pubsub.Project,
+		NoMigrate:        noMigrate,
+		Sinks:            sinks,
+		PurgeWindow:      config.Deletion.PurgeWindow,
+		PruneInterval:    config.Retention.PruneInterval,
+		Quotas:           quotaConfig(),
+		MaxContentsBytes: config.MaxContentsBytes,
+		BlobStore: registry.BlobStoreConfig{
+			Type:            config.Blobstore.Type,
+			Path:            config.Blobstore.Path,
+			Endpoint:        config.Blobstore.S3.Endpoint,
+			Region:          config.Blobstore.S3.Region,
+			Bucket:          config.Blobstore.S3.Bucket,
+			Prefix:          config.Blobstore.S3.Prefix,
+			AccessKeyID:     config.Blobstore.S3.AccessKeyID,
+			SecretAccessKey: config.Blobstore.S3.SecretAccessKey,
+		},
 	})
 	if err != nil {
 		logger.WithError(err).Fatalf("Failed to create registry server")
 	}
 
-	var serverOptions []grpc.ServerOption
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
 	if config.Monitoring.Enable {
-		serverOptions = []grpc.ServerOption{
-			grpc.ChainUnaryInterceptor(grpc_prometheus.UnaryServerInterceptor, logInterceptor),
-			grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
+		unaryInterceptors = append(unaryInterceptors, grpc_prometheus.UnaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, grpc_prometheus.StreamServerInterceptor)
+	}
+	// Callers are authenticated before calls are logged, so request logs include their principals.
+	if config.Authentication.Enable {
+		authenticator, err := authn.NewAuthenticator(authenticationConfig())
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create authenticator")
 		}
-	} else {
-		serverOptions = []grpc.ServerOption{
-			grpc.UnaryInterceptor(logInterceptor),
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, logInterceptor)
+	streamInterceptors = append(streamInterceptors, logStreamInterceptor)
+	if config.Authorization.Enable {
+		policy, err := authorizationPolicy()
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create authorization policy")
 		}
+		unaryInterceptors = append(unaryInterceptors, authz.UnaryInterceptor(policy))
+		streamInterceptors = append(streamInterceptors, authz.StreamInterceptor(policy))
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.MaxRecvMsgSize(maxMessageBytes()),
+		grpc.MaxSendMsgSize(maxMessageBytes()),
+	}
+	if config.Tracing.Exporter != "" {
+		// Spans are started before interceptors run, so request logs include trace IDs.
+		serverOptions = append(serverOptions, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	}
+	var tlsReloader *tlsconfig.Reloader
+	if config.TLS.Enable {
+		reloader, err := tlsconfig.NewReloader(tlsconfig.ServerFiles{
+			CertFile:          config.TLS.CertFile,
+			KeyFile:           config.TLS.KeyFile,
+			ClientCAFile:      config.TLS.ClientCAFile,
+			RequireClientCert: config.TLS.RequireClientCert,
+		})
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to load TLS configuration")
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(reloader.Config())))
+		tlsReloader = reloader
 	}
 	listener, server, err := registryServer.ServeGRPC(
 		&net.TCPAddr{Port: config.Port},
@@ -182,6 +514,7 @@ func main() {
 		logger.WithError(err).Fatalf("Failed to create TCP listener")
 	}
 	logger.Infof("Listening on %s", listener.Addr())
+	probes := registryServer.HealthHandler()
 
 	if config.Monitoring.Enable {
 		grpc_prometheus.EnableHandlingTimeHistogram()
@@ -192,6 +525,8 @@ func main() {
 
 		mux := http.NewServeMux()
 		mux.Handle(prometheusPath, promhttp.Handler())
+		mux.Handle("/healthz", probes)
+		mux.Handle("/readyz", probes)
 
 		httpServer := &http.Server{
 			Addr:    listener.Addr().String(),
@@ -205,12 +540,95 @@ func main() {
 		}()
 	}
 
+	var gatewayServer *http.Server
+	if config.Gateway.Enable {
+		var gatewayListener net.Listener
+		gatewayServer, gatewayListener, err = newGateway(listener.Addr(), tlsReloader, probes)
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create REST gateway")
+		}
+		logger.Infof("Serving REST on %s", gatewayListener.Addr().String())
+		go func() {
+			if err := gatewayServer.Serve(gatewayListener); err != nil && err != http.ErrServerClosed {
+				logger.WithError(err).Fatalf("Failed to start REST gateway")
+			}
+		}()
+	}
+
 	// Wait for an interruption signal.
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
 	<-done
+	registryServer.Drain()
+	if period := config.Shutdown.DrainPeriod; period > 0 {
+		logger.Infof("Draining for %s", period)
+		time.Sleep(period)
+	}
+	if gatewayServer != nil {
+		if err := gatewayServer.Shutdown(context.Background()); err != nil {
+			logger.WithError(err).Errorf("Failed to stop REST gateway")
+		}
+	}
 	server.GracefulStop()
 	registryServer.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := stopTracing(ctx); err != nil {
+		logger.WithError(err).Errorf("Failed to export traces")
+	}
+}
+
+// newGateway returns an HTTP server and a listener for the REST/JSON gateway,
+// which calls the gRPC server listening at addr and also serves the health probes.
+// With TLS, the gateway serves the server certificate and calls the gRPC server over TLS.
+func newGateway(addr net.Addr, reloader *tlsconfig.Reloader, probes http.Handler) (*http.Server, net.Listener, error) {
+	// The gRPC server listens on all interfaces, so the gateway calls it on the loopback interface.
+	target := addr.String()
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		target = net.JoinHostPort("localhost", strconv.Itoa(tcp.Port))
+	}
+	creds := insecure.NewCredentials()
+	if reloader != nil {
+		// The connection is to this process, whose certificate may not be issued for localhost.
+		creds = credentials.NewTLS(&tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true,
+		})
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageBytes()), grpc.MaxCallSendMsgSize(maxMessageBytes())),
+	}
+	if config.Tracing.Exporter != "" {
+		dialOpts = append(dialOpts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	}
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	gateway, err := registry.GatewayHandler(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	handler := http.NewServeMux()
+	handler.Handle("/", gateway)
+	handler.Handle("/healthz", probes)
+	handler.Handle("/readyz", probes)
+	l, err := net.Listen("tcp", config.Gateway.Address)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if reloader != nil {
+		l = tls.NewListener(l, reloader.Config())
+	}
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	server.RegisterOnShutdown(func() { conn.Close() })
+	return server, l, nil
 }
 
 func validateConfig() error {
@@ -218,10 +636,22 @@ func validateConfig() error {
 		return fmt.Errorf("invalid port %q: must be non-negative", config.Port)
 	}
 
+	if config.TLS.Enable {
+		if config.TLS.CertFile == "" || config.TLS.KeyFile == "" {
+			return fmt.Errorf("invalid tls: tls cannot be enabled without a cert_file and a key_file")
+		}
+		if config.Gateway.Enable && config.TLS.RequireClientCert {
+			return fmt.Errorf("invalid gateway: the gateway cannot be enabled when tls requires client certificates")
+		}
+		if config.TLS.RequireClientCert && config.TLS.ClientCAFile == "" {
+			return fmt.Errorf("invalid tls.client_ca_file %q: require_client_cert requires a client_ca_file", config.TLS.ClientCAFile)
+		}
+	}
+
 	switch driver := config.Database.Driver; driver {
-	case "sqlite3", "postgres", "cloudsqlpostgres":
+	case "sqlite3", "postgres", "cloudsqlpostgres", "mysql":
 	default:
-		return fmt.Errorf("invalid database.driver %q: must be one of [sqlite3, postgres, cloudsqlpostgres]", driver)
+		return fmt.Errorf("invalid database.driver %q: must be one of [sqlite3, postgres, cloudsqlpostgres, mysql]", driver)
 	}
 
 	switch level := config.Logging.Level; level {
@@ -240,9 +670,187 @@ func validateConfig() error {
 		return fmt.Errorf("invalid pubsub.project %q: pubsub cannot be enabled without GCP project ID", project)
 	}
 
+	if url := config.Webhook.URL; config.Webhook.Enable && url == "" {
+		return fmt.Errorf("invalid webhook.url %q: webhook cannot be enabled without a URL", url)
+	}
+
+	if period := config.Shutdown.DrainPeriod; period < 0 {
+		return fmt.Errorf("invalid shutdown.drain_period %q: must be non-negative", period)
+	}
+
+	if err := tracingConfig().Validate(); err != nil {
+		return err
+	}
+
+	if window := config.Deletion.PurgeWindow; window < 0 {
+		return fmt.Errorf("invalid deletion.purge_window %q: must be non-negative", window)
+	}
+
+	if interval := config.Retention.PruneInterval; interval < 0 {
+		return fmt.Errorf("invalid retention.prune_interval %q: must be non-negative", interval)
+	}
+
+	if attempts := config.Webhook.MaxAttempts; attempts < 0 {
+		return fmt.Errorf("invalid webhook.max_attempts %d: must be non-negative", attempts)
+	}
+
+	if path := config.Eventlog.Path; config.Eventlog.Enable && path == "" {
+		return fmt.Errorf("invalid eventlog.path %q: eventlog cannot be enabled without a path", path)
+	}
+
+	switch kind := config.Blobstore.Type; kind {
+	case "", "database":
+	case "filesystem":
+		if config.Blobstore.Path == "" {
+			return fmt.Errorf("invalid blobstore.path %q: filesystem blobstore requires a path", config.Blobstore.Path)
+		}
+	case "s3":
+		if config.Blobstore.S3.Bucket == "" {
+			return fmt.Errorf("invalid blobstore.s3.bucket %q: s3 blobstore requires a bucket", config.Blobstore.S3.Bucket)
+		}
+	default:
+		return fmt.Errorf("invalid blobstore.type %q: must be one of [database, filesystem, s3]", kind)
+	}
+
+	if config.Authentication.Enable {
+		if len(config.Authentication.Issuers) == 0 {
+			return fmt.Errorf("invalid authentication.issuers: authentication cannot be enabled without issuers")
+		}
+		for i, iss := range config.Authentication.Issuers {
+			if iss.Issuer == "" {
+				return fmt.Errorf("invalid authentication.issuers[%d].issuer %q: must be set", i, iss.Issuer)
+			}
+			if iss.JWKS == "" {
+				return fmt.Errorf("invalid authentication.issuers[%d].jwks %q: must be a path or URL", i, iss.JWKS)
+			}
+		}
+	}
+
+	for i, b := range config.Authorization.Bindings {
+		if b.Principal == "" {
+			return fmt.Errorf("invalid authorization.bindings[%d].principal %q: must be set", i, b.Principal)
+		}
+		if _, err := authz.ParseRole(b.Role); err != nil {
+			return fmt.Errorf("invalid authorization.bindings[%d].role %q: must be one of [viewer, editor, admin]", i, b.Role)
+		}
+		if b.API != "" && (b.Project == "" || b.Project == "*") {
+			return fmt.Errorf("invalid authorization.bindings[%d].api %q: requires a project", i, b.API)
+		}
+	}
+
+	if err := validateLimits("quotas", config.Quotas.LimitsConfig); err != nil {
+		return err
+	}
+	for id, l := range config.Quotas.Projects {
+		if err := validateLimits(fmt.Sprintf("quotas.projects[%s]", id), l); err != nil {
+			return err
+		}
+	}
+	if rps := config.Quotas.RequestsPerSecond; rps < 0 {
+		return fmt.Errorf("invalid quotas.requests_per_second %v: must be non-negative", rps)
+	}
+	if burst := config.Quotas.RequestBurst; burst < 0 {
+		return fmt.Errorf("invalid quotas.request_burst %d: must be non-negative", burst)
+	}
+
+	return nil
+}
+
+func validateLimits(prefix string, l LimitsConfig) error {
+	if l.MaxApis < 0 {
+		return fmt.Errorf("invalid %s.max_apis %d: must be non-negative", prefix, l.MaxApis)
+	}
+	if l.MaxSpecRevisions < 0 {
+		return fmt.Errorf("invalid %s.max_spec_revisions %d: must be non-negative", prefix, l.MaxSpecRevisions)
+	}
+	if l.MaxBlobBytes < 0 {
+		return fmt.Errorf("invalid %s.max_blob_bytes %d: must be non-negative", prefix, l.MaxBlobBytes)
+	}
 	return nil
 }
 
+func quotaConfig() registry.QuotaConfig {
+	limits := func(l LimitsConfig) registry.Limits {
+		return registry.Limits{
+			MaxApis:          l.MaxApis,
+			MaxSpecRevisions: l.MaxSpecRevisions,
+			MaxBlobBytes:     l.MaxBlobBytes,
+		}
+	}
+	c := registry.QuotaConfig{
+		Limits:            limits(config.Quotas.LimitsConfig),
+		RequestsPerSecond: config.Quotas.RequestsPerSecond,
+		RequestBurst:      config.Quotas.RequestBurst,
+	}
+	if len(config.Quotas.Projects) > 0 {
+		c.Projects = make(map[string]registry.Limits, len(config.Quotas.Projects))
+		for id, l := range config.Quotas.Projects {
+			c.Projects[id] = limits(l)
+		}
+	}
+	return c
+}
+
+func authenticationConfig() authn.Config {
+	c := authn.Config{AllowUnauthenticated: config.Authentication.AllowUnauthenticated}
+	for _, iss := range config.Authentication.Issuers {
+		// Unset environment variables leave empty audiences in the list.
+		var audiences []string
+		for _, a := range iss.Audiences {
+			if a != "" {
+				audiences = append(audiences, a)
+			}
+		}
+		c.Issuers = append(c.Issuers, authn.Issuer{
+			Issuer:         iss.Issuer,
+			Audiences:      audiences,
+			JWKS:           iss.JWKS,
+			PrincipalClaim: iss.PrincipalClaim,
+		})
+	}
+	return c
+}
+
+func authorizationPolicy() (*authz.Policy, error) {
+	bindings := make([]authz.Binding, 0, len(config.Authorization.Bindings))
+	for _, b := range config.Authorization.Bindings {
+		role, err := authz.ParseRole(b.Role)
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, authz.Binding{
+			Principal: b.Principal,
+			Role:      role,
+			Project:   b.Project,
+			API:       b.API,
+		})
+	}
+	return authz.NewPolicy(bindings)
+}
+
+func notificationSinks() ([]registry.NotificationSink, error) {
+	var sinks []registry.NotificationSink
+	if config.Webhook.Enable {
+		sink, err := registry.NewWebhookSink(registry.WebhookConfig{
+			URL:         config.Webhook.URL,
+			Secret:      config.Webhook.Secret,
+			MaxAttempts: config.Webhook.MaxAttempts,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.Eventlog.Enable {
+		sink, err := registry.NewFileSink(config.Eventlog.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
 func loggerOptions(conf LoggingConfig) []log.Option {
 	opts := make([]log.Option, 0, 2)
 	switch conf.Level {
@@ -267,3 +875,14 @@ func loggerOptions(conf LoggingConfig) []log.Option {
 
 	return opts
 }
+
+func tracingConfig() tracing.Config {
+	return tracing.Config{
+		Exporter:    config.Tracing.Exporter,
+		Endpoint:    config.Tracing.Endpoint,
+		Insecure:    config.Tracing.Insecure,
+		Path:        config.Tracing.Path,
+		SampleRatio: config.Tracing.SampleRatio,
+		ServiceName: "registry-server",
+	}
+}
@@ -186,6 +186,9 @@ func annotateDeployments(
 	})
 }
 
+// Tasks update the resources that were listed, including their etags, so an
+// update fails with ABORTED if a resource changed after it was listed.
+// This keeps concurrent changes to other annotations from being overwritten.
 type annotateApiTask struct {
 	client   connection.RegistryClient
 	api      *rpc.Api
@@ -209,7 +212,7 @@ func (task *annotateApiTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"annotations"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -236,7 +239,7 @@ func (task *annotateVersionTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"annotations"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -263,7 +266,7 @@ func (task *annotateSpecTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"annotations"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -290,6 +293,6 @@ func (task *annotateDeploymentTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"annotations"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
@@ -18,10 +18,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/apigee/registry/cmd/registry/cmd/label"
 	"github.com/apigee/registry/pkg/connection/grpctest"
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TestMain will set up a local RegistryServer and grpc.Server for all
@@ -188,3 +191,43 @@ func TestAnnotate(t *testing.T) {
 		}
 	}
 }
+
+func TestAnnotateConflict(t *testing.T) {
+	const (
+		projectID = "annotate-conflict-test"
+		apiName   = "projects/" + projectID + "/locations/global/apis/sample"
+	)
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, projectID, nil)
+	api, err := registryClient.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/" + projectID + "/locations/global",
+		ApiId:  "sample",
+		Api:    &rpc.Api{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating api %s", err)
+	}
+
+	// Change the API after it was read.
+	if _, err := registryClient.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{Name: apiName, Annotations: map[string]string{"other": "true"}},
+	}); err != nil {
+		t.Fatalf("Error updating api %s", err)
+	}
+
+	task := &annotateApiTask{
+		client:   registryClient,
+		api:      api,
+		labeling: &label.Labeling{Set: map[string]string{"mine": "true"}},
+	}
+	if err := task.Run(ctx); status.Code(err) != codes.Aborted {
+		t.Errorf("Run() returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+	got, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: apiName})
+	if err != nil {
+		t.Fatalf("Error getting api %s", err)
+	}
+	if want := map[string]string{"other": "true"}; !cmp.Equal(want, got.Annotations) {
+		t.Errorf("Annotations were overwritten (-want +got):\n%s", cmp.Diff(want, got.Annotations))
+	}
+}
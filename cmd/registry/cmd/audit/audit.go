@@ -0,0 +1,165 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var csvHeader = []string{"sequence", "create_time", "principal", "method", "change", "resource", "update_mask", "before_hash", "after_hash"}
+
+func Command() *cobra.Command {
+	var filter string
+	var output string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List and export the audit log of changes to the API Registry",
+		Long: `List and export the audit log of changes to the API Registry.
+Every change made through the API is recorded with the caller that made it,
+the method, the changed resource, the updated fields and hashes of the resource
+before and after the change. Entries are listed in the order that they were
+recorded. Reading the audit log requires the admin role for all projects.
+
+The "--filter" parameter takes a CEL expression that can refer to sequence,
+create_time, principal, method, resource, project_id, change, before_hash and
+after_hash. The "--output" parameter selects text (the default), json (one
+entry per line) or csv.
+
+Examples:
+
+List the changes to a project:
+
+	registry audit --filter 'project_id == "my-project"'
+
+Export the changes made by a caller since the start of 2023:
+
+	registry audit --filter 'principal == "alice@example.com" && create_time > timestamp("2023-01-01T00:00:00Z")' -o csv > audit.csv
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if output != "text" && output != "json" && output != "csv" {
+				return fmt.Errorf("unsupported output %q, must be text, json or csv", output)
+			}
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			client, err := connection.NewAdminClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			req := &rpc.ListAuditEntriesRequest{Filter: filter}
+			if limit > 0 && limit < 1000 {
+				req.PageSize = int32(limit)
+			}
+			w, err := newWriter(cmd.OutOrStdout(), output)
+			if err != nil {
+				return err
+			}
+			it := client.ListAuditEntries(ctx, req)
+			for count := 0; limit <= 0 || count < limit; count++ {
+				entry, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					return err
+				}
+				if err := w.write(entry); err != nil {
+					return err
+				}
+			}
+			return w.flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&filter, "filter", "", "filter selected resources")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output type (text|json|csv)")
+	cmd.Flags().IntVar(&limit, "limit", 0, "maximum number of entries to return, or 0 for all entries")
+	return cmd
+}
+
+// writer writes audit entries in an output format.
+type writer struct {
+	out    io.Writer
+	output string
+	csv    *csv.Writer
+}
+
+func newWriter(out io.Writer, output string) (*writer, error) {
+	w := &writer{out: out, output: output}
+	if output == "csv" {
+		w.csv = csv.NewWriter(out)
+		if err := w.csv.Write(csvHeader); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func (w *writer) write(e *rpc.AuditEntry) error {
+	createTime := e.GetCreateTime().AsTime().Format(time.RFC3339Nano)
+	switch w.output {
+	case "json":
+		b, err := protojson.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w.out, "%s\n", b)
+		return err
+	case "csv":
+		return w.csv.Write([]string{
+			strconv.FormatInt(e.GetSequence(), 10),
+			createTime,
+			e.GetPrincipal(),
+			e.GetMethod(),
+			e.GetChange().String(),
+			e.GetResource(),
+			strings.Join(e.GetUpdateMaskPaths(), ","),
+			e.GetBeforeHash(),
+			e.GetAfterHash(),
+		})
+	default:
+		principal := e.GetPrincipal()
+		if principal == "" {
+			principal = "-"
+		}
+		_, err := fmt.Fprintf(w.out, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			e.GetSequence(), createTime, principal, e.GetMethod(), e.GetChange(), e.GetResource())
+		return err
+	}
+}
+
+func (w *writer) flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
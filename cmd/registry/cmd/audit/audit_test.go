@@ -0,0 +1,169 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+const resource = "projects/audit-test/locations/global/apis/a"
+
+// setup creates an API and updates it, so that the last entries of the API's
+// audit log record its creation and update. Entries of previous changes
+// are kept, so tests only check the last entries.
+func setup(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "audit-test", []seeder.RegistryResource{
+		&rpc.Api{Name: resource},
+	})
+	if _, err := registryClient.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: resource, DisplayName: "A"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApi() returned error: %s", err)
+	}
+}
+
+func execute(t *testing.T, args ...string) string {
+	t.Helper()
+	out := new(bytes.Buffer)
+	cmd := Command()
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with args %v returned error: %s", args, err)
+	}
+	return out.String()
+}
+
+func TestAudit(t *testing.T) {
+	setup(t)
+	filter := "--filter=resource == \"" + resource + "\""
+
+	t.Run("json", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSpace(execute(t, filter, "-o", "json")), "\n")
+		if len(lines) < 2 {
+			t.Fatalf("Execute() returned %d entries, expected at least 2", len(lines))
+		}
+		var created, updated rpc.AuditEntry
+		if err := protojson.Unmarshal([]byte(lines[len(lines)-2]), &created); err != nil {
+			t.Fatalf("Failed to parse entry %q: %s", lines[len(lines)-2], err)
+		}
+		if err := protojson.Unmarshal([]byte(lines[len(lines)-1]), &updated); err != nil {
+			t.Fatalf("Failed to parse entry %q: %s", lines[len(lines)-1], err)
+		}
+		want := &rpc.AuditEntry{
+			Sequence:        updated.GetSequence(),
+			Method:          "UpdateApi",
+			Resource:        resource,
+			Change:          rpc.Notification_UPDATED,
+			UpdateMaskPaths: []string{"display_name"},
+			BeforeHash:      created.GetAfterHash(),
+		}
+		opts := cmp.Options{protocmp.Transform(), protocmp.IgnoreFields(&rpc.AuditEntry{}, "create_time", "after_hash")}
+		if diff := cmp.Diff(want, &updated, opts); diff != "" {
+			t.Errorf("Execute() returned unexpected diff (-want +got):\n%s", diff)
+		}
+		if updated.GetAfterHash() == "" || updated.GetAfterHash() == created.GetAfterHash() {
+			t.Errorf("Update has after hash %q, expected a new hash", updated.GetAfterHash())
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSpace(execute(t, filter)), "\n")
+		fields := strings.Split(lines[len(lines)-1], "\t")
+		if len(fields) != 6 {
+			t.Fatalf("Execute() returned %q, expected 6 tab-separated fields", lines[len(lines)-1])
+		}
+		if want := []string{"-", "UpdateApi", "UPDATED", resource}; !cmp.Equal(want, fields[2:]) {
+			t.Errorf("Execute() returned fields %q, expected %q", fields[2:], want)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		records, err := csv.NewReader(strings.NewReader(execute(t, filter, "-o", "csv"))).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to parse output: %s", err)
+		}
+		if len(records) < 3 {
+			t.Fatalf("Execute() returned %d records, expected a header and at least 2 entries", len(records))
+		}
+		if !cmp.Equal(csvHeader, records[0]) {
+			t.Errorf("Execute() returned header %q, expected %q", records[0], csvHeader)
+		}
+		last := records[len(records)-1]
+		if last[3] != "UpdateApi" || last[6] != "display_name" {
+			t.Errorf("Execute() returned last record %q, expected an update of display_name", last)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		if got := strings.Count(execute(t, filter, "--limit", "1"), "\n"); got != 1 {
+			t.Errorf("Execute() with limit 1 returned %d entries", got)
+		}
+	})
+}
+
+func TestAuditErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "invalid output",
+			args: []string{"-o", "yaml"},
+		},
+		{
+			desc: "unexpected argument",
+			args: []string{"projects/audit-test"},
+		},
+		{
+			desc: "invalid filter",
+			args: []string{"--filter", "unknown == 1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetArgs(test.args)
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("Execute() with args %v succeeded, expected error", test.args)
+			}
+		})
+	}
+}
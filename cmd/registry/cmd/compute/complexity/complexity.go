@@ -55,35 +55,33 @@ func Command() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			// Initialize task queue.
-			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
-
 			parsed, err := names.ParseSpecRevision(args[0])
 			if err != nil {
 				return err
 			}
 
+			// Initialize task queue.
+			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
+			artifacts := visitor.NewArtifactBatch(client)
+			handler := func(ctx context.Context, spec *rpc.ApiSpec) error {
+				taskQueue <- &computeComplexityTask{
+					artifacts: artifacts,
+					spec:      spec,
+					dryRun:    dryRun,
+				}
+				return nil
+			}
+
 			if parsed.RevisionID == "" {
-				err = visitor.ListSpecs(ctx, client, parsed.Spec(), 0, filter, false, func(ctx context.Context, spec *rpc.ApiSpec) error {
-					taskQueue <- &computeComplexityTask{
-						client:   client,
-						specName: spec.Name,
-						dryRun:   dryRun,
-					}
-					return nil
-				})
+				err = visitor.ListSpecs(ctx, client, parsed.Spec(), 0, filter, true, handler)
 			} else {
-				err = visitor.ListSpecRevisions(ctx, client, parsed, 0, filter, false, func(ctx context.Context, spec *rpc.ApiSpec) error {
-					taskQueue <- &computeComplexityTask{
-						client:   client,
-						specName: spec.Name,
-						dryRun:   dryRun,
-					}
-					return nil
-				})
+				err = visitor.ListSpecRevisions(ctx, client, parsed, 0, filter, true, handler)
 			}
-			return err
+			wait()
+			if err != nil {
+				return err
+			}
+			return artifacts.Flush(ctx)
 		},
 	}
 	cmd.Flags().StringVar(&filter, "filter", "", "filter selected resources")
@@ -93,73 +91,64 @@ func Command() *cobra.Command {
 }
 
 type computeComplexityTask struct {
-	client   connection.RegistryClient
-	specName string
-	dryRun   bool
+	artifacts *visitor.ArtifactBatch
+	spec      *rpc.ApiSpec
+	dryRun    bool
 }
 
 func (task *computeComplexityTask) String() string {
-	return "compute complexity " + task.specName
+	return "compute complexity " + task.spec.GetName()
 }
 
 func (task *computeComplexityTask) Run(ctx context.Context) error {
-	specName, err := names.ParseSpecRevision(task.specName)
-	if err != nil {
-		return err
-	}
-	var spec *rpc.ApiSpec
-	if err = visitor.GetSpecRevision(ctx, task.client, specName, true, func(ctx context.Context, s *rpc.ApiSpec) error {
-		spec = s
-		return nil
-	}); err != nil {
-		return err
-	}
-
+	spec := task.spec
+	specName := spec.GetName()
 	relation := "complexity"
-	log.Debugf(ctx, "Computing %s/artifacts/%s", task.specName, relation)
+	log.Debugf(ctx, "Computing %s/artifacts/%s", specName, relation)
 	contents := spec.GetContents()
 	var complexity *metrics.Complexity
 	if mime.IsOpenAPIv2(spec.GetMimeType()) {
 		document, err := oas2.ParseDocument(contents)
 		if err != nil {
-			log.FromContext(ctx).WithError(err).Errorf("Invalid OpenAPI: %s", task.specName)
+			log.FromContext(ctx).WithError(err).Errorf("Invalid OpenAPI: %s", specName)
 			return nil
 		}
 		complexity = SummarizeOpenAPIv2Document(document)
 	} else if mime.IsOpenAPIv3(spec.GetMimeType()) {
 		document, err := oas3.ParseDocument(contents)
 		if err != nil {
-			log.FromContext(ctx).WithError(err).Errorf("Invalid OpenAPI: %s", task.specName)
+			log.FromContext(ctx).WithError(err).Errorf("Invalid OpenAPI: %s", specName)
 			return nil
 		}
 		complexity = SummarizeOpenAPIv3Document(document)
 	} else if mime.IsDiscovery(spec.GetMimeType()) {
 		document, err := discovery.ParseDocument(contents)
 		if err != nil {
-			log.FromContext(ctx).WithError(err).Errorf("Invalid Discovery: %s", task.specName)
+			log.FromContext(ctx).WithError(err).Errorf("Invalid Discovery: %s", specName)
 			return nil
 		}
 		complexity = SummarizeDiscoveryDocument(document)
 	} else if mime.IsProto(spec.GetMimeType()) && mime.IsZipArchive(spec.GetMimeType()) {
+		var err error
 		complexity, err = SummarizeZippedProtos(contents)
 		if err != nil {
-			log.FromContext(ctx).WithError(err).Errorf("Error processing protos: %s", task.specName)
+			log.FromContext(ctx).WithError(err).Errorf("Error processing protos: %s", specName)
 			return nil
 		}
 	} else {
-		return fmt.Errorf("we don't know how to summarize %s", task.specName)
+		return fmt.Errorf("we don't know how to summarize %s", specName)
 	}
 
 	if task.dryRun {
 		fmt.Println(protojson.Format(complexity))
 		return nil
 	}
-	subject := task.specName
+	subject := specName
 	messageData, _ := proto.Marshal(complexity)
 	artifact := &rpc.Artifact{
 		Name:     subject + "/artifacts/" + relation,
 		MimeType: mime.MimeTypeForMessageType("gnostic.metrics.Complexity"),
 		Contents: messageData,
 	}
-	return visitor.SetArtifact(ctx, task.client, artifact)
+	return task.artifacts.Set(ctx, artifact)
 }
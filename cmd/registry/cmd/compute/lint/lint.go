@@ -62,26 +62,32 @@ func Command() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			// Initialize task queue.
-			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
-
 			spec, err := names.ParseSpec(args[0])
 			if err != nil {
 				return fmt.Errorf("invalid spec pattern %s", args[0])
 			}
 
+			// Initialize task queue.
+			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
+			artifacts := visitor.NewArtifactBatch(client)
+
 			// Iterate through a collection of specs and evaluate each.
-			return visitor.ListSpecs(ctx, client, spec, 0, filter, false, func(ctx context.Context, spec *rpc.ApiSpec) error {
+			err = visitor.ListSpecs(ctx, client, spec, 0, filter, true, func(ctx context.Context, spec *rpc.ApiSpec) error {
 				taskQueue <- &computeLintTask{
-					client: client,
-					spec:   spec,
-					linter: linter,
-					dryRun: dryRun,
-					debug:  debug,
+					client:    client,
+					artifacts: artifacts,
+					spec:      spec,
+					linter:    linter,
+					dryRun:    dryRun,
+					debug:     debug,
 				}
 				return nil
 			})
+			wait()
+			if err != nil {
+				return err
+			}
+			return artifacts.Flush(ctx)
 		},
 	}
 
@@ -95,11 +101,12 @@ func Command() *cobra.Command {
 }
 
 type computeLintTask struct {
-	client connection.RegistryClient
-	spec   *rpc.ApiSpec
-	linter string
-	dryRun bool
-	debug  bool
+	client    connection.RegistryClient
+	artifacts *visitor.ArtifactBatch
+	spec      *rpc.ApiSpec
+	linter    string
+	dryRun    bool
+	debug     bool
 }
 
 func (task *computeLintTask) String() string {
@@ -141,5 +148,5 @@ func (task *computeLintTask) Run(ctx context.Context) error {
 		MimeType: mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.style.Lint"),
 		Contents: messageData,
 	}
-	return visitor.SetArtifact(ctx, task.client, artifact)
+	return task.artifacts.Set(ctx, artifact)
 }
@@ -29,7 +29,6 @@ import (
 	"github.com/hexops/gotextdiff/myers"
 	"github.com/hexops/gotextdiff/span"
 	"github.com/spf13/cobra"
-	"google.golang.org/api/iterator"
 )
 
 func Command() *cobra.Command {
@@ -43,6 +42,10 @@ func Command() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			// The second resource may be a revision of the first spec, such as "@{-1}".
+			if strings.HasPrefix(args[1], "@") {
+				args[1] = strings.SplitN(args[0], "@", 2)[0] + args[1]
+			}
 			for i := range args {
 				args[i] = c.FQName(args[i])
 			}
@@ -53,7 +56,6 @@ func Command() *cobra.Command {
 			}
 
 			var spec1, spec2 *rpc.ApiSpec
-			var path1 names.Spec
 			if name1, err := names.ParseSpec(args[0]); err == nil {
 				err = visitor.GetSpec(ctx, client, name1, true, func(ctx context.Context, s *rpc.ApiSpec) error {
 					spec1 = s
@@ -62,7 +64,6 @@ func Command() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				path1 = name1
 			} else if name1, err := names.ParseSpecRevision(args[0]); err == nil {
 				err = visitor.GetSpecRevision(ctx, client, name1, true, func(ctx context.Context, s *rpc.ApiSpec) error {
 					spec1 = s
@@ -71,7 +72,8 @@ func Command() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				path1 = name1.Spec()
+			} else {
+				return fmt.Errorf("%q is not a spec or spec revision", args[0])
 			}
 
 			if name2, err := names.ParseSpec(args[1]); err == nil {
@@ -90,16 +92,8 @@ func Command() *cobra.Command {
 				if err != nil {
 					return err
 				}
-			} else if name2, err := resolveSpecRevision(ctx, client, path1.String(), args[1]); err == nil {
-				err = visitor.GetSpecRevision(ctx, client, name2, true, func(ctx context.Context, s *rpc.ApiSpec) error {
-					spec2 = s
-					return nil
-				})
-				if err != nil {
-					return err
-				}
 			} else {
-				return err
+				return fmt.Errorf("%q is not a spec or spec revision", args[1])
 			}
 			if spec1 != nil && spec2 != nil {
 				err = printDiff(spec1, spec2)
@@ -110,43 +104,6 @@ func Command() *cobra.Command {
 	return cmd
 }
 
-func resolveSpecRevision(ctx context.Context,
-	client connection.RegistryClient,
-	base string,
-	suffix string) (names.SpecRevision, error) {
-	// First try to treat the raw suffix as revision name.
-	name, err := names.ParseSpecRevision(base + suffix)
-	if err == nil {
-		return name, nil
-	}
-	// Next try to process a relative reference '@{-N}',
-	// which points to the Nth revision back.
-	// NOTE: This should probably be implemented in the server
-	// and removed when that is available.
-	var revIndex int
-	if _, err := fmt.Sscanf(suffix, "@{-%d}", &revIndex); err != nil {
-		return names.SpecRevision{}, fmt.Errorf("%s is not a valid revision reference", suffix)
-	} else if revIndex <= 0 {
-		return names.SpecRevision{}, fmt.Errorf("%d is not a valid revision index", -revIndex)
-	}
-	it := client.ListApiSpecRevisions(ctx,
-		&rpc.ListApiSpecRevisionsRequest{
-			Name: base,
-		})
-	for i := 0; i >= -revIndex; i -= 1 {
-		spec, err := it.Next()
-		if err == iterator.Done {
-			return names.SpecRevision{}, fmt.Errorf("no revision exists for index %d", -revIndex)
-		} else if err != nil {
-			return names.SpecRevision{}, err
-		}
-		if i == -revIndex {
-			return names.ParseSpecRevision(spec.Name)
-		}
-	}
-	return names.SpecRevision{}, fmt.Errorf("%s is not a valid revision reference", suffix)
-}
-
 func printDiff(spec1, spec2 *rpc.ApiSpec) error {
 	if spec1.MimeType != spec2.MimeType {
 		return fmt.Errorf("incomparable content types (%s, %s)", spec1.MimeType, spec2.MimeType)
@@ -185,6 +185,9 @@ func labelDeployments(
 	})
 }
 
+// Tasks update the resources that were listed, including their etags, so an
+// update fails with ABORTED if a resource changed after it was listed.
+// This keeps concurrent changes to other labels from being overwritten.
 type labelApiTask struct {
 	client   connection.RegistryClient
 	api      *rpc.Api
@@ -208,7 +211,7 @@ func (task *labelApiTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -235,7 +238,7 @@ func (task *labelVersionTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -262,7 +265,7 @@ func (task *labelSpecTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
 
@@ -289,6 +292,6 @@ func (task *labelDeploymentTask) Run(ctx context.Context) error {
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels"},
 			},
-		})
+		}, connection.WithoutRetries())
 	return err
 }
@@ -22,6 +22,8 @@ import (
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TestMain will set up a local RegistryServer and grpc.Server for all
@@ -189,3 +191,43 @@ func TestLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestLabelConflict(t *testing.T) {
+	const (
+		projectID = "label-conflict-test"
+		apiName   = "projects/" + projectID + "/locations/global/apis/sample"
+	)
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, projectID, nil)
+	api, err := registryClient.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/" + projectID + "/locations/global",
+		ApiId:  "sample",
+		Api:    &rpc.Api{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating api %s", err)
+	}
+
+	// Change the API after it was read.
+	if _, err := registryClient.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{Name: apiName, Labels: map[string]string{"other": "true"}},
+	}); err != nil {
+		t.Fatalf("Error updating api %s", err)
+	}
+
+	task := &labelApiTask{
+		client:   registryClient,
+		api:      api,
+		labeling: &Labeling{Set: map[string]string{"mine": "true"}},
+	}
+	if err := task.Run(ctx); status.Code(err) != codes.Aborted {
+		t.Errorf("Run() returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+	got, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: apiName})
+	if err != nil {
+		t.Fatalf("Error getting api %s", err)
+	}
+	if want := map[string]string{"other": "true"}; !cmp.Equal(want, got.Labels) {
+		t.Errorf("Labels were overwritten (-want +got):\n%s", cmp.Diff(want, got.Labels))
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "prune API",
+		Short: "Delete spec and deployment revisions that retention policies don't keep",
+		Long: `Delete spec and deployment revisions that retention policies don't keep.
+Policies are RetentionPolicy artifacts named "retention-policy" that are stored
+in projects or APIs, and the policy of an API takes precedence over the policy
+of its project. APIs without policies are skipped.
+
+A revision is pruned when it is neither among the most recent keepCount
+revisions of its spec or deployment nor younger than keepDuration. The most
+recent revision, tagged revisions and spec revisions that are referenced by
+the apiSpecRevision fields of deployments are always kept. The names of pruned
+revisions are printed, and with "--dry-run" they are printed but not deleted.
+
+Examples:
+
+Store a policy that keeps ten revisions and all revisions from the last week:
+
+	registry apply -f - --parent projects/my-project/locations/global <<EOF
+	apiVersion: apigeeregistry/v1
+	kind: RetentionPolicy
+	metadata:
+	  name: retention-policy
+	data:
+	  keepCount: 10
+	  keepDuration: 604800s
+	EOF
+
+List the revisions of all APIs in the project that would be pruned:
+
+	registry prune projects/my-project/locations/global/apis/- --dry-run
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			api, err := names.ParseApi(c.FQName(args[0]))
+			if err != nil {
+				return fmt.Errorf("invalid API %q: must be an API name, such as projects/my-project/locations/global/apis/-", args[0])
+			}
+			client, err := connection.NewRegistryClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+			response, err := client.PruneApiRevisions(ctx, &rpc.PruneApiRevisionsRequest{
+				Name:         api.String(),
+				ValidateOnly: dryRun,
+			})
+			if err != nil {
+				return err
+			}
+			for _, name := range response.GetRevisionNames() {
+				fmt.Fprintln(cmd.OutOrStdout(), name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list the revisions that would be pruned without deleting them")
+	return cmd
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	project := "projects/prune-test/locations/global"
+	spec := project + "/apis/a/versions/v1/specs/s"
+	client, _ := grpctest.SetupRegistry(ctx, t, "prune-test", []seeder.RegistryResource{
+		&rpc.ApiSpec{Name: spec, Contents: []byte("0")},
+	})
+	first, err := client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec})
+	if err != nil {
+		t.Fatalf("Setup: GetApiSpec() returned error: %s", err)
+	}
+	if _, err := client.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec: &rpc.ApiSpec{Name: spec, Contents: []byte("1")},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApiSpec() returned error: %s", err)
+	}
+	contents, err := proto.Marshal(&controller.RetentionPolicy{KeepCount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     project,
+		ArtifactId: "retention-policy",
+		Artifact: &rpc.Artifact{
+			MimeType: mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.controller.RetentionPolicy"),
+			Contents: contents,
+		},
+	}); err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+
+	pruned := spec + "@" + first.GetRevisionId() + "\n"
+	for _, args := range [][]string{
+		{project + "/apis/-", "--dry-run"},
+		{project + "/apis/a"},
+	} {
+		out := new(bytes.Buffer)
+		cmd := Command()
+		cmd.SetArgs(args)
+		cmd.SetOut(out)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute() with args %v returned error: %s", args, err)
+		}
+		if diff := cmp.Diff(pruned, out.String()); diff != "" {
+			t.Errorf("Execute() with args %v returned unexpected diff (-want +got):\n%s", args, diff)
+		}
+	}
+
+	if _, err := client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec + "@" + first.GetRevisionId()}); err == nil {
+		t.Errorf("GetApiSpec(%q) succeeded after pruning, expected an error", spec+"@"+first.GetRevisionId())
+	}
+}
+
+func TestPruneErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"projects/prune-test/locations/global/apis/a/versions/v1"},
+		{"projects/prune-test/locations/global/apis/missing"},
+	} {
+		cmd := Command()
+		cmd.SetArgs(args)
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("Execute() with args %v succeeded, expected an error", args)
+		}
+	}
+}
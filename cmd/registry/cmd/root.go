@@ -17,6 +17,7 @@ package cmd
 import (
 	"github.com/apigee/registry/cmd/registry/cmd/annotate"
 	"github.com/apigee/registry/cmd/registry/cmd/apply"
+	"github.com/apigee/registry/cmd/registry/cmd/audit"
 	"github.com/apigee/registry/cmd/registry/cmd/auth"
 	"github.com/apigee/registry/cmd/registry/cmd/check"
 	"github.com/apigee/registry/cmd/registry/cmd/compute"
@@ -26,8 +27,10 @@ import (
 	"github.com/apigee/registry/cmd/registry/cmd/export"
 	"github.com/apigee/registry/cmd/registry/cmd/get"
 	"github.com/apigee/registry/cmd/registry/cmd/label"
+	"github.com/apigee/registry/cmd/registry/cmd/prune"
 	"github.com/apigee/registry/cmd/registry/cmd/resolve"
 	"github.com/apigee/registry/cmd/registry/cmd/rpc"
+	"github.com/apigee/registry/cmd/registry/cmd/search"
 	"github.com/apigee/registry/cmd/registry/cmd/upload"
 	pkgconf "github.com/apigee/registry/pkg/config"
 	"github.com/spf13/cobra"
@@ -47,6 +50,7 @@ func Command() *cobra.Command {
 
 	cmd.AddCommand(annotate.Command())
 	cmd.AddCommand(apply.Command())
+	cmd.AddCommand(audit.Command())
 	cmd.AddCommand(auth.Command())
 	cmd.AddCommand(check.Command())
 	cmd.AddCommand(compute.Command())
@@ -57,7 +61,9 @@ func Command() *cobra.Command {
 	cmd.AddCommand(export.Command())
 	cmd.AddCommand(get.Command())
 	cmd.AddCommand(label.Command())
+	cmd.AddCommand(prune.Command())
 	cmd.AddCommand(upload.Command())
 	cmd.AddCommand(rpc.Command())
+	cmd.AddCommand(search.Command())
 	return cmd
 }
@@ -0,0 +1,102 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+)
+
+func Command() *cobra.Command {
+	var parent string
+	var output string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "search QUERY",
+		Short: "Search the names, descriptions and spec contents of resources in the API Registry",
+		Long: `Search the names, descriptions and spec contents of resources in the API Registry.
+The QUERY is a list of words that must all appear in matching APIs, versions,
+specs or deployments. Words enclosed in double quotes must appear as a phrase.
+Matching is case-insensitive and punctuation separates words.
+
+By default, the name of each matching resource is followed by the text around
+its matches. The "--output name" parameter lists only the resource names.
+
+Examples:
+
+List the specs that use an OpenAPI extension:
+
+	registry search x-internal-id
+
+Find the APIs that have an operation called "ListInvoices":
+
+	registry search ListInvoices --output name
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if output != "text" && output != "name" {
+				return fmt.Errorf("unsupported output %q, must be text or name", output)
+			}
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			if parent == "" {
+				if parent, err = c.ProjectWithLocation(); err != nil {
+					return fmt.Errorf("unable to identify parent: please use --parent or set registry.project in configuration (%s)", err)
+				}
+			}
+			client, err := connection.NewRegistryClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			req := &rpc.SearchResourcesRequest{
+				Parent: parent,
+				Query:  strings.Join(args, " "),
+			}
+			if limit > 0 && limit < 1000 {
+				req.PageSize = int32(limit)
+			}
+			it := client.SearchResources(ctx, req)
+			for count := 0; limit <= 0 || count < limit; count++ {
+				result, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					return err
+				}
+				if output == "name" {
+					fmt.Fprintln(cmd.OutOrStdout(), result.GetName())
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s\n\t%s\n", result.GetName(), result.GetSnippet())
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&parent, "parent", "", "parent project for the search (projects/PROJECT/locations/LOCATION)")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output type (text|name)")
+	cmd.Flags().IntVar(&limit, "limit", 50, "maximum number of results to return, or 0 for all results")
+	return cmd
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	parent := "projects/search-test/locations/global"
+	seed := []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:     parent + "/apis/billing/versions/v1/specs/openapi",
+			MimeType: "application/x.openapi;version=3",
+			Contents: []byte("paths:\n  /invoices:\n    get:\n      operationId: ListInvoices\n"),
+		},
+		&rpc.ApiSpec{
+			Name:     parent + "/apis/billing/versions/v2/specs/openapi",
+			MimeType: "application/x.openapi;version=3",
+			Contents: []byte("paths:\n  /invoices:\n    get:\n      operationId: ListAllInvoices\n"),
+		},
+		&rpc.Api{
+			Name:        parent + "/apis/accounts",
+			Description: "Lists accounts.",
+		},
+	}
+	grpctest.SetupRegistry(ctx, t, "search-test", seed)
+
+	tests := []struct {
+		desc     string
+		args     []string
+		want     string
+		contains string // if set, text that the output must contain
+		count    int    // if nonzero, the number of expected results
+	}{
+		{
+			desc: "names",
+			args: []string{"ListInvoices", "--parent", parent, "-o", "name"},
+			want: parent + "/apis/billing/versions/v1/specs/openapi\n",
+		},
+		{
+			desc:     "snippets",
+			args:     []string{"ListInvoices", "--parent", parent},
+			contains: parent + "/apis/billing/versions/v1/specs/openapi\n\t",
+			count:    2,
+		},
+		{
+			desc: "phrase",
+			args: []string{`"lists accounts"`, "--parent", parent, "-o", "name"},
+			want: parent + "/apis/accounts\n",
+		},
+		{
+			desc:  "limit",
+			args:  []string{"invoices", "--parent", parent, "-o", "name", "--limit", "1"},
+			count: 1,
+		},
+		{
+			desc: "no results",
+			args: []string{"unknown", "--parent", parent},
+			want: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			cmd := Command()
+			cmd.SetArgs(test.args)
+			cmd.SetOut(out)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %v returned error: %s", test.args, err)
+			}
+			if test.count > 0 {
+				if lines := bytes.Count(out.Bytes(), []byte("\n")); lines != test.count {
+					t.Errorf("Execute() with args %v returned %d lines, expected %d", test.args, lines, test.count)
+				}
+				if !strings.Contains(out.String(), test.contains) {
+					t.Errorf("Execute() with args %v returned %q, expected it to contain %q", test.args, out.String(), test.contains)
+				}
+				return
+			}
+			if diff := cmp.Diff(test.want, out.String()); diff != "" {
+				t.Errorf("Execute() with args %v returned unexpected diff (-want +got):\n%s", test.args, diff)
+			}
+		})
+	}
+}
+
+func TestSearchErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "missing query",
+			args: []string{"--parent", "projects/search-test/locations/global"},
+		},
+		{
+			desc: "invalid output",
+			args: []string{"hello", "--parent", "projects/search-test/locations/global", "-o", "yaml"},
+		},
+		{
+			desc: "invalid parent",
+			args: []string{"hello", "--parent", "projects/search-test"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetArgs(test.args)
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("Execute() with args %v succeeded, expected an error", test.args)
+			}
+		})
+	}
+}
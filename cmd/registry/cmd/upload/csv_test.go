@@ -168,7 +168,7 @@ func TestUploadCSV(t *testing.T) {
 				// Ignore list ordering. We only want to verify that each spec exists.
 				cmpopts.SortSlices(func(a, b *rpc.ApiSpec) bool { return a.GetName() < b.GetName() }),
 				// Ignore generated fields.
-				protocmp.IgnoreFields(&rpc.ApiSpec{}, "revision_id", "hash", "size_bytes", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(&rpc.ApiSpec{}, "revision_id", "hash", "size_bytes", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if diff := cmp.Diff(test.want, got, opts); diff != "" {
@@ -205,7 +205,7 @@ func (task *uploadDiscoveryTask) createOrUpdateSpec(ctx context.Context) error {
 		AllowMissing: true,
 	}
 
-	response, err := task.client.UpdateApiSpec(ctx, request)
+	response, err := visitor.UpdateSpec(ctx, task.client, request)
 	if err != nil {
 		log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
 	} else {
@@ -23,9 +23,11 @@ import (
 	"regexp"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/apigee/registry/cmd/registry/cmd"
 	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/tracing"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
@@ -58,12 +60,16 @@ func main() {
 	cmd.SetUsageTemplate(usageTemplate)
 	cobra.AddTemplateFunc("Plugins", plugins)
 
+	// Trace the command if tracing is configured. Spans are exported before the process exits.
+	ctx, endTrace := startTrace(ctx, cmd)
+
 	staySilent := cmd.SilenceErrors
 	cmd.SilenceErrors = true // don't print "unknown command" error
 	if err := cmd.ExecuteContext(ctx); err != nil {
 		if strings.HasPrefix(err.Error(), "unknown command") && contains(plugins(), os.Args[1]) {
 			exCmd, err := exec.LookPath(pluginPrefix + os.Args[1])
 			if err == nil {
+				endTrace(nil)
 				if err := syscall.Exec(exCmd, append([]string{exCmd}, os.Args[2:]...), os.Environ()); err != nil {
 					fmt.Fprintf(os.Stderr, "Command finished with error: %v", err)
 					os.Exit(127)
@@ -74,8 +80,39 @@ func main() {
 			cmd.PrintErrln("Error:", err.Error())
 			cmd.PrintErrf("Run '%v --help' for usage.\n", cmd.CommandPath())
 		}
+		endTrace(err)
 		os.Exit(1)
 	}
+	endTrace(nil)
+}
+
+// startTrace starts a span for the command selected by the arguments and
+// returns a function that ends it and exports the spans of the trace.
+func startTrace(ctx context.Context, root *cobra.Command) (context.Context, func(error)) {
+	logger := log.FromContext(ctx)
+	config, err := tracing.ConfigFromEnv("registry")
+	if err != nil {
+		logger.WithError(err).Warn("Tracing is disabled.")
+		return ctx, func(error) {}
+	}
+	stop, err := tracing.Start(ctx, config)
+	if err != nil {
+		logger.WithError(err).Warn("Tracing is disabled.")
+		return ctx, func(error) {}
+	}
+	name := root.Name()
+	if c, _, err := root.Find(os.Args[1:]); err == nil {
+		name = c.CommandPath()
+	}
+	ctx, span := tracing.Tracer().Start(ctx, name)
+	return ctx, func(err error) {
+		tracing.End(span, err)
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		if err := stop(ctx); err != nil {
+			logger.WithError(err).Warn("Failed to export traces.")
+		}
+	}
 }
 
 // adds `Available Plugins` and `Need more help?` sections to default
@@ -190,14 +190,39 @@ func optionalDeploymentName(apiName names.Api, deploymentID string) string {
 }
 
 func applyApiPatchBytes(ctx context.Context, client connection.RegistryClient, bytes []byte, parent string, filename string) error {
+	api, req, err := apiPatchRequest(bytes, parent)
+	if err != nil {
+		return err
+	}
+	_, err = client.UpdateApi(ctx, req, callOptions(api.Metadata)...)
+	if err != nil {
+		return fmt.Errorf("UpdateApi: %s", err)
+	}
+	return applyApiChildPatches(ctx, client, api, req.Api.GetName(), filename)
+}
+
+// applyBatchedApiPatchBytes applies the rest of an API patch after the API was updated in a batch.
+func applyBatchedApiPatchBytes(ctx context.Context, client connection.RegistryClient, bytes []byte, parent string, filename string, batchErr error) error {
+	if batchErr != nil {
+		return fmt.Errorf("UpdateApi: %s", batchErr)
+	}
+	api, req, err := apiPatchRequest(bytes, parent)
+	if err != nil {
+		return err
+	}
+	return applyApiChildPatches(ctx, client, api, req.Api.GetName(), filename)
+}
+
+// apiPatchRequest returns the request that updates the API described by a patch.
+func apiPatchRequest(bytes []byte, parent string) (*encoding.Api, *rpc.UpdateApiRequest, error) {
 	var api encoding.Api
 	err := yaml.Unmarshal(bytes, &api)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	projectName, err := names.ParseProjectWithLocation(parent)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	apiName := projectName.Api(api.Metadata.Name)
 	req := &rpc.UpdateApiRequest{
@@ -210,27 +235,29 @@ func applyApiPatchBytes(ctx context.Context, client connection.RegistryClient, b
 			RecommendedDeployment: optionalDeploymentName(apiName, api.Data.RecommendedDeployment),
 			Labels:                api.Metadata.Labels,
 			Annotations:           api.Metadata.Annotations,
+			Etag:                  api.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
-	_, err = client.UpdateApi(ctx, req)
-	if err != nil {
-		return fmt.Errorf("UpdateApi: %s", err)
-	}
+	return &api, req, nil
+}
+
+// applyApiChildPatches applies the versions, deployments, and artifacts nested in an API patch.
+func applyApiChildPatches(ctx context.Context, client connection.RegistryClient, api *encoding.Api, apiName string, filename string) error {
 	for _, versionPatch := range api.Data.ApiVersions {
-		err := applyApiVersionPatch(ctx, client, versionPatch, apiName.String(), filename)
+		err := applyApiVersionPatch(ctx, client, versionPatch, apiName, filename)
 		if err != nil {
 			return err
 		}
 	}
 	for _, deploymentPatch := range api.Data.ApiDeployments {
-		err := applyApiDeploymentPatch(ctx, client, deploymentPatch, apiName.String(), filename)
+		err := applyApiDeploymentPatch(ctx, client, deploymentPatch, apiName, filename)
 		if err != nil {
 			return err
 		}
 	}
 	for _, artifactPatch := range api.Data.Artifacts {
-		err = applyArtifactPatch(ctx, client, artifactPatch, apiName.String(), filename)
+		err := applyArtifactPatch(ctx, client, artifactPatch, apiName, filename)
 		if err != nil {
 			return err
 		}
@@ -28,6 +28,8 @@ import (
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
 	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/visitor"
+	"github.com/apigee/registry/rpc"
 	"gopkg.in/yaml.v3"
 )
 
@@ -148,6 +150,7 @@ func (p *patchGroup) parse(client connection.RegistryClient, adminClient connect
 }
 
 func (p *patchGroup) run(ctx context.Context, jobs int) error {
+	p.updateApis(ctx)
 	// Apply each resource type independently in order of ownership (parents first).
 	for _, taskLists := range [][]tasks.Task{
 		p.apiTasks,
@@ -172,6 +175,30 @@ func (p *patchGroup) run(ctx context.Context, jobs int) error {
 	return nil
 }
 
+// updateApis updates the APIs of all API patches with batch requests.
+// The resources nested in each API are applied later by its task.
+func (p *patchGroup) updateApis(ctx context.Context) {
+	var batched []*applyBytesTask
+	var requests []*rpc.UpdateApiRequest
+	for _, t := range p.apiTasks {
+		task := t.(*applyBytesTask)
+		_, req, err := apiPatchRequest(task.bytes, task.project)
+		if err != nil {
+			continue // the task reports this when it runs
+		}
+		batched = append(batched, task)
+		requests = append(requests, req)
+	}
+	if len(requests) == 0 {
+		return
+	}
+	errs := visitor.UpdateApis(ctx, batched[0].client, requests)
+	for i, task := range batched {
+		task.batched = true
+		task.batchErr = errs[i]
+	}
+}
+
 type applyBytesTask struct {
 	client      connection.RegistryClient
 	adminClient connection.AdminClient
@@ -181,6 +208,8 @@ type applyBytesTask struct {
 	name        string
 	kind        string
 	bytes       []byte
+	batched     bool  // set if the resource was updated in a batch
+	batchErr    error // the result of the batched update
 }
 
 func (task *applyBytesTask) String() string {
@@ -228,6 +257,9 @@ func (task *applyBytesTask) Run(ctx context.Context) error {
 	case "Project":
 		return applyProjectPatchBytes(ctx, task.adminClient, task.bytes)
 	case "API":
+		if task.batched {
+			return applyBatchedApiPatchBytes(ctx, task.client, task.bytes, task.project, task.path, task.batchErr)
+		}
 		return applyApiPatchBytes(ctx, task.client, task.bytes, task.project, task.path)
 	case "Version":
 		return applyApiVersionPatchBytes(ctx, task.client, task.bytes, task.project, task.path)
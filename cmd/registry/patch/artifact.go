@@ -178,21 +178,25 @@ func applyArtifactPatch(ctx context.Context, client connection.RegistryClient, c
 		Contents:    bytes,
 		Labels:      content.Metadata.Labels,
 		Annotations: content.Metadata.Annotations,
-	}
-	req := &rpc.CreateArtifactRequest{
-		Parent:     name.Parent(),
-		ArtifactId: name.ArtifactID(),
-		Artifact:   artifact,
-	}
-	_, err = client.CreateArtifact(ctx, req)
-	if err != nil {
-		req := &rpc.ReplaceArtifactRequest{
-			Artifact: artifact,
+		Etag:        content.Metadata.Etag,
+	}
+	// An artifact with an etag must already exist, so it is only replaced.
+	if artifact.Etag == "" {
+		req := &rpc.CreateArtifactRequest{
+			Parent:     name.Parent(),
+			ArtifactId: name.ArtifactID(),
+			Artifact:   artifact,
 		}
-		if _, err := client.ReplaceArtifact(ctx, req); err != nil {
-			return fmt.Errorf("ReplaceArtifact: %s", err)
+		if _, err := client.CreateArtifact(ctx, req); err == nil {
+			return nil
 		}
 	}
+	req := &rpc.ReplaceArtifactRequest{
+		Artifact: artifact,
+	}
+	if _, err := client.ReplaceArtifact(ctx, req, callOptions(content.Metadata)...); err != nil {
+		return fmt.Errorf("ReplaceArtifact: %s", err)
+	}
 	return nil
 }
 
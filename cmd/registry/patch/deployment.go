@@ -142,6 +142,7 @@ func applyApiDeploymentPatch(
 			AccessGuidance:     deployment.Data.AccessGuidance,
 			Labels:             deployment.Metadata.Labels,
 			Annotations:        deployment.Metadata.Annotations,
+			Etag:               deployment.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
@@ -149,7 +150,7 @@ func applyApiDeploymentPatch(
 	if err != nil {
 		return err
 	}
-	_, err = client.UpdateApiDeployment(ctx, req)
+	_, err = client.UpdateApiDeployment(ctx, req, callOptions(deployment.Metadata)...)
 	if err != nil {
 		return fmt.Errorf("UpdateApiDeployment: %s", err)
 	}
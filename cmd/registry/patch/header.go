@@ -15,7 +15,9 @@
 package patch
 
 import (
+	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
+	"github.com/googleapis/gax-go/v2"
 	"gopkg.in/yaml.v3"
 )
 
@@ -36,3 +38,11 @@ func readHeaderWithItems(bytes []byte) (encoding.Header, yaml.Node, error) {
 	err := yaml.Unmarshal(bytes, &wrapper)
 	return wrapper.Header, wrapper.Items, err
 }
+
+// callOptions returns the options for a request that applies a resource with the given metadata.
+func callOptions(metadata encoding.Metadata) []gax.CallOption {
+	if metadata.Etag != "" {
+		return []gax.CallOption{connection.WithoutRetries()}
+	}
+	return nil
+}
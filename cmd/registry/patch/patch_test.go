@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/apigee/registry/pkg/application/apihub"
 	"github.com/apigee/registry/pkg/application/controller"
@@ -39,6 +40,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // TestMain will set up a local RegistryServer and grpc.Server for all
@@ -194,7 +196,7 @@ func TestApiPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 			err = visitor.GetAPI(ctx, registryClient, apiName,
 				func(ctx context.Context, api *rpc.Api) error {
@@ -283,7 +285,7 @@ func TestVersionPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 			err = visitor.GetVersion(ctx, registryClient, versionName,
 				func(ctx context.Context, version *rpc.ApiVersion) error {
@@ -372,7 +374,7 @@ func TestSpecPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "hash", "size_bytes", "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "hash", "size_bytes", "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 			err = visitor.GetSpec(ctx, registryClient, specName, false,
 				func(ctx context.Context, spec *rpc.ApiSpec) error {
@@ -464,7 +466,7 @@ func TestDeploymentPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 			err = visitor.GetDeployment(ctx, registryClient, deploymentName,
 				func(ctx context.Context, deployment *rpc.ApiDeployment) error {
@@ -714,6 +716,19 @@ func TestMessageArtifactPatches(t *testing.T) {
 				ResultUri:   "https://example.com",
 			},
 		},
+		{
+			artifactID: "retention-policy",
+			parent:     "apis/a",
+			yamlFile:   "testdata/artifacts/retention-policy.yaml",
+			message: &controller.RetentionPolicy{
+				Id:           "retention-policy", // deprecated field
+				Kind:         "RetentionPolicy",  // deprecated field
+				DisplayName:  "Retention Policy",
+				Description:  "Keep the last ten revisions and all revisions from the last thirty days",
+				KeepCount:    10,
+				KeepDuration: durationpb.New(30 * 24 * time.Hour),
+			},
+		},
 		{
 			artifactID: "references",
 			parent:     "apis/a",
@@ -1315,3 +1330,44 @@ func TestDeploymentImports(t *testing.T) {
 		})
 	}
 }
+
+func TestApiPatchEtag(t *testing.T) {
+	root := "projects/patch-etag-test/locations/global"
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "patch-etag-test", []seeder.RegistryResource{
+		&rpc.Api{Name: root + "/apis/a"},
+	})
+	api, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: root + "/apis/a"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	patch := func(etag string) error {
+		b, err := encoding.EncodeYAML(&encoding.Api{
+			Header: encoding.Header{
+				ApiVersion: encoding.RegistryV1,
+				Kind:       "API",
+				Metadata:   encoding.Metadata{Name: "a", Etag: etag},
+			},
+			Data: encoding.ApiData{DisplayName: etag},
+		})
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+		return applyApiPatchBytes(ctx, registryClient, b, root, "patch.yaml")
+	}
+
+	if err := patch(api.GetEtag()); err != nil {
+		t.Fatalf("Patch with the current etag returned error: %s", err)
+	}
+	// The etag changed with the previous patch, so it is now stale.
+	if err := patch(api.GetEtag()); err == nil || !strings.Contains(err.Error(), "Aborted") {
+		t.Errorf("Patch with a stale etag returned %v, want an Aborted error", err)
+	}
+	got, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: root + "/apis/a"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if got.GetDisplayName() != api.GetEtag() {
+		t.Errorf("Patch with a stale etag changed display name to %q", got.GetDisplayName())
+	}
+}
@@ -114,6 +114,7 @@ func applyApiSpecPatch(
 			SourceUri:   spec.Data.SourceURI,
 			Labels:      spec.Metadata.Labels,
 			Annotations: spec.Metadata.Annotations,
+			Etag:        spec.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
@@ -217,7 +218,7 @@ func applyApiSpecPatch(
 			}
 		}
 	}
-	_, err = client.UpdateApiSpec(ctx, req)
+	_, err = client.UpdateApiSpec(ctx, req, callOptions(spec.Metadata)...)
 	if err != nil {
 		return fmt.Errorf("UpdateApiSpec: %s", err)
 	}
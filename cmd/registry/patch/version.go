@@ -123,10 +123,11 @@ func applyApiVersionPatch(
 			PrimarySpec: version.Data.PrimarySpec,
 			Labels:      version.Metadata.Labels,
 			Annotations: version.Metadata.Annotations,
+			Etag:        version.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
-	_, err = client.UpdateApiVersion(ctx, req)
+	_, err = client.UpdateApiVersion(ctx, req, callOptions(version.Metadata)...)
 	if err != nil {
 		return fmt.Errorf("UpdateApiVersion: %s", err)
 	}
@@ -176,6 +176,12 @@ func TestFullResourceNameFromParent(t *testing.T) {
 			parent:          "projects/demo/locations/global/apis/petstore/versions/1.0.0",
 			want:            generateSpecName(t, "projects/demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi@rev"),
 		},
+		{
+			desc:            "relative specrev pattern",
+			resourcePattern: "projects/demo/locations/global/apis/-/versions/-/specs/openapi@{-1}",
+			parent:          "projects/demo/locations/global/apis/petstore/versions/1.0.0",
+			want:            generateSpecName(t, "projects/demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi@{-1}"),
+		},
 		{
 			desc:            "artifact pattern",
 			resourcePattern: "projects/demo/locations/global/apis/-/versions/-/specs/-/artifacts/complexity",
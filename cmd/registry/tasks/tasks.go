@@ -19,6 +19,9 @@ import (
 	"sync"
 
 	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -58,10 +61,13 @@ func WorkerPool(ctx context.Context, n int, continueOnError bool) (chan<- Task,
 			default:
 				t := task
 				f := func() error {
+					ctx, span := tracing.Tracer().Start(ctx, "tasks.Run",
+						trace.WithAttributes(attribute.String("registry.task", t.String())))
 					err := t.Run(ctx)
 					if err != nil {
 						log.FromContext(ctx).WithError(err).Warnf("task failed: %s", t)
 					}
+					tracing.End(span, err)
 					return err
 				}
 				eg.Go(f) // blocks at n runners
@@ -19,6 +19,12 @@ import (
 	"errors"
 	"sync"
 	"testing"
+
+	"github.com/apigee/registry/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestWorkerPoolContinueOnError(t *testing.T) {
@@ -93,6 +99,41 @@ func TestWorkerPoolIgnoreError(t *testing.T) {
 	}
 }
 
+func TestWorkerPoolTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctx, root := tracing.Tracer().Start(context.Background(), "test")
+	taskQueue, wait := WorkerPool(ctx, 2, true)
+	taskQueue <- &incrTask{new(atomicInt32)}
+	taskQueue <- &failTask{}
+	if err := wait(); err == nil {
+		t.Errorf("wait() returned no error, want the error of the failed task")
+	}
+	root.End()
+
+	failed := map[string]bool{}
+	for _, span := range recorder.Ended() {
+		if span.Name() != "tasks.Run" {
+			continue
+		}
+		if span.Parent().SpanID() != root.SpanContext().SpanID() {
+			t.Errorf("task span has parent %s, want %s", span.Parent().SpanID(), root.SpanContext().SpanID())
+		}
+		for _, kv := range span.Attributes() {
+			if kv.Key == "registry.task" {
+				failed[kv.Value.AsString()] = span.Status().Code == codes.Error
+			}
+		}
+	}
+	want := map[string]bool{"add 1": false, "fail task": true}
+	if len(failed) != len(want) || failed["add 1"] != want["add 1"] || failed["fail task"] != want["fail task"] {
+		t.Errorf("recorded task spans with errors %v, want %v", failed, want)
+	}
+}
+
 type failTask struct {
 }
 
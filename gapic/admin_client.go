@@ -42,14 +42,19 @@ var newAdminClientHook clientHook
 
 // AdminCallOptions contains the retry settings for each method of AdminClient.
 type AdminCallOptions struct {
-	GetStatus       []gax.CallOption
-	GetStorage      []gax.CallOption
-	MigrateDatabase []gax.CallOption
-	ListProjects    []gax.CallOption
-	GetProject      []gax.CallOption
-	CreateProject   []gax.CallOption
-	UpdateProject   []gax.CallOption
-	DeleteProject   []gax.CallOption
+	GetStatus           []gax.CallOption
+	GetStorage          []gax.CallOption
+	MigrateDatabase     []gax.CallOption
+	ReplayNotifications []gax.CallOption
+	ListAuditEntries    []gax.CallOption
+	ListProjects        []gax.CallOption
+	GetProject          []gax.CallOption
+	CreateProject       []gax.CallOption
+	UpdateProject       []gax.CallOption
+	DeleteProject       []gax.CallOption
+	GetProjectQuota     []gax.CallOption
+	ExportProject       []gax.CallOption
+	ImportProject       []gax.CallOption
 }
 
 func defaultAdminGRPCClientOptions() []option.ClientOption {
@@ -66,14 +71,19 @@ func defaultAdminGRPCClientOptions() []option.ClientOption {
 
 func defaultAdminCallOptions() *AdminCallOptions {
 	return &AdminCallOptions{
-		GetStatus:       []gax.CallOption{},
-		GetStorage:      []gax.CallOption{},
-		MigrateDatabase: []gax.CallOption{},
-		ListProjects:    []gax.CallOption{},
-		GetProject:      []gax.CallOption{},
-		CreateProject:   []gax.CallOption{},
-		UpdateProject:   []gax.CallOption{},
-		DeleteProject:   []gax.CallOption{},
+		GetStatus:           []gax.CallOption{},
+		GetStorage:          []gax.CallOption{},
+		MigrateDatabase:     []gax.CallOption{},
+		ReplayNotifications: []gax.CallOption{},
+		ListAuditEntries:    []gax.CallOption{},
+		ListProjects:        []gax.CallOption{},
+		GetProject:          []gax.CallOption{},
+		CreateProject:       []gax.CallOption{},
+		UpdateProject:       []gax.CallOption{},
+		DeleteProject:       []gax.CallOption{},
+		GetProjectQuota:     []gax.CallOption{},
+		ExportProject:       []gax.CallOption{},
+		ImportProject:       []gax.CallOption{},
 	}
 }
 
@@ -86,11 +96,18 @@ type internalAdminClient interface {
 	GetStorage(context.Context, *emptypb.Empty, ...gax.CallOption) (*rpcpb.Storage, error)
 	MigrateDatabase(context.Context, *rpcpb.MigrateDatabaseRequest, ...gax.CallOption) (*MigrateDatabaseOperation, error)
 	MigrateDatabaseOperation(name string) *MigrateDatabaseOperation
+	ReplayNotifications(context.Context, *rpcpb.ReplayNotificationsRequest, ...gax.CallOption) (*rpcpb.ReplayNotificationsResponse, error)
+	ListAuditEntries(context.Context, *rpcpb.ListAuditEntriesRequest, ...gax.CallOption) *AuditEntryIterator
 	ListProjects(context.Context, *rpcpb.ListProjectsRequest, ...gax.CallOption) *ProjectIterator
 	GetProject(context.Context, *rpcpb.GetProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	CreateProject(context.Context, *rpcpb.CreateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	UpdateProject(context.Context, *rpcpb.UpdateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	DeleteProject(context.Context, *rpcpb.DeleteProjectRequest, ...gax.CallOption) error
+	GetProjectQuota(context.Context, *rpcpb.GetProjectQuotaRequest, ...gax.CallOption) (*rpcpb.ProjectQuota, error)
+	ExportProject(context.Context, *rpcpb.ExportProjectRequest, ...gax.CallOption) (*ExportProjectOperation, error)
+	ExportProjectOperation(name string) *ExportProjectOperation
+	ImportProject(context.Context, *rpcpb.ImportProjectRequest, ...gax.CallOption) (*ImportProjectOperation, error)
+	ImportProjectOperation(name string) *ImportProjectOperation
 }
 
 // AdminClient is a client for interacting with .
@@ -167,6 +184,25 @@ func (c *AdminClient) MigrateDatabaseOperation(name string) *MigrateDatabaseOper
 	return c.internalClient.MigrateDatabaseOperation(name)
 }
 
+// ReplayNotifications replayNotifications schedules stored notifications to be delivered again
+// to all configured notification sinks, starting with a specified sequence
+// number.
+// (– api-linter: core::0136::http-uri-suffix=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Not in the official API. –)
+func (c *AdminClient) ReplayNotifications(ctx context.Context, req *rpcpb.ReplayNotificationsRequest, opts ...gax.CallOption) (*rpcpb.ReplayNotificationsResponse, error) {
+	return c.internalClient.ReplayNotifications(ctx, req, opts...)
+}
+
+// ListAuditEntries listAuditEntries returns matching entries of the audit log, in the order
+// that they were recorded.
+// (– api-linter: core::0132::http-uri-parent=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Not in the official API. –)
+// (– api-linter: core::0132::request-parent-required=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Not in the official API. –)
+func (c *AdminClient) ListAuditEntries(ctx context.Context, req *rpcpb.ListAuditEntriesRequest, opts ...gax.CallOption) *AuditEntryIterator {
+	return c.internalClient.ListAuditEntries(ctx, req, opts...)
+}
+
 // ListProjects listProjects returns matching projects.
 // (– api-linter: standard-methods=disabled –)
 // (– api-linter: core::0132::method-signature=disabled
@@ -201,6 +237,42 @@ func (c *AdminClient) DeleteProject(ctx context.Context, req *rpcpb.DeleteProjec
 	return c.internalClient.DeleteProject(ctx, req, opts...)
 }
 
+// GetProjectQuota getProjectQuota returns the limits of a project and its usage of them.
+// (– api-linter: core::0131::response-message-name=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Not in the official API. –)
+func (c *AdminClient) GetProjectQuota(ctx context.Context, req *rpcpb.GetProjectQuotaRequest, opts ...gax.CallOption) (*rpcpb.ProjectQuota, error) {
+	return c.internalClient.GetProjectQuota(ctx, req, opts...)
+}
+
+// ExportProject exportProject returns an archive of a project and all of its resources,
+// revisions, tags and contents that can be restored with ImportProject.
+// The archive is made before the call returns, so the returned operation is
+// always done and can't be retrieved later.
+func (c *AdminClient) ExportProject(ctx context.Context, req *rpcpb.ExportProjectRequest, opts ...gax.CallOption) (*ExportProjectOperation, error) {
+	return c.internalClient.ExportProject(ctx, req, opts...)
+}
+
+// ExportProjectOperation returns a new ExportProjectOperation from a given name.
+// The name must be that of a previously created ExportProjectOperation, possibly from a different process.
+func (c *AdminClient) ExportProjectOperation(name string) *ExportProjectOperation {
+	return c.internalClient.ExportProjectOperation(name)
+}
+
+// ImportProject importProject creates a project from an archive that was returned by
+// ExportProject. Revision IDs and timestamps are kept, so an exported
+// project can be moved between servers or restored after it is deleted.
+// The project is created before the call returns, so the returned operation
+// is always done and can't be retrieved later.
+func (c *AdminClient) ImportProject(ctx context.Context, req *rpcpb.ImportProjectRequest, opts ...gax.CallOption) (*ImportProjectOperation, error) {
+	return c.internalClient.ImportProject(ctx, req, opts...)
+}
+
+// ImportProjectOperation returns a new ImportProjectOperation from a given name.
+// The name must be that of a previously created ImportProjectOperation, possibly from a different process.
+func (c *AdminClient) ImportProjectOperation(name string) *ImportProjectOperation {
+	return c.internalClient.ImportProjectOperation(name)
+}
+
 // adminGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -346,6 +418,64 @@ func (c *adminGRPCClient) MigrateDatabase(ctx context.Context, req *rpcpb.Migrat
 	}, nil
 }
 
+func (c *adminGRPCClient) ReplayNotifications(ctx context.Context, req *rpcpb.ReplayNotificationsRequest, opts ...gax.CallOption) (*rpcpb.ReplayNotificationsResponse, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ReplayNotifications[0:len((*c.CallOptions).ReplayNotifications):len((*c.CallOptions).ReplayNotifications)], opts...)
+	var resp *rpcpb.ReplayNotificationsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ReplayNotifications(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ListAuditEntries(ctx context.Context, req *rpcpb.ListAuditEntriesRequest, opts ...gax.CallOption) *AuditEntryIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListAuditEntries[0:len((*c.CallOptions).ListAuditEntries):len((*c.CallOptions).ListAuditEntries)], opts...)
+	it := &AuditEntryIterator{}
+	req = proto.Clone(req).(*rpcpb.ListAuditEntriesRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.AuditEntry, string, error) {
+		resp := &rpcpb.ListAuditEntriesResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListAuditEntries(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetAuditEntries(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
 func (c *adminGRPCClient) ListProjects(ctx context.Context, req *rpcpb.ListProjectsRequest, opts ...gax.CallOption) *ProjectIterator {
 	ctx = insertMetadata(ctx, c.xGoogMetadata)
 	opts = append((*c.CallOptions).ListProjects[0:len((*c.CallOptions).ListProjects):len((*c.CallOptions).ListProjects)], opts...)
@@ -451,6 +581,59 @@ func (c *adminGRPCClient) DeleteProject(ctx context.Context, req *rpcpb.DeletePr
 	return err
 }
 
+func (c *adminGRPCClient) GetProjectQuota(ctx context.Context, req *rpcpb.GetProjectQuotaRequest, opts ...gax.CallOption) (*rpcpb.ProjectQuota, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).GetProjectQuota[0:len((*c.CallOptions).GetProjectQuota):len((*c.CallOptions).GetProjectQuota)], opts...)
+	var resp *rpcpb.ProjectQuota
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.GetProjectQuota(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ExportProject(ctx context.Context, req *rpcpb.ExportProjectRequest, opts ...gax.CallOption) (*ExportProjectOperation, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ExportProject[0:len((*c.CallOptions).ExportProject):len((*c.CallOptions).ExportProject)], opts...)
+	var resp *longrunningpb.Operation
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ExportProject(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ExportProjectOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, resp),
+	}, nil
+}
+
+func (c *adminGRPCClient) ImportProject(ctx context.Context, req *rpcpb.ImportProjectRequest, opts ...gax.CallOption) (*ImportProjectOperation, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ImportProject[0:len((*c.CallOptions).ImportProject):len((*c.CallOptions).ImportProject)], opts...)
+	var resp *longrunningpb.Operation
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ImportProject(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ImportProjectOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, resp),
+	}, nil
+}
+
 // MigrateDatabaseOperation manages a long-running operation from MigrateDatabase.
 type MigrateDatabaseOperation struct {
 	lro *longrunning.Operation
@@ -520,6 +703,191 @@ func (op *MigrateDatabaseOperation) Name() string {
 	return op.lro.Name()
 }
 
+// ExportProjectOperation manages a long-running operation from ExportProject.
+type ExportProjectOperation struct {
+	lro *longrunning.Operation
+}
+
+// ExportProjectOperation returns a new ExportProjectOperation from a given name.
+// The name must be that of a previously created ExportProjectOperation, possibly from a different process.
+func (c *adminGRPCClient) ExportProjectOperation(name string) *ExportProjectOperation {
+	return &ExportProjectOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, &longrunningpb.Operation{Name: name}),
+	}
+}
+
+// Wait blocks until the long-running operation is completed, returning the response and any errors encountered.
+//
+// See documentation of Poll for error-handling information.
+func (op *ExportProjectOperation) Wait(ctx context.Context, opts ...gax.CallOption) (*rpcpb.ExportProjectResponse, error) {
+	var resp rpcpb.ExportProjectResponse
+	if err := op.lro.WaitWithInterval(ctx, &resp, time.Minute, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Poll fetches the latest state of the long-running operation.
+//
+// Poll also fetches the latest metadata, which can be retrieved by Metadata.
+//
+// If Poll fails, the error is returned and op is unmodified. If Poll succeeds and
+// the operation has completed with failure, the error is returned and op.Done will return true.
+// If Poll succeeds and the operation has completed successfully,
+// op.Done will return true, and the response of the operation is returned.
+// If Poll succeeds and the operation has not completed, the returned response and error are both nil.
+func (op *ExportProjectOperation) Poll(ctx context.Context, opts ...gax.CallOption) (*rpcpb.ExportProjectResponse, error) {
+	var resp rpcpb.ExportProjectResponse
+	if err := op.lro.Poll(ctx, &resp, opts...); err != nil {
+		return nil, err
+	}
+	if !op.Done() {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+// Metadata returns metadata associated with the long-running operation.
+// Metadata itself does not contact the server, but Poll does.
+// To get the latest metadata, call this method after a successful call to Poll.
+// If the metadata is not available, the returned metadata and error are both nil.
+func (op *ExportProjectOperation) Metadata() (*rpcpb.ExportProjectMetadata, error) {
+	var meta rpcpb.ExportProjectMetadata
+	if err := op.lro.Metadata(&meta); err == longrunning.ErrNoMetadata {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// Done reports whether the long-running operation has completed.
+func (op *ExportProjectOperation) Done() bool {
+	return op.lro.Done()
+}
+
+// Name returns the name of the long-running operation.
+// The name is assigned by the server and is unique within the service from which the operation is created.
+func (op *ExportProjectOperation) Name() string {
+	return op.lro.Name()
+}
+
+// ImportProjectOperation manages a long-running operation from ImportProject.
+type ImportProjectOperation struct {
+	lro *longrunning.Operation
+}
+
+// ImportProjectOperation returns a new ImportProjectOperation from a given name.
+// The name must be that of a previously created ImportProjectOperation, possibly from a different process.
+func (c *adminGRPCClient) ImportProjectOperation(name string) *ImportProjectOperation {
+	return &ImportProjectOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, &longrunningpb.Operation{Name: name}),
+	}
+}
+
+// Wait blocks until the long-running operation is completed, returning the response and any errors encountered.
+//
+// See documentation of Poll for error-handling information.
+func (op *ImportProjectOperation) Wait(ctx context.Context, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	var resp rpcpb.Project
+	if err := op.lro.WaitWithInterval(ctx, &resp, time.Minute, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Poll fetches the latest state of the long-running operation.
+//
+// Poll also fetches the latest metadata, which can be retrieved by Metadata.
+//
+// If Poll fails, the error is returned and op is unmodified. If Poll succeeds and
+// the operation has completed with failure, the error is returned and op.Done will return true.
+// If Poll succeeds and the operation has completed successfully,
+// op.Done will return true, and the response of the operation is returned.
+// If Poll succeeds and the operation has not completed, the returned response and error are both nil.
+func (op *ImportProjectOperation) Poll(ctx context.Context, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	var resp rpcpb.Project
+	if err := op.lro.Poll(ctx, &resp, opts...); err != nil {
+		return nil, err
+	}
+	if !op.Done() {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+// Metadata returns metadata associated with the long-running operation.
+// Metadata itself does not contact the server, but Poll does.
+// To get the latest metadata, call this method after a successful call to Poll.
+// If the metadata is not available, the returned metadata and error are both nil.
+func (op *ImportProjectOperation) Metadata() (*rpcpb.ImportProjectMetadata, error) {
+	var meta rpcpb.ImportProjectMetadata
+	if err := op.lro.Metadata(&meta); err == longrunning.ErrNoMetadata {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// Done reports whether the long-running operation has completed.
+func (op *ImportProjectOperation) Done() bool {
+	return op.lro.Done()
+}
+
+// Name returns the name of the long-running operation.
+// The name is assigned by the server and is unique within the service from which the operation is created.
+func (op *ImportProjectOperation) Name() string {
+	return op.lro.Name()
+}
+
+// AuditEntryIterator manages a stream of *rpcpb.AuditEntry.
+type AuditEntryIterator struct {
+	items    []*rpcpb.AuditEntry
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.AuditEntry, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *AuditEntryIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *AuditEntryIterator) Next() (*rpcpb.AuditEntry, error) {
+	var item *rpcpb.AuditEntry
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *AuditEntryIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *AuditEntryIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 // ProjectIterator manages a stream of *rpcpb.Project.
 type ProjectIterator struct {
 	items    []*rpcpb.Project
@@ -45,6 +45,8 @@ type RegistryCallOptions struct {
 	CreateApi                   []gax.CallOption
 	UpdateApi                   []gax.CallOption
 	DeleteApi                   []gax.CallOption
+	UndeleteApi                 []gax.CallOption
+	BatchUpdateApis             []gax.CallOption
 	ListApiVersions             []gax.CallOption
 	GetApiVersion               []gax.CallOption
 	CreateApiVersion            []gax.CallOption
@@ -53,6 +55,7 @@ type RegistryCallOptions struct {
 	ListApiSpecs                []gax.CallOption
 	GetApiSpec                  []gax.CallOption
 	GetApiSpecContents          []gax.CallOption
+	BatchGetApiSpecs            []gax.CallOption
 	CreateApiSpec               []gax.CallOption
 	UpdateApiSpec               []gax.CallOption
 	DeleteApiSpec               []gax.CallOption
@@ -73,8 +76,16 @@ type RegistryCallOptions struct {
 	GetArtifact                 []gax.CallOption
 	GetArtifactContents         []gax.CallOption
 	CreateArtifact              []gax.CallOption
+	BatchCreateArtifacts        []gax.CallOption
 	ReplaceArtifact             []gax.CallOption
 	DeleteArtifact              []gax.CallOption
+	WatchResources              []gax.CallOption
+	SearchResources             []gax.CallOption
+	PruneApiRevisions           []gax.CallOption
+	UploadApiSpecContents       []gax.CallOption
+	StreamApiSpecContents       []gax.CallOption
+	UploadArtifactContents      []gax.CallOption
+	StreamArtifactContents      []gax.CallOption
 }
 
 func defaultRegistryGRPCClientOptions() []option.ClientOption {
@@ -161,6 +172,8 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApi:     []gax.CallOption{},
+		BatchUpdateApis: []gax.CallOption{},
 		ListApiVersions: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -273,6 +286,7 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		BatchGetApiSpecs: []gax.CallOption{},
 		CreateApiSpec: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -553,6 +567,7 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		BatchCreateArtifacts: []gax.CallOption{},
 		ReplaceArtifact: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -581,6 +596,26 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		WatchResources: []gax.CallOption{},
+		SearchResources: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		PruneApiRevisions:      []gax.CallOption{},
+		UploadApiSpecContents:  []gax.CallOption{},
+		StreamApiSpecContents:  []gax.CallOption{},
+		UploadArtifactContents: []gax.CallOption{},
+		StreamArtifactContents: []gax.CallOption{},
 	}
 }
 
@@ -594,6 +629,8 @@ type internalRegistryClient interface {
 	CreateApi(context.Context, *rpcpb.CreateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	UpdateApi(context.Context, *rpcpb.UpdateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	DeleteApi(context.Context, *rpcpb.DeleteApiRequest, ...gax.CallOption) error
+	UndeleteApi(context.Context, *rpcpb.UndeleteApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
+	BatchUpdateApis(context.Context, *rpcpb.BatchUpdateApisRequest, ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error)
 	ListApiVersions(context.Context, *rpcpb.ListApiVersionsRequest, ...gax.CallOption) *ApiVersionIterator
 	GetApiVersion(context.Context, *rpcpb.GetApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	CreateApiVersion(context.Context, *rpcpb.CreateApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
@@ -602,6 +639,7 @@ type internalRegistryClient interface {
 	ListApiSpecs(context.Context, *rpcpb.ListApiSpecsRequest, ...gax.CallOption) *ApiSpecIterator
 	GetApiSpec(context.Context, *rpcpb.GetApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	GetApiSpecContents(context.Context, *rpcpb.GetApiSpecContentsRequest, ...gax.CallOption) (*httpbodypb.HttpBody, error)
+	BatchGetApiSpecs(context.Context, *rpcpb.BatchGetApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error)
 	CreateApiSpec(context.Context, *rpcpb.CreateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	UpdateApiSpec(context.Context, *rpcpb.UpdateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	DeleteApiSpec(context.Context, *rpcpb.DeleteApiSpecRequest, ...gax.CallOption) error
@@ -622,8 +660,16 @@ type internalRegistryClient interface {
 	GetArtifact(context.Context, *rpcpb.GetArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	GetArtifactContents(context.Context, *rpcpb.GetArtifactContentsRequest, ...gax.CallOption) (*httpbodypb.HttpBody, error)
 	CreateArtifact(context.Context, *rpcpb.CreateArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	BatchCreateArtifacts(context.Context, *rpcpb.BatchCreateArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error)
 	ReplaceArtifact(context.Context, *rpcpb.ReplaceArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	DeleteArtifact(context.Context, *rpcpb.DeleteArtifactRequest, ...gax.CallOption) error
+	WatchResources(context.Context, *rpcpb.WatchResourcesRequest, ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error)
+	SearchResources(context.Context, *rpcpb.SearchResourcesRequest, ...gax.CallOption) *SearchResourcesResponse_ResultIterator
+	PruneApiRevisions(context.Context, *rpcpb.PruneApiRevisionsRequest, ...gax.CallOption) (*rpcpb.PruneApiRevisionsResponse, error)
+	UploadApiSpecContents(context.Context, ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error)
+	StreamApiSpecContents(context.Context, *rpcpb.GetApiSpecContentsRequest, ...gax.CallOption) (rpcpb.Registry_StreamApiSpecContentsClient, error)
+	UploadArtifactContents(context.Context, ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error)
+	StreamArtifactContents(context.Context, *rpcpb.GetArtifactContentsRequest, ...gax.CallOption) (rpcpb.Registry_StreamArtifactContentsClient, error)
 }
 
 // RegistryClient is a client for interacting with .
@@ -682,11 +728,24 @@ func (c *RegistryClient) UpdateApi(ctx context.Context, req *rpcpb.UpdateApiRequ
 }
 
 // DeleteApi deleteApi removes a specified API and all of the resources that it
-// owns.
+// owns. If the server has a purge window, the API can be restored with
+// UndeleteApi until the window has passed.
 func (c *RegistryClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApiRequest, opts ...gax.CallOption) error {
 	return c.internalClient.DeleteApi(ctx, req, opts...)
 }
 
+// UndeleteApi undeleteApi restores a deleted API and all of the resources that it
+// owns. APIs can be restored until they are purged.
+func (c *RegistryClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	return c.internalClient.UndeleteApi(ctx, req, opts...)
+}
+
+// BatchUpdateApis batchUpdateApis updates a set of APIs in a single transaction.
+// Either all of the updates succeed or none of them are applied.
+func (c *RegistryClient) BatchUpdateApis(ctx context.Context, req *rpcpb.BatchUpdateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error) {
+	return c.internalClient.BatchUpdateApis(ctx, req, opts...)
+}
+
 // ListApiVersions listApiVersions returns matching versions.
 func (c *RegistryClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	return c.internalClient.ListApiVersions(ctx, req, opts...)
@@ -731,6 +790,12 @@ func (c *RegistryClient) GetApiSpecContents(ctx context.Context, req *rpcpb.GetA
 	return c.internalClient.GetApiSpecContents(ctx, req, opts...)
 }
 
+// BatchGetApiSpecs batchGetApiSpecs returns a set of specs or spec revisions.
+// If any of the specs can't be found, the request fails.
+func (c *RegistryClient) BatchGetApiSpecs(ctx context.Context, req *rpcpb.BatchGetApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error) {
+	return c.internalClient.BatchGetApiSpecs(ctx, req, opts...)
+}
+
 // CreateApiSpec createApiSpec creates a specified spec.
 func (c *RegistryClient) CreateApiSpec(ctx context.Context, req *rpcpb.CreateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	return c.internalClient.CreateApiSpec(ctx, req, opts...)
@@ -841,6 +906,12 @@ func (c *RegistryClient) CreateArtifact(ctx context.Context, req *rpcpb.CreateAr
 	return c.internalClient.CreateArtifact(ctx, req, opts...)
 }
 
+// BatchCreateArtifacts batchCreateArtifacts creates a set of artifacts in a single transaction.
+// Either all of the artifacts are created or none of them are.
+func (c *RegistryClient) BatchCreateArtifacts(ctx context.Context, req *rpcpb.BatchCreateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error) {
+	return c.internalClient.BatchCreateArtifacts(ctx, req, opts...)
+}
+
 // ReplaceArtifact replaceArtifact can be used to replace a specified artifact.
 func (c *RegistryClient) ReplaceArtifact(ctx context.Context, req *rpcpb.ReplaceArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	return c.internalClient.ReplaceArtifact(ctx, req, opts...)
@@ -851,6 +922,60 @@ func (c *RegistryClient) DeleteArtifact(ctx context.Context, req *rpcpb.DeleteAr
 	return c.internalClient.DeleteArtifact(ctx, req, opts...)
 }
 
+// WatchResources watchResources streams notifications of changes to resources under a
+// specified parent as they are made.
+func (c *RegistryClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	return c.internalClient.WatchResources(ctx, req, opts...)
+}
+
+// SearchResources searchResources returns the APIs, versions, specs and deployments in a
+// project whose names, descriptions or spec contents match a query.
+// Results are ordered by decreasing relevance. Unlike the page tokens of
+// List methods, page tokens of searches hold the positions of the next
+// results, so results may be skipped or repeated if resources change
+// while results are paged.
+func (c *RegistryClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResourcesResponse_ResultIterator {
+	return c.internalClient.SearchResources(ctx, req, opts...)
+}
+
+// PruneApiRevisions pruneApiRevisions deletes the spec and deployment revisions of an API
+// that are not kept by its retention policy. Policies are RetentionPolicy
+// artifacts named "retention-policy" that are stored in APIs or projects,
+// and the policy of an API takes precedence over that of its project.
+func (c *RegistryClient) PruneApiRevisions(ctx context.Context, req *rpcpb.PruneApiRevisionsRequest, opts ...gax.CallOption) (*rpcpb.PruneApiRevisionsResponse, error) {
+	return c.internalClient.PruneApiRevisions(ctx, req, opts...)
+}
+
+// UploadApiSpecContents uploadApiSpecContents creates or updates a spec with contents that are
+// sent in a stream of chunks, which allows contents that are larger than
+// the maximum message size. The spec is created if it does not exist, as
+// with UpdateApiSpec when allow_missing is set. Contents that are larger
+// than the server's limit are rejected with RESOURCE_EXHAUSTED.
+func (c *RegistryClient) UploadApiSpecContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error) {
+	return c.internalClient.UploadApiSpecContents(ctx, opts...)
+}
+
+// StreamApiSpecContents streamApiSpecContents returns the contents of a specified spec in a
+// stream of chunks. The contents are the same as those returned by
+// GetApiSpecContents.
+func (c *RegistryClient) StreamApiSpecContents(ctx context.Context, req *rpcpb.GetApiSpecContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_StreamApiSpecContentsClient, error) {
+	return c.internalClient.StreamApiSpecContents(ctx, req, opts...)
+}
+
+// UploadArtifactContents uploadArtifactContents creates or replaces an artifact with contents
+// that are sent in a stream of chunks. Contents that are larger than the
+// server's limit are rejected with RESOURCE_EXHAUSTED.
+func (c *RegistryClient) UploadArtifactContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error) {
+	return c.internalClient.UploadArtifactContents(ctx, opts...)
+}
+
+// StreamArtifactContents streamArtifactContents returns the contents of a specified artifact in
+// a stream of chunks. The contents are the same as those returned by
+// GetArtifactContents.
+func (c *RegistryClient) StreamArtifactContents(ctx context.Context, req *rpcpb.GetArtifactContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_StreamArtifactContentsClient, error) {
+	return c.internalClient.StreamArtifactContents(ctx, req, opts...)
+}
+
 // registryGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -1061,6 +1186,50 @@ func (c *registryGRPCClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApi
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApi[0:len((*c.CallOptions).UndeleteApi):len((*c.CallOptions).UndeleteApi)], opts...)
+	var resp *rpcpb.Api
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApi(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchUpdateApis(ctx context.Context, req *rpcpb.BatchUpdateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchUpdateApis[0:len((*c.CallOptions).BatchUpdateApis):len((*c.CallOptions).BatchUpdateApis)], opts...)
+	var resp *rpcpb.BatchUpdateApisResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchUpdateApis(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 
@@ -1279,6 +1448,28 @@ func (c *registryGRPCClient) GetApiSpecContents(ctx context.Context, req *rpcpb.
 	return resp, nil
 }
 
+func (c *registryGRPCClient) BatchGetApiSpecs(ctx context.Context, req *rpcpb.BatchGetApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchGetApiSpecs[0:len((*c.CallOptions).BatchGetApiSpecs):len((*c.CallOptions).BatchGetApiSpecs)], opts...)
+	var resp *rpcpb.BatchGetApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchGetApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) CreateApiSpec(ctx context.Context, req *rpcpb.CreateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
@@ -1803,6 +1994,28 @@ func (c *registryGRPCClient) CreateArtifact(ctx context.Context, req *rpcpb.Crea
 	return resp, nil
 }
 
+func (c *registryGRPCClient) BatchCreateArtifacts(ctx context.Context, req *rpcpb.BatchCreateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchCreateArtifacts[0:len((*c.CallOptions).BatchCreateArtifacts):len((*c.CallOptions).BatchCreateArtifacts)], opts...)
+	var resp *rpcpb.BatchCreateArtifactsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchCreateArtifacts(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) ReplaceArtifact(ctx context.Context, req *rpcpb.ReplaceArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
@@ -1843,6 +2056,154 @@ func (c *registryGRPCClient) DeleteArtifact(ctx context.Context, req *rpcpb.Dele
 	return err
 }
 
+func (c *registryGRPCClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	var resp rpcpb.Registry_WatchResourcesClient
+	opts = append((*c.CallOptions).WatchResources[0:len((*c.CallOptions).WatchResources):len((*c.CallOptions).WatchResources)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.WatchResources(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResourcesResponse_ResultIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).SearchResources[0:len((*c.CallOptions).SearchResources):len((*c.CallOptions).SearchResources)], opts...)
+	it := &SearchResourcesResponse_ResultIterator{}
+	req = proto.Clone(req).(*rpcpb.SearchResourcesRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.SearchResourcesResponse_Result, string, error) {
+		resp := &rpcpb.SearchResourcesResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.SearchResources(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetResults(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) PruneApiRevisions(ctx context.Context, req *rpcpb.PruneApiRevisionsRequest, opts ...gax.CallOption) (*rpcpb.PruneApiRevisionsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).PruneApiRevisions[0:len((*c.CallOptions).PruneApiRevisions):len((*c.CallOptions).PruneApiRevisions)], opts...)
+	var resp *rpcpb.PruneApiRevisionsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.PruneApiRevisions(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) UploadApiSpecContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_UploadApiSpecContentsClient
+	opts = append((*c.CallOptions).UploadApiSpecContents[0:len((*c.CallOptions).UploadApiSpecContents):len((*c.CallOptions).UploadApiSpecContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UploadApiSpecContents(ctx, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) StreamApiSpecContents(ctx context.Context, req *rpcpb.GetApiSpecContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_StreamApiSpecContentsClient, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	var resp rpcpb.Registry_StreamApiSpecContentsClient
+	opts = append((*c.CallOptions).StreamApiSpecContents[0:len((*c.CallOptions).StreamApiSpecContents):len((*c.CallOptions).StreamApiSpecContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.StreamApiSpecContents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) UploadArtifactContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_UploadArtifactContentsClient
+	opts = append((*c.CallOptions).UploadArtifactContents[0:len((*c.CallOptions).UploadArtifactContents):len((*c.CallOptions).UploadArtifactContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UploadArtifactContents(ctx, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) StreamArtifactContents(ctx context.Context, req *rpcpb.GetArtifactContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_StreamArtifactContentsClient, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	var resp rpcpb.Registry_StreamArtifactContentsClient
+	opts = append((*c.CallOptions).StreamArtifactContents[0:len((*c.CallOptions).StreamArtifactContents):len((*c.CallOptions).StreamArtifactContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.StreamArtifactContents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // ApiDeploymentIterator manages a stream of *rpcpb.ApiDeployment.
 type ApiDeploymentIterator struct {
 	items    []*rpcpb.ApiDeployment
@@ -2078,6 +2439,53 @@ func (it *ArtifactIterator) takeBuf() interface{} {
 	return b
 }
 
+// SearchResourcesResponse_ResultIterator manages a stream of *rpcpb.SearchResourcesResponse_Result.
+type SearchResourcesResponse_ResultIterator struct {
+	items    []*rpcpb.SearchResourcesResponse_Result
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.SearchResourcesResponse_Result, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *SearchResourcesResponse_ResultIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *SearchResourcesResponse_ResultIterator) Next() (*rpcpb.SearchResourcesResponse_Result, error) {
+	var item *rpcpb.SearchResourcesResponse_Result
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *SearchResourcesResponse_ResultIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *SearchResourcesResponse_ResultIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 func (c *RegistryClient) GrpcClient() rpcpb.RegistryClient {
 	return c.internalClient.(*registryGRPCClient).registryClient
 }
@@ -63,6 +63,9 @@ func CreateFlagSet() *pflag.FlagSet {
 	flags.String("registry.location", "", "the API Registry location")
 	flags.String("registry.project", "", "the API Registry project")
 	flags.String("registry.token", "", "the token to use for authorization to the API Registry")
+	flags.String("registry.ca-file", "", "file of PEM-encoded CA certificates that verify the server")
+	flags.String("registry.cert-file", "", "file of the PEM-encoded client certificate to present to the server")
+	flags.String("registry.key-file", "", "file of the PEM-encoded client key")
 	return flags
 }
 
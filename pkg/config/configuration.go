@@ -38,7 +38,10 @@ type Registry struct {
 	Insecure bool   `mapstructure:"insecure" yaml:"insecure"` // if true, connect over HTTP
 	Location string `mapstructure:"location" yaml:"location"`
 	Project  string `mapstructure:"project" yaml:"project"`
-	Token    string `mapstructure:"token" yaml:"-"` // generated from TokenSource
+	Token    string `mapstructure:"token" yaml:"-"`                       // generated from TokenSource
+	CAFile   string `mapstructure:"ca-file" yaml:"ca-file,omitempty"`     // CA certificates that verify the server
+	CertFile string `mapstructure:"cert-file" yaml:"cert-file,omitempty"` // client certificate
+	KeyFile  string `mapstructure:"key-file" yaml:"key-file,omitempty"`   // client key
 }
 
 // if a name is unqualified, attempt this namespace
@@ -252,12 +252,15 @@ func TestManipulations(t *testing.T) {
 		},
 	}
 	want := map[string]interface{}{
-		"registry.address":  c.Registry.Address,
-		"registry.insecure": c.Registry.Insecure,
-		"registry.location": c.Registry.Location,
-		"registry.project":  c.Registry.Project,
-		"registry.token":    c.Registry.Token,
-		"token-source":      "",
+		"registry.address":   c.Registry.Address,
+		"registry.ca-file":   c.Registry.CAFile,
+		"registry.cert-file": c.Registry.CertFile,
+		"registry.insecure":  c.Registry.Insecure,
+		"registry.key-file":  c.Registry.KeyFile,
+		"registry.location":  c.Registry.Location,
+		"registry.project":   c.Registry.Project,
+		"registry.token":     c.Registry.Token,
+		"token-source":       "",
 	}
 	m, err := c.FlatMap()
 	if err != nil {
@@ -453,7 +456,10 @@ func TestProperies(t *testing.T) {
 	got := c.Properties()
 	want := []string{
 		"registry.address",
+		"registry.ca-file",
+		"registry.cert-file",
 		"registry.insecure",
+		"registry.key-file",
 		"registry.location",
 		"registry.project",
 		"registry.token",
@@ -19,10 +19,15 @@ import (
 	"fmt"
 
 	"github.com/apigee/registry/gapic"
+	"github.com/apigee/registry/pkg/tlsconfig"
+	"github.com/googleapis/gax-go/v2"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/oauth2"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/oauth"
 )
 
 func clientOptions(config Config) ([]option.ClientOption, error) {
@@ -31,12 +36,35 @@ func clientOptions(config Config) ([]option.ClientOption, error) {
 		return nil, fmt.Errorf("rpc error: address must be set")
 	}
 	opts = append(opts, option.WithEndpoint(config.Address))
+	// Calls are traced and carry trace contexts to the server.
+	tracingOption := grpc.WithStatsHandler(otelgrpc.NewClientHandler())
 	if config.Insecure {
-		conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()), tracingOption)
 		if err != nil {
 			return nil, err
 		}
 		opts = append(opts, option.WithGRPCConn(conn))
+	} else if config.CAFile != "" || config.CertFile != "" {
+		tlsConfig, err := tlsconfig.ClientConfig(config.CAFile, config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), tracingOption}
+		if config.Token != "" {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(oauth.TokenSource{
+				TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+					AccessToken: config.Token,
+					TokenType:   "Bearer",
+				}),
+			}))
+		}
+		conn, err := grpc.Dial(config.Address, dialOpts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithGRPCConn(conn))
+	} else {
+		opts = append(opts, option.WithGRPCDialOption(tracingOption))
 	}
 	if config.Token != "" {
 		opts = append(opts, option.WithTokenSource(oauth2.StaticTokenSource(
@@ -112,3 +140,10 @@ func NewProvisioningClientWithSettings(ctx context.Context, config Config) (Prov
 	}
 	return gapic.NewProvisioningClient(ctx, opts...)
 }
+
+// WithoutRetries disables the default retries of a call. It should be used for
+// requests with etags because they fail with ABORTED when the etag doesn't match,
+// which the default settings retry but which would fail again in the same way.
+func WithoutRetries() gax.CallOption {
+	return gax.WithRetry(nil)
+}
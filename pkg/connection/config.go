@@ -24,11 +24,14 @@ import (
 
 // Config configures the client.
 type Config struct {
-	Address  string `mapstructure:"address"`  // service address
-	Insecure bool   `mapstructure:"insecure"` // if true, connect over HTTP
-	Location string `mapstructure:"location"` // optional
-	Project  string `mapstructure:"project"`  // optional
-	Token    string `mapstructure:"token"`    // bearer token
+	Address  string `mapstructure:"address"`   // service address
+	Insecure bool   `mapstructure:"insecure"`  // if true, connect over HTTP
+	Location string `mapstructure:"location"`  // optional
+	Project  string `mapstructure:"project"`   // optional
+	Token    string `mapstructure:"token"`     // bearer token
+	CAFile   string `mapstructure:"ca-file"`   // optional, CA certificates that verify the server
+	CertFile string `mapstructure:"cert-file"` // optional, client certificate for mutual TLS
+	KeyFile  string `mapstructure:"key-file"`  // optional, client key for mutual TLS
 }
 
 // If set, ActiveConfig() returns this configuration.
@@ -56,6 +59,9 @@ func ActiveConfig() (Config, error) {
 		Location: c.Registry.Location,
 		Project:  c.Registry.Project,
 		Token:    c.Registry.Token,
+		CAFile:   c.Registry.CAFile,
+		CertFile: c.Registry.CertFile,
+		KeyFile:  c.Registry.KeyFile,
 	}, nil
 }
 
@@ -74,6 +80,9 @@ func ReadConfig(name string) (Config, error) {
 		Location: c.Registry.Location,
 		Project:  c.Registry.Project,
 		Token:    c.Registry.Token,
+		CAFile:   c.Registry.CAFile,
+		CertFile: c.Registry.CertFile,
+		KeyFile:  c.Registry.KeyFile,
 	}
 
 	return config, err
@@ -25,6 +25,9 @@ type Metadata struct {
 	Parent      string            `yaml:"parent,omitempty"`
 	Labels      map[string]string `yaml:"labels,omitempty"`
 	Annotations map[string]string `yaml:"annotations,omitempty"`
+	// Etag is optional. When it is set, changes are only applied if the
+	// resource exists and its current etag matches.
+	Etag string `yaml:"etag,omitempty"`
 }
 
 const RegistryV1 = "apigeeregistry/v1"
@@ -22,6 +22,9 @@ import (
 	"time"
 
 	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/tlsconfig"
+	"github.com/apigee/registry/pkg/tracing"
+	"github.com/apigee/registry/server/registry/authz"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -39,10 +42,7 @@ func CallLogger(opts ...log.Option) grpc.UnaryServerInterceptor {
 	// Each request will share this logger as a base template.
 	sharedLogger := log.NewLogger(opts...)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		reqInfo := map[string]interface{}{
-			"request_id": fmt.Sprintf("%.8s", uuid.New()),
-			"method":     filepath.Base(info.FullMethod),
-		}
+		reqInfo := callInfo(ctx, info.FullMethod)
 
 		if r, ok := req.(resourceOperation); ok {
 			reqInfo["resource"] = r.GetName()
@@ -70,54 +70,109 @@ func CallLogger(opts ...log.Option) grpc.UnaryServerInterceptor {
 		}
 
 		// Bind response details before logging a response.
-		logger = logger.WithFields(respInfo)
+		logResult(logger.WithFields(respInfo), err)
+		return resp, err
+	}
+}
 
-		// Error messages may include a status code, but we want to log messages and codes separately.
-		if err != nil {
-			st, _ := status.FromError(err)
-			unwrapped := errors.New(st.Message())
-			logger = logger.WithError(unwrapped)
-		}
+// StreamCallLogger returns a gRPC server interceptor for logging streaming API operations.
+// Streams are logged when they start and when they end. Their messages aren't logged.
+func StreamCallLogger(opts ...log.Option) grpc.StreamServerInterceptor {
+	sharedLogger := log.NewLogger(opts...)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		logger := log.WithInboundFields(ctx, sharedLogger).WithFields(callInfo(ctx, info.FullMethod))
 
-		switch status.Code(err) {
-		case codes.OK:
-			logger.Info("Success.")
-		case codes.Canceled:
-			logger.Info("Canceled.")
-		case codes.Unknown:
-			logger.Error("Unknown error.")
-		case codes.InvalidArgument:
-			logger.Error("Invalid argument.")
-		case codes.DeadlineExceeded:
-			logger.Error("Deadline exceeded.")
-		case codes.NotFound:
-			logger.Info("Not found.")
-		case codes.AlreadyExists:
-			logger.Error("Already exists.")
-		case codes.PermissionDenied:
-			logger.Error("Permission denied.")
-		case codes.ResourceExhausted:
-			logger.Error("Resource exhausted.")
-		case codes.FailedPrecondition:
-			logger.Error("Failed precondition.")
-		case codes.Aborted:
-			logger.Error("Aborted.")
-		case codes.OutOfRange:
-			logger.Error("Out of range.")
-		case codes.Unimplemented:
-			logger.Error("Unimplemented.")
-		case codes.Internal:
-			logger.Error("Internal error.")
-		case codes.Unavailable:
-			logger.Info("Unavailable.")
-		case codes.DataLoss:
-			logger.Info("Data loss.")
-		case codes.Unauthenticated:
-			logger.Info("Unauthenticated.")
-		default:
-			logger.Info("User error.")
-		}
+		logger.Info("Handling request.")
+		start := time.Now()
+		err := handler(srv, &loggedStream{ServerStream: ss, ctx: log.NewContext(ctx, logger)})
 
-		return resp, err
+		logResult(logger.WithFields(map[string]interface{}{
+			"duration":    time.Since(start),
+			"status_code": status.Code(err),
+		}), err)
+		return err
+	}
+}
+
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
+
+// callInfo returns the fields that identify a call and its caller.
+func callInfo(ctx context.Context, method string) map[string]interface{} {
+	info := map[string]interface{}{
+		"request_id": fmt.Sprintf("%.8s", uuid.New()),
+		"method":     filepath.Base(method),
+	}
+
+	if identity := tlsconfig.PeerIdentity(ctx); identity != "" {
+		info["client_cert"] = identity
+	}
+
+	// Callers that were authenticated by an earlier interceptor are identified by their principals.
+	if principal, ok := authz.FromContext(ctx); ok {
+		info["principal"] = principal
+	}
+
+	// Correlate the logs of the request with its trace.
+	if traceID, spanID := tracing.IDs(ctx); traceID != "" {
+		info["trace_id"] = traceID
+		info["span_id"] = spanID
+	}
+	return info
+}
+
+// logResult logs the end of a call with a level and message for its status.
+func logResult(logger log.Logger, err error) {
+	// Error messages may include a status code, but we want to log messages and codes separately.
+	if err != nil {
+		st, _ := status.FromError(err)
+		unwrapped := errors.New(st.Message())
+		logger = logger.WithError(unwrapped)
+	}
+
+	switch status.Code(err) {
+	case codes.OK:
+		logger.Info("Success.")
+	case codes.Canceled:
+		logger.Info("Canceled.")
+	case codes.Unknown:
+		logger.Error("Unknown error.")
+	case codes.InvalidArgument:
+		logger.Error("Invalid argument.")
+	case codes.DeadlineExceeded:
+		logger.Error("Deadline exceeded.")
+	case codes.NotFound:
+		logger.Info("Not found.")
+	case codes.AlreadyExists:
+		logger.Error("Already exists.")
+	case codes.PermissionDenied:
+		logger.Error("Permission denied.")
+	case codes.ResourceExhausted:
+		logger.Error("Resource exhausted.")
+	case codes.FailedPrecondition:
+		logger.Error("Failed precondition.")
+	case codes.Aborted:
+		logger.Error("Aborted.")
+	case codes.OutOfRange:
+		logger.Error("Out of range.")
+	case codes.Unimplemented:
+		logger.Error("Unimplemented.")
+	case codes.Internal:
+		logger.Error("Internal error.")
+	case codes.Unavailable:
+		logger.Info("Unavailable.")
+	case codes.DataLoss:
+		logger.Info("Data loss.")
+	case codes.Unauthenticated:
+		logger.Info("Unauthenticated.")
+	default:
+		logger.Info("User error.")
 	}
 }
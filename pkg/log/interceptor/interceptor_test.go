@@ -0,0 +1,170 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/server/registry/authz"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestCallLoggerTraceIDs(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01, 0x02, 0x03},
+		SpanID:  trace.SpanID{0x04, 0x05, 0x06},
+	})
+	tests := []struct {
+		desc string
+		ctx  context.Context
+		want []string
+		skip []string
+	}{
+		{
+			desc: "traced",
+			ctx:  trace.ContextWithSpanContext(context.Background(), sc),
+			want: []string{
+				`"trace_id":"` + sc.TraceID().String() + `"`,
+				`"span_id":"` + sc.SpanID().String() + `"`,
+			},
+		},
+		{
+			desc: "untraced",
+			ctx:  context.Background(),
+			skip: []string{"trace_id", "span_id"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := CallLogger(log.JSONFormat(&buf))
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+			if _, err := logger(test.ctx, nil, info, handler); err != nil {
+				t.Fatalf("CallLogger() returned error: %s", err)
+			}
+			checkLines(t, buf.String(), test.want, test.skip)
+		})
+	}
+}
+
+// checkLines fails the test unless logged has a request and a response entry
+// that contain each of want and none of skip.
+func checkLines(t *testing.T, logged string, want, skip []string) {
+	t.Helper()
+	lines := strings.Split(strings.TrimSpace(logged), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("logged %q, want a request and a response entry", logged)
+	}
+	for _, line := range lines {
+		for _, w := range want {
+			if !strings.Contains(line, w) {
+				t.Errorf("logged %s, want it to contain %s", line, w)
+			}
+		}
+		for _, s := range skip {
+			if strings.Contains(line, s) {
+				t.Errorf("logged %s, want it to not contain %s", line, s)
+			}
+		}
+	}
+}
+
+func TestCallLoggerClientCert(t *testing.T) {
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "client"},
+		EmailAddresses: []string{"client@example.com"},
+	}
+	tests := []struct {
+		desc  string
+		state tls.ConnectionState
+		want  []string
+		skip  []string
+	}{
+		{
+			desc:  "verified",
+			state: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}},
+			want:  []string{`"client_cert":"client@example.com"`},
+		},
+		{
+			desc:  "unverified",
+			state: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+			skip:  []string{"client_cert"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: test.state}})
+			var buf bytes.Buffer
+			logger := CallLogger(log.JSONFormat(&buf))
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+			if _, err := logger(ctx, nil, info, handler); err != nil {
+				t.Fatalf("CallLogger() returned error: %s", err)
+			}
+			checkLines(t, buf.String(), test.want, test.skip)
+		})
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamCallLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := StreamCallLogger(log.JSONFormat(&buf))
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Watch"}
+	ctx := authz.NewContext(context.Background(), "alice@example.com")
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		// Handlers log with the logger of the call.
+		log.Info(ss.Context(), "Sending.")
+		return status.Error(codes.Unavailable, "closed")
+	}
+	if err := logger(nil, &testStream{ctx: ctx}, info, handler); status.Code(err) != codes.Unavailable {
+		t.Fatalf("StreamCallLogger() returned %v, want the error of the handler", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("StreamCallLogger() logged %q, want a request, handler and response entry", buf.String())
+	}
+	for _, line := range lines {
+		for _, want := range []string{`"method":"Watch"`, `"principal":"alice@example.com"`} {
+			if !strings.Contains(line, want) {
+				t.Errorf("StreamCallLogger() logged %s, want it to contain %s", line, want)
+			}
+		}
+	}
+	if want := `"status_code":14`; !strings.Contains(lines[2], want) {
+		t.Errorf("StreamCallLogger() logged %s, want it to contain %s", lines[2], want)
+	}
+}
@@ -189,6 +189,7 @@ var artifactMessageTypes map[string]messageFactory = map[string]messageFactory{
 	"google.cloud.apigeeregistry.v1.apihub.TaxonomyList":         func() proto.Message { return new(apihub.TaxonomyList) },
 	"google.cloud.apigeeregistry.v1.controller.Manifest":         func() proto.Message { return new(controller.Manifest) },
 	"google.cloud.apigeeregistry.v1.controller.Receipt":          func() proto.Message { return new(controller.Receipt) },
+	"google.cloud.apigeeregistry.v1.controller.RetentionPolicy":  func() proto.Message { return new(controller.RetentionPolicy) },
 	"google.cloud.apigeeregistry.v1.scoring.Score":               func() proto.Message { return new(scoring.Score) },
 	"google.cloud.apigeeregistry.v1.scoring.ScoreDefinition":     func() proto.Message { return new(scoring.ScoreDefinition) },
 	"google.cloud.apigeeregistry.v1.scoring.ScoreCard":           func() proto.Message { return new(scoring.ScoreCard) },
@@ -209,6 +209,11 @@ func TestProtobufMessageTypes(t *testing.T) {
 			messageType: "google.cloud.apigeeregistry.v1.controller.Receipt",
 			mimeType:    "application/octet-stream;type=google.cloud.apigeeregistry.v1.controller.Receipt",
 		},
+		{
+			kind:        "RetentionPolicy",
+			messageType: "google.cloud.apigeeregistry.v1.controller.RetentionPolicy",
+			mimeType:    "application/octet-stream;type=google.cloud.apigeeregistry.v1.controller.RetentionPolicy",
+		},
 		{
 			kind:        "Score",
 			messageType: "google.cloud.apigeeregistry.v1.scoring.Score",
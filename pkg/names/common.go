@@ -17,6 +17,7 @@ package names
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -30,8 +31,44 @@ const (
 
 	// The format of a custom revision tag.
 	revisionTag = `([a-z0-9-]+)`
+
+	// The format of a revision reference, which is a revision ID or tag that may
+	// be followed by "~N", or "{-N}" for the Nth revision before the latest.
+	revisionReference = `([a-z0-9-]+(?:~[0-9]+)?|\{-[0-9]+\})`
 )
 
+// LatestRevision refers to the latest revision of a spec or deployment,
+// unless one of its revisions has a tag with the same name.
+const LatestRevision = "latest"
+
+var relativeRevisionRegexp = regexp.MustCompile(`^(?:\{-([0-9]+)\}|([a-z0-9-]+)~([0-9]+))$`)
+
+// RelativeRevision parses a revision reference that refers to a revision
+// relative to the latest revision or to another revision: "latest" refers to
+// the latest revision, "{-N}" to the Nth revision before the latest revision,
+// and "ID~N" to the Nth revision before the revision with the ID or tag ID.
+// It returns the ID of the base revision, which is empty for the latest
+// revision, and the number of revisions before it. ok is false for references
+// to revision IDs and tags.
+func RelativeRevision(ref string) (base string, n int, ok bool) {
+	if ref == LatestRevision {
+		return "", 0, true
+	}
+	m := relativeRevisionRegexp.FindStringSubmatch(ref)
+	if m == nil {
+		return "", 0, false
+	}
+	offset := m[1]
+	if offset == "" {
+		base, offset = m[2], m[3]
+	}
+	n, err := strconv.Atoi(offset)
+	if err != nil {
+		return "", 0, false
+	}
+	return base, n, true
+}
+
 // The format of a custom resource identifier.
 // User provided identifiers should be validated according to this format.
 var customIdentifier = regexp.MustCompile(`^[a-z0-9-.]+$`)
@@ -20,7 +20,7 @@ import (
 )
 
 var deploymentRevisionRegexp = regexp.MustCompile(fmt.Sprintf("^projects/%s/locations/%s/apis/%s/deployments/%s(?:@%s)?$",
-	identifier, Location, identifier, identifier, revisionTag))
+	identifier, Location, identifier, identifier, revisionReference))
 
 var deploymentRevisionCollectionRegexp = regexp.MustCompile(fmt.Sprintf("^projects/%s/locations/%s/apis/%s/deployments/%s@$",
 	identifier, Location, identifier, identifier))
@@ -85,7 +85,8 @@ func (s DeploymentRevision) String() string {
 	}
 }
 
-// ParseDeploymentRevision parses the name of a deployment.
+// ParseDeploymentRevision parses the name of a deployment. Its revision may be
+// a revision ID, a tag or a relative reference (see RelativeRevision).
 func ParseDeploymentRevision(name string) (DeploymentRevision, error) {
 	if !deploymentRevisionRegexp.MatchString(name) {
 		return DeploymentRevision{}, fmt.Errorf("invalid deployment revision name %q: must match %q", name, deploymentRevisionRegexp)
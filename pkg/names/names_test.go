@@ -398,6 +398,34 @@ func TestRevisionTags(t *testing.T) {
 	}
 }
 
+func TestRelativeRevision(t *testing.T) {
+	tests := []struct {
+		ref  string
+		base string
+		n    int
+		ok   bool
+	}{
+		{ref: "latest", ok: true},
+		{ref: "{-0}", ok: true},
+		{ref: "{-3}", n: 3, ok: true},
+		{ref: "prod~0", base: "prod", ok: true},
+		{ref: "1234abcd~2", base: "1234abcd", n: 2, ok: true},
+		{ref: "1234abcd"},
+		{ref: "prod"},
+		{ref: "{-}"},
+		{ref: "{-99999999999999999999}"},
+		{ref: "prod~-1"},
+	}
+	for _, test := range tests {
+		t.Run(test.ref, func(t *testing.T) {
+			base, n, ok := RelativeRevision(test.ref)
+			if base != test.base || n != test.n || ok != test.ok {
+				t.Errorf("RelativeRevision(%q) returned (%q, %d, %t), want (%q, %d, %t)", test.ref, base, n, ok, test.base, test.n, test.ok)
+			}
+		})
+	}
+}
+
 func TestExportableName(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -485,12 +513,17 @@ func TestParseValidNames(t *testing.T) {
 		"projects/p/locations/global/apis/a/versions/v/specs/s@",
 		"projects/p/locations/global/apis/a/versions/v/specs/s@-",
 		"projects/p/locations/global/apis/a/versions/v/specs/s@123",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@latest",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@{-2}",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@prod~1",
 		"projects/p/locations/global/apis/a/deployments",
 		"projects/p/locations/global/apis/a/deployments/-",
 		"projects/p/locations/global/apis/a/deployments/d",
 		"projects/p/locations/global/apis/a/deployments/d@",
 		"projects/p/locations/global/apis/a/deployments/d@-",
 		"projects/p/locations/global/apis/a/deployments/d@123",
+		"projects/p/locations/global/apis/a/deployments/d@{-1}",
+		"projects/p/locations/global/apis/a/deployments/d@123~3",
 		"projects/p/locations/global/artifacts",
 		"projects/p/locations/global/artifacts/-",
 		"projects/p/locations/global/artifacts/x",
@@ -506,6 +539,11 @@ func TestParseValidNames(t *testing.T) {
 func TestParseInvalidNames(t *testing.T) {
 	names := []string{
 		"invalid",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@{-}",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@{1}",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@prod~",
+		"projects/p/locations/global/apis/a/deployments/d@~1",
+		"projects/p/locations/global/apis/a/versions/v/specs/s@{-1}/artifacts/x",
 	}
 	for _, name := range names {
 		_, err := Parse(name)
@@ -20,7 +20,7 @@ import (
 )
 
 var specRevisionRegexp = regexp.MustCompile(fmt.Sprintf("^projects/%s/locations/%s/apis/%s/versions/%s/specs/%s(?:@%s)?$",
-	identifier, Location, identifier, identifier, identifier, revisionTag))
+	identifier, Location, identifier, identifier, identifier, revisionReference))
 
 var specRevisionCollectionRegexp = regexp.MustCompile(fmt.Sprintf("^projects/%s/locations/%s/apis/%s/versions/%s/specs/%s@$",
 	identifier, Location, identifier, identifier, identifier))
@@ -97,7 +97,8 @@ func (s SpecRevision) String() string {
 	}
 }
 
-// ParseSpecRevision parses the name of a spec.
+// ParseSpecRevision parses the name of a spec. Its revision may be a revision
+// ID, a tag or a relative reference (see RelativeRevision).
 func ParseSpecRevision(name string) (SpecRevision, error) {
 	if !specRevisionRegexp.MatchString(name) {
 		return SpecRevision{}, fmt.Errorf("invalid spec revision name %q: must match %q", name, specRevisionRegexp)
@@ -0,0 +1,223 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tlsconfig builds TLS configurations for registry servers and
+// clients from certificate and key files.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// reloadInterval is the minimum time between checks for changed files.
+const reloadInterval = time.Second
+
+// ServerFiles are the files of a server TLS configuration.
+type ServerFiles struct {
+	// CertFile and KeyFile contain the PEM-encoded certificate chain and private key of the server.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile contains PEM-encoded certificates of the authorities that issue client certificates.
+	// If set, client certificates are verified with them.
+	ClientCAFile string
+	// RequireClientCert rejects clients without valid certificates. It requires a ClientCAFile.
+	RequireClientCert bool
+}
+
+// Reloader provides server TLS configurations that are loaded from files.
+// The files are loaded again when they change, so that certificates can be
+// rotated without restarting servers.
+type Reloader struct {
+	files ServerFiles
+
+	mu       sync.Mutex
+	config   *tls.Config
+	modTimes []time.Time
+	checked  time.Time
+}
+
+// NewReloader returns a Reloader that loads the provided files.
+func NewReloader(files ServerFiles) (*Reloader, error) {
+	if files.CertFile == "" || files.KeyFile == "" {
+		return nil, errors.New("a certificate file and a key file are required")
+	}
+	if files.RequireClientCert && files.ClientCAFile == "" {
+		return nil, errors.New("requiring client certificates requires a client CA file")
+	}
+	r := &Reloader{files: files}
+	config, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.config = config
+	r.modTimes = r.stat()
+	r.checked = time.Now()
+	return r, nil
+}
+
+// Config returns a TLS configuration that uses the latest files for each connection.
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current(hello.Context()), nil
+		},
+	}
+}
+
+// current returns the configuration of the files, after loading them again if they changed.
+// If they can't be loaded, the configuration that was loaded before is used.
+func (r *Reloader) current(ctx context.Context) *tls.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < reloadInterval {
+		return r.config
+	}
+	r.checked = time.Now()
+	modTimes := r.stat()
+	if equalTimes(modTimes, r.modTimes) {
+		return r.config
+	}
+	config, err := r.load()
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to reload TLS configuration")
+		return r.config
+	}
+	log.FromContext(ctx).Info("Reloaded TLS configuration")
+	r.config = config
+	r.modTimes = modTimes
+	return r.config
+}
+
+func (r *Reloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %s", err)
+	}
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		// Configurations returned by GetConfigForClient replace the configuration
+		// that gRPC adds "h2" to, so HTTP/2 must be negotiated here. Clients that
+		// only offer HTTP/1.1, such as clients of the gateway, still connect.
+		NextProtos: []string{"h2"},
+	}
+	if r.files.ClientCAFile != "" {
+		pool, err := loadPool(r.files.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if r.files.RequireClientCert {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return config, nil
+}
+
+// stat returns the modification times of the files. Files that can't be read have zero times.
+func (r *Reloader) stat() []time.Time {
+	var times []time.Time
+	for _, name := range []string{r.files.CertFile, r.files.KeyFile, r.files.ClientCAFile} {
+		var t time.Time
+		if info, err := os.Stat(name); name != "" && err == nil {
+			t = info.ModTime()
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ClientConfig returns a client TLS configuration. If caFile is set, server certificates are
+// verified with its authorities instead of the system's. If certFile and keyFile are set,
+// their certificate is presented to servers that verify client certificates.
+func ClientConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pool, err := loadPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("a client certificate requires both a certificate file and a key file")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %s", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+func loadPool(name string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA certificates: %s", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("failed to load CA certificates: %s contains no PEM certificates", name)
+	}
+	return pool, nil
+}
+
+// PeerIdentity returns the identity of the verified client certificate of a call, which is its first email address,
+// its first URI or its subject's common name. It returns "" if the call has no verified client certificate.
+func PeerIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	cert := info.State.VerifiedChains[0][0]
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	default:
+		return cert.Subject.CommonName
+	}
+}
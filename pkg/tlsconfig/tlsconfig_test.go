@@ -0,0 +1,314 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issue creates a certificate that is signed by parent, or a self-signed CA certificate if parent is nil.
+func issue(t *testing.T, template *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %s", err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+// write writes the certificate and key of c to files in dir and returns their names.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+	der, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %s", err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(certFile, certPEM, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func serverTemplate(cn string) *x509.Certificate {
+	return &x509.Certificate{
+		Subject:     pkix.Name{CommonName: cn},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+}
+
+type testFiles struct {
+	serverCA              *testCert
+	dir                   string
+	caFile                string
+	clientCAFile          string
+	certFile, keyFile     string
+	clientCert, clientKey string
+}
+
+func writeFiles(t *testing.T) testFiles {
+	t.Helper()
+	f := testFiles{dir: t.TempDir()}
+	f.serverCA = issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "server-ca"}}, nil)
+	clientCA := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "client-ca"}}, nil)
+	f.caFile, _ = f.serverCA.write(t, f.dir, "server-ca")
+	f.clientCAFile, _ = clientCA.write(t, f.dir, "client-ca")
+	f.certFile, f.keyFile = issue(t, serverTemplate("server-1"), f.serverCA).write(t, f.dir, "server")
+	f.clientCert, f.clientKey = issue(t, &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "client"},
+		EmailAddresses: []string{"client@example.com"},
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, clientCA).write(t, f.dir, "client")
+	return f
+}
+
+// serve starts a gRPC server that records the peer identities of its calls.
+func serve(t *testing.T, config *tls.Config) (string, *[]string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %s", err)
+	}
+	identities := &[]string{}
+	s := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(config)),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			*identities = append(*identities, PeerIdentity(ctx))
+			return handler(ctx, req)
+		}),
+	)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+	return l.Addr().String(), identities
+}
+
+func check(ctx context.Context, addr string, config *tls.Config) error {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestMutualTLS(t *testing.T) {
+	ctx := context.Background()
+	f := writeFiles(t)
+	r, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.keyFile, ClientCAFile: f.clientCAFile})
+	if err != nil {
+		t.Fatalf("NewReloader() returned error: %s", err)
+	}
+	addr, identities := serve(t, r.Config())
+
+	withCert, err := ClientConfig(f.caFile, f.clientCert, f.clientKey)
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if err := check(ctx, addr, withCert); err != nil {
+		t.Fatalf("Call with client certificate failed: %s", err)
+	}
+	withoutCert, err := ClientConfig(f.caFile, "", "")
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if err := check(ctx, addr, withoutCert); err != nil {
+		t.Fatalf("Call without client certificate failed: %s", err)
+	}
+	want := []string{"client@example.com", ""}
+	if len(*identities) != 2 || (*identities)[0] != want[0] || (*identities)[1] != want[1] {
+		t.Errorf("Calls were made by %q, expected %q", *identities, want)
+	}
+
+	untrusted, err := ClientConfig("", "", "")
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if err := check(ctx, addr, untrusted); err == nil {
+		t.Errorf("Call that doesn't trust the server's CA succeeded, expected error")
+	}
+}
+
+func TestNegotiatedProtocol(t *testing.T) {
+	f := writeFiles(t)
+	r, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.keyFile})
+	if err != nil {
+		t.Fatalf("NewReloader() returned error: %s", err)
+	}
+	addr, _ := serve(t, r.Config())
+
+	for _, test := range []struct {
+		offered []string
+		want    string
+	}{
+		{offered: []string{"h2"}, want: "h2"},
+		{offered: []string{"http/1.1"}, want: ""},
+	} {
+		config, err := ClientConfig(f.caFile, "", "")
+		if err != nil {
+			t.Fatalf("ClientConfig() returned error: %s", err)
+		}
+		config.NextProtos = test.offered
+		conn, err := tls.Dial("tcp", addr, config)
+		if err != nil {
+			t.Fatalf("Handshake offering %q failed: %s", test.offered, err)
+		}
+		if got := conn.ConnectionState().NegotiatedProtocol; got != test.want {
+			t.Errorf("Handshake offering %q negotiated %q, expected %q", test.offered, got, test.want)
+		}
+		conn.Close()
+	}
+}
+
+func TestRequireClientCert(t *testing.T) {
+	ctx := context.Background()
+	f := writeFiles(t)
+	r, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.keyFile, ClientCAFile: f.clientCAFile, RequireClientCert: true})
+	if err != nil {
+		t.Fatalf("NewReloader() returned error: %s", err)
+	}
+	addr, _ := serve(t, r.Config())
+
+	withoutCert, err := ClientConfig(f.caFile, "", "")
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if err := check(ctx, addr, withoutCert); err == nil {
+		t.Errorf("Call without client certificate succeeded, expected error")
+	}
+	withCert, err := ClientConfig(f.caFile, f.clientCert, f.clientKey)
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if err := check(ctx, addr, withCert); err != nil {
+		t.Errorf("Call with client certificate failed: %s", err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	f := writeFiles(t)
+	r, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.keyFile})
+	if err != nil {
+		t.Fatalf("NewReloader() returned error: %s", err)
+	}
+	serverName := func() string {
+		t.Helper()
+		cert, err := x509.ParseCertificate(r.current(context.Background()).Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatalf("Failed to parse server certificate: %s", err)
+		}
+		return cert.Subject.CommonName
+	}
+	if got := serverName(); got != "server-1" {
+		t.Fatalf("Server certificate is %q, expected %q", got, "server-1")
+	}
+
+	// Rotate the certificate and make its files look newer.
+	issue(t, serverTemplate("server-2"), f.serverCA).write(t, f.dir, "server")
+	later := time.Now().Add(time.Minute)
+	for _, name := range []string{f.certFile, f.keyFile} {
+		if err := os.Chtimes(name, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r.checked = time.Now().Add(-reloadInterval)
+	if got := serverName(); got != "server-2" {
+		t.Errorf("Server certificate is %q after rotation, expected %q", got, "server-2")
+	}
+
+	// Files that can't be loaded are ignored and the previous certificate is used.
+	if err := os.WriteFile(f.keyFile, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(f.keyFile, later, later); err != nil {
+		t.Fatal(err)
+	}
+	r.checked = time.Now().Add(-reloadInterval)
+	if got := serverName(); got != "server-2" {
+		t.Errorf("Server certificate is %q after invalid rotation, expected %q", got, "server-2")
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	f := writeFiles(t)
+	if _, err := NewReloader(ServerFiles{CertFile: f.certFile}); err == nil {
+		t.Errorf("NewReloader() without key succeeded, expected error")
+	}
+	if _, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.keyFile, RequireClientCert: true}); err == nil {
+		t.Errorf("NewReloader() requiring client certificates without a client CA succeeded, expected error")
+	}
+	if _, err := NewReloader(ServerFiles{CertFile: f.certFile, KeyFile: f.clientKey}); err == nil {
+		t.Errorf("NewReloader() with mismatched key succeeded, expected error")
+	}
+	if _, err := ClientConfig("", f.clientCert, ""); err == nil {
+		t.Errorf("ClientConfig() without client key succeeded, expected error")
+	}
+	if _, err := ClientConfig(f.keyFile, "", ""); err == nil {
+		t.Errorf("ClientConfig() with invalid CA file succeeded, expected error")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing configures OpenTelemetry tracing for registry servers and
+// clients and starts the spans that they share.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters of Config.
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Config selects where spans are exported.
+type Config struct {
+	// Exporter of spans, one of [ "", otlp, stdout, file ].
+	// If empty, spans are not recorded.
+	Exporter string
+	// Endpoint of an OTLP collector, such as "localhost:4317".
+	// If empty, the standard OTEL_EXPORTER_OTLP_ENDPOINT variables are used.
+	Endpoint string
+	// Insecure disables TLS when calling an OTLP collector.
+	Insecure bool
+	// Path of the file that receives spans as JSON objects.
+	Path string
+	// SampleRatio is the fraction of traces that are sampled when a
+	// call has no sampled parent. If zero, all traces are sampled.
+	SampleRatio float64
+	// ServiceName identifies the process in exported spans.
+	ServiceName string
+}
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvExporter    = "REGISTRY_TRACE_EXPORTER"
+	EnvEndpoint    = "REGISTRY_TRACE_ENDPOINT"
+	EnvInsecure    = "REGISTRY_TRACE_INSECURE"
+	EnvPath        = "REGISTRY_TRACE_PATH"
+	EnvSampleRatio = "REGISTRY_TRACE_SAMPLE_RATIO"
+)
+
+// ConfigFromEnv returns a Config for the named service that is read from
+// the REGISTRY_TRACE_* environment variables.
+func ConfigFromEnv(serviceName string) (Config, error) {
+	c := Config{
+		Exporter:    os.Getenv(EnvExporter),
+		Endpoint:    os.Getenv(EnvEndpoint),
+		Path:        os.Getenv(EnvPath),
+		ServiceName: serviceName,
+	}
+	if v := os.Getenv(EnvInsecure); v != "" {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s %q: %s", EnvInsecure, v, err)
+		}
+		c.Insecure = insecure
+	}
+	if v := os.Getenv(EnvSampleRatio); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s %q: %s", EnvSampleRatio, v, err)
+		}
+		c.SampleRatio = ratio
+	}
+	return c, c.Validate()
+}
+
+// Validate returns an error if the Config can't be used to start tracing.
+func (c Config) Validate() error {
+	switch c.Exporter {
+	case ExporterNone, ExporterOTLP, ExporterStdout:
+	case ExporterFile:
+		if c.Path == "" {
+			return fmt.Errorf("invalid tracing: the file exporter requires a path")
+		}
+	default:
+		return fmt.Errorf("invalid tracing exporter %q: must be one of [ otlp, stdout, file ]", c.Exporter)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("invalid tracing sample ratio %v: must be between 0 and 1", c.SampleRatio)
+	}
+	return nil
+}
+
+// Start installs a global tracer provider that exports spans as configured
+// and a propagator that carries trace contexts in W3C headers.
+// The returned function flushes and stops the exporter.
+func Start(ctx context.Context, c Config) (func(context.Context) error, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if c.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closer, err := newExporter(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", c.ServiceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+	sampler := sdktrace.AlwaysSample()
+	if c.SampleRatio > 0 {
+		sampler = sdktrace.TraceIDRatioBased(c.SampleRatio)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			if cerr := closer.Close(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, c Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch c.Exporter {
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		return exporter, nil, err
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		return exporter, nil, err
+	case ExporterFile:
+		f, err := os.OpenFile(c.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return exporter, f, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported tracing exporter %q", c.Exporter)
+	}
+}
+
+// Tracer returns the tracer of registry spans from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer("github.com/apigee/registry")
+}
+
+// End records a non-nil error on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// IDs returns the trace and span IDs of the span in ctx, which are empty
+// if ctx has no span. They can be logged to correlate logs with traces.
+func IDs(ctx context.Context) (traceID, spanID string) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return "", ""
+	}
+	return sc.TraceID().String(), sc.SpanID().String()
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		desc string
+		env  map[string]string
+		want Config
+		err  bool
+	}{
+		{
+			desc: "unset",
+			want: Config{ServiceName: "test"},
+		},
+		{
+			desc: "otlp",
+			env: map[string]string{
+				EnvExporter:    "otlp",
+				EnvEndpoint:    "localhost:4317",
+				EnvInsecure:    "true",
+				EnvSampleRatio: "0.5",
+			},
+			want: Config{Exporter: "otlp", Endpoint: "localhost:4317", Insecure: true, SampleRatio: 0.5, ServiceName: "test"},
+		},
+		{
+			desc: "file",
+			env:  map[string]string{EnvExporter: "file", EnvPath: "/tmp/trace.json"},
+			want: Config{Exporter: "file", Path: "/tmp/trace.json", ServiceName: "test"},
+		},
+		{
+			desc: "file without path",
+			env:  map[string]string{EnvExporter: "file"},
+			err:  true,
+		},
+		{
+			desc: "unknown exporter",
+			env:  map[string]string{EnvExporter: "jaeger"},
+			err:  true,
+		},
+		{
+			desc: "invalid insecure",
+			env:  map[string]string{EnvExporter: "otlp", EnvInsecure: "maybe"},
+			err:  true,
+		},
+		{
+			desc: "invalid sample ratio",
+			env:  map[string]string{EnvExporter: "otlp", EnvSampleRatio: "2"},
+			err:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			for _, k := range []string{EnvExporter, EnvEndpoint, EnvInsecure, EnvPath, EnvSampleRatio} {
+				t.Setenv(k, test.env[k])
+			}
+			got, err := ConfigFromEnv("test")
+			if test.err {
+				if err == nil {
+					t.Errorf("ConfigFromEnv() returned %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConfigFromEnv() returned error: %s", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ConfigFromEnv() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	ctx := context.Background()
+	stop, err := Start(ctx, Config{Exporter: ExporterFile, Path: path, ServiceName: "test"})
+	if err != nil {
+		t.Fatalf("Start() returned error: %s", err)
+	}
+	ctx, span := Tracer().Start(ctx, "test-span")
+	traceID, spanID := IDs(ctx)
+	End(span, errors.New("test error"))
+	if err := stop(context.Background()); err != nil {
+		t.Fatalf("stop() returned error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"Name":"test-span"`, traceID, spanID, "test error", `"Value":"test"`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("exported %s, want it to contain %s", b, want)
+		}
+	}
+}
+
+func TestIDs(t *testing.T) {
+	if traceID, spanID := IDs(context.Background()); traceID != "" || spanID != "" {
+		t.Errorf("IDs() of an untraced context returned %q, %q, want empty IDs", traceID, spanID)
+	}
+}
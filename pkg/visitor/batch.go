@@ -0,0 +1,290 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package visitor
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/apigee/registry/cmd/registry/compress"
+	"github.com/apigee/registry/gapic"
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/rpc"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// batchSize is the largest number of resources sent in a batch request.
+	batchSize = 100
+	// batchBytes limits the contents sent or requested in a batch request
+	// so that messages stay well below the default gRPC message size limit.
+	batchBytes = 2 * 1024 * 1024
+)
+
+// batchParent returns the project (with location) that contains a resource.
+// Batch requests can only include resources of a single project.
+func batchParent(name string) string {
+	parts := strings.SplitN(name, "/", 5)
+	if len(parts) < 4 {
+		return name
+	}
+	return strings.Join(parts[:4], "/")
+}
+
+// batchUnsupported returns true if an error indicates that the server does not implement batch requests.
+func batchUnsupported(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// FetchSpecsContents gets the contents of a set of specs.
+// Specs are fetched in batches with BatchGetApiSpecs, grouped by project and limited by
+// their sizes. If the server doesn't support batches, contents are fetched individually.
+func FetchSpecsContents(ctx context.Context, client *gapic.RegistryClient, specs []*rpc.ApiSpec) error {
+	var batch []*rpc.ApiSpec
+	var size int32
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := fetchSpecsContents(ctx, client, batch)
+		batch, size = nil, 0
+		return err
+	}
+	for _, spec := range specs {
+		if spec.Contents != nil {
+			continue
+		}
+		if len(batch) > 0 && (len(batch) == batchSize ||
+			size+spec.GetSizeBytes() > batchBytes ||
+			batchParent(batch[0].GetName()) != batchParent(spec.GetName())) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		batch = append(batch, spec)
+		size += spec.GetSizeBytes()
+	}
+	return flush()
+}
+
+func fetchSpecsContents(ctx context.Context, client *gapic.RegistryClient, specs []*rpc.ApiSpec) error {
+	request := &rpc.BatchGetApiSpecsRequest{
+		Parent:          batchParent(specs[0].GetName()),
+		Names:           make([]string, len(specs)),
+		IncludeContents: true,
+	}
+	for i, spec := range specs {
+		request.Names[i] = spec.GetName()
+	}
+	response, err := client.BatchGetApiSpecs(ctx, request)
+	if batchUnsupported(err) {
+		for _, spec := range specs {
+			if err := FetchSpecContents(ctx, client, spec); err != nil {
+				return err
+			}
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for i, spec := range specs {
+		fetched := response.ApiSpecs[i]
+		spec.MimeType = fetched.GetMimeType()
+		spec.Contents = fetched.GetContents()
+		if mime.IsGZipCompressed(spec.MimeType) {
+			spec.MimeType = mime.GUnzippedType(spec.MimeType)
+			spec.Contents, err = compress.GUnzippedBytes(spec.Contents)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ArtifactBatch collects artifacts and stores them with BatchCreateArtifacts.
+// Artifacts that already exist are replaced, as they are by SetArtifact.
+// An ArtifactBatch can be used concurrently. Callers must call Flush to
+// store any artifacts that remain after the last call to Set.
+type ArtifactBatch struct {
+	client *gapic.RegistryClient
+
+	mu          sync.Mutex
+	pending     map[string][]*rpc.Artifact // by project
+	sizes       map[string]int
+	unsupported bool
+}
+
+// NewArtifactBatch creates an ArtifactBatch that uses the specified client.
+func NewArtifactBatch(client *gapic.RegistryClient) *ArtifactBatch {
+	return &ArtifactBatch{
+		client:  client,
+		pending: make(map[string][]*rpc.Artifact),
+		sizes:   make(map[string]int),
+	}
+}
+
+// Set adds an artifact to the batch. Full batches are stored immediately.
+func (b *ArtifactBatch) Set(ctx context.Context, artifact *rpc.Artifact) error {
+	parent := batchParent(artifact.GetName())
+	b.mu.Lock()
+	if b.unsupported {
+		b.mu.Unlock()
+		return SetArtifact(ctx, b.client, artifact)
+	}
+	var full []*rpc.Artifact
+	if len(b.pending[parent]) > 0 && b.sizes[parent]+len(artifact.GetContents()) > batchBytes {
+		full = b.take(parent)
+	}
+	b.pending[parent] = append(b.pending[parent], artifact)
+	b.sizes[parent] += len(artifact.GetContents())
+	if full == nil && len(b.pending[parent]) == batchSize {
+		full = b.take(parent)
+	}
+	b.mu.Unlock()
+	return b.store(ctx, parent, full)
+}
+
+// Flush stores all artifacts that have been added to the batch.
+func (b *ArtifactBatch) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	batches := make(map[string][]*rpc.Artifact)
+	for parent := range b.pending {
+		batches[parent] = b.take(parent)
+	}
+	b.mu.Unlock()
+	var firstErr error
+	for parent, artifacts := range batches {
+		if err := b.store(ctx, parent, artifacts); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// take removes and returns the pending artifacts of a project. b.mu must be held.
+func (b *ArtifactBatch) take(parent string) []*rpc.Artifact {
+	artifacts := b.pending[parent]
+	delete(b.pending, parent)
+	delete(b.sizes, parent)
+	return artifacts
+}
+
+// store creates or replaces a set of artifacts in a single request.
+// If the server doesn't support batches, each artifact is set individually.
+// Batches are all-or-nothing, so other errors are returned without
+// retrying the artifacts individually.
+func (b *ArtifactBatch) store(ctx context.Context, parent string, artifacts []*rpc.Artifact) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+	request := &rpc.BatchCreateArtifactsRequest{
+		Parent:          parent,
+		Requests:        make([]*rpc.CreateArtifactRequest, len(artifacts)),
+		ReplaceExisting: true,
+	}
+	for i, artifact := range artifacts {
+		request.Requests[i] = &rpc.CreateArtifactRequest{
+			Parent:     path.Dir(path.Dir(artifact.GetName())),
+			ArtifactId: path.Base(artifact.GetName()),
+			Artifact:   artifact,
+		}
+	}
+	_, err := b.client.BatchCreateArtifacts(ctx, request)
+	if !batchUnsupported(err) {
+		return err
+	}
+	b.mu.Lock()
+	b.unsupported = true
+	b.mu.Unlock()
+	var firstErr error
+	for _, artifact := range artifacts {
+		if err := SetArtifact(ctx, b.client, artifact); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// UpdateApis applies a set of API updates with BatchUpdateApis, grouping them by project.
+// If the server doesn't support batches, updates are applied individually. Batches are
+// all-or-nothing, so if a batch fails, its updates are also applied individually and
+// one invalid update doesn't fail the others. The returned slice contains the result
+// of each update.
+func UpdateApis(ctx context.Context, client *gapic.RegistryClient, requests []*rpc.UpdateApiRequest) []error {
+	errs := make([]error, len(requests))
+	batches := make(map[string][]int) // request indices by project
+	var parents []string
+	for i, request := range requests {
+		parent := batchParent(request.GetApi().GetName())
+		if _, ok := batches[parent]; !ok {
+			parents = append(parents, parent)
+		}
+		batches[parent] = append(batches[parent], i)
+	}
+	unsupported := false
+	for _, parent := range parents {
+		indices := batches[parent]
+		for len(indices) > 0 {
+			n := len(indices)
+			if n > batchSize {
+				n = batchSize
+			}
+			batch := indices[:n]
+			indices = indices[n:]
+			if !unsupported {
+				request := &rpc.BatchUpdateApisRequest{Parent: parent}
+				for _, i := range batch {
+					request.Requests = append(request.Requests, requests[i])
+				}
+				_, err := client.BatchUpdateApis(ctx, request)
+				unsupported = batchUnsupported(err)
+				if err == nil || (!unsupported && len(batch) == 1) {
+					for _, i := range batch {
+						errs[i] = err
+					}
+					continue
+				}
+			}
+			for _, i := range batch {
+				var opts []gax.CallOption
+				if requests[i].GetApi().GetEtag() != "" {
+					// Conditional updates should fail rather than be retried.
+					opts = append(opts, connection.WithoutRetries())
+				}
+				_, errs[i] = client.UpdateApi(ctx, requests[i], opts...)
+			}
+		}
+	}
+	return errs
+}
+
+// handleSpecsWithContents fetches the contents of a set of specs and passes each to a handler.
+func handleSpecsWithContents(ctx context.Context, client *gapic.RegistryClient, specs []*rpc.ApiSpec, handler SpecHandler) error {
+	if err := FetchSpecsContents(ctx, client, specs); err != nil {
+		return err
+	}
+	for _, spec := range specs {
+		if err := handler(ctx, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package visitor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/apigee/registry/cmd/registry/compress"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchHelpers(t *testing.T) {
+	ctx := context.Background()
+	version, err := names.ParseVersion("projects/batch-test/locations/global/apis/a/versions/v")
+	if err != nil {
+		t.Fatalf("Setup: Failed to parse version name: %s", err)
+	}
+	gzipped, err := compress.GZippedBytes([]byte("gzipped"))
+	if err != nil {
+		t.Fatalf("Setup: Failed to compress test data: %s", err)
+	}
+	// Seed more specs than fit in a single batch.
+	count := batchSize + 20
+	seeds := []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:     version.Spec("gzipped").String(),
+			MimeType: "text/plain+gzip",
+			Contents: gzipped,
+		},
+		&rpc.Api{Name: "projects/batch-test/locations/global/apis/b"},
+	}
+	for i := 0; i < count-1; i++ {
+		seeds = append(seeds, &rpc.ApiSpec{
+			Name:     version.Spec(fmt.Sprintf("s%03d", i)).String(),
+			MimeType: "text/plain",
+			Contents: []byte(fmt.Sprintf("s%03d", i)),
+		})
+	}
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "batch-test", seeds)
+
+	t.Run("list-specs-with-contents", func(t *testing.T) {
+		var specs []*rpc.ApiSpec
+		err := ListSpecs(ctx, registryClient, version.Spec("-"), 0, "", true, func(ctx context.Context, spec *rpc.ApiSpec) error {
+			specs = append(specs, spec)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ListSpecs() returned error: %s", err)
+		}
+		if len(specs) != count {
+			t.Fatalf("ListSpecs() returned %d specs, expected %d", len(specs), count)
+		}
+		for _, spec := range specs {
+			want := spec.GetName()[len(version.Spec("").String()):]
+			if string(spec.GetContents()) != want || spec.GetMimeType() != "text/plain" {
+				t.Errorf("ListSpecs() returned %q (%s) for %s, expected %q (text/plain)", spec.GetContents(), spec.GetMimeType(), spec.GetName(), want)
+			}
+		}
+	})
+
+	t.Run("artifact-batch", func(t *testing.T) {
+		for _, contents := range []string{"first", "second"} {
+			batch := NewArtifactBatch(registryClient)
+			var wg sync.WaitGroup
+			for i := 0; i < count; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					err := batch.Set(ctx, &rpc.Artifact{
+						Name:     version.Artifact(fmt.Sprintf("x%03d", i)).String(),
+						MimeType: "text/plain",
+						Contents: []byte(contents),
+					})
+					if err != nil {
+						t.Errorf("Set() returned error: %s", err)
+					}
+				}(i)
+			}
+			wg.Wait()
+			if err := batch.Flush(ctx); err != nil {
+				t.Fatalf("Flush() returned error: %s", err)
+			}
+			for i := 0; i < count; i++ {
+				artifact := &rpc.Artifact{Name: version.Artifact(fmt.Sprintf("x%03d", i)).String()}
+				if err := FetchArtifactContents(ctx, registryClient, artifact); err != nil {
+					t.Fatalf("FetchArtifactContents() returned error: %s", err)
+				}
+				if string(artifact.GetContents()) != contents {
+					t.Fatalf("FetchArtifactContents() returned %q for %s, expected %q", artifact.GetContents(), artifact.GetName(), contents)
+				}
+			}
+		}
+	})
+
+	t.Run("update-apis", func(t *testing.T) {
+		errs := UpdateApis(ctx, registryClient, []*rpc.UpdateApiRequest{
+			{
+				Api: &rpc.Api{
+					Name:        "projects/batch-test/locations/global/apis/b",
+					DisplayName: "B",
+				},
+			},
+			{
+				Api: &rpc.Api{
+					Name: "projects/batch-test/locations/global/apis/missing",
+				},
+			},
+			{
+				Api: &rpc.Api{
+					Name:        "projects/batch-test/locations/global/apis/c",
+					DisplayName: "C",
+				},
+				AllowMissing: true,
+			},
+		})
+		// The failing update fails the batch, which is retried individually.
+		for i, want := range []codes.Code{codes.OK, codes.NotFound, codes.OK} {
+			if status.Code(errs[i]) != want {
+				t.Errorf("UpdateApis() returned status %s for request %d, expected %s: %v", status.Code(errs[i]), i, want, errs[i])
+			}
+		}
+		for _, name := range []string{"b", "c"} {
+			api, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: "projects/batch-test/locations/global/apis/" + name})
+			if err != nil {
+				t.Fatalf("GetApi() returned error: %s", err)
+			}
+			if api.GetDisplayName() == "" {
+				t.Errorf("GetApi() returned %s without the display name of its individual update", api.GetName())
+			}
+		}
+
+		// Batches of valid updates are applied.
+		errs = UpdateApis(ctx, registryClient, []*rpc.UpdateApiRequest{
+			{Api: &rpc.Api{Name: "projects/batch-test/locations/global/apis/b", DisplayName: "B2"}},
+			{Api: &rpc.Api{Name: "projects/batch-test/locations/global/apis/d", DisplayName: "D"}, AllowMissing: true},
+		})
+		for i, err := range errs {
+			if err != nil {
+				t.Errorf("UpdateApis() returned error for request %d: %s", i, err)
+			}
+		}
+		for name, want := range map[string]string{"b": "B2", "d": "D"} {
+			api, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: "projects/batch-test/locations/global/apis/" + name})
+			if err != nil {
+				t.Fatalf("GetApi() returned error: %s", err)
+			}
+			if api.GetDisplayName() != want {
+				t.Errorf("GetApi() returned %s with display name %q, expected %q", api.GetName(), api.GetDisplayName(), want)
+			}
+		}
+	})
+}
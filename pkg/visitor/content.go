@@ -27,6 +27,9 @@ import (
 func SetArtifact(ctx context.Context,
 	client *gapic.RegistryClient,
 	artifact *rpc.Artifact) error {
+	if len(artifact.GetContents()) > StreamingThreshold {
+		return uploadArtifact(ctx, client, artifact)
+	}
 	request := &rpc.CreateArtifactRequest{}
 	request.Artifact = artifact
 	request.ArtifactId = path.Base(artifact.GetName())
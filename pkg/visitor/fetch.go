@@ -32,26 +32,47 @@ func FetchSpecContents(ctx context.Context, client *gapic.RegistryClient, spec *
 		Name: spec.GetName(),
 	}
 	ctx = metadata.AppendToOutgoingContext(ctx, "accept-encoding", "gzip")
-	contents, err := client.GetApiSpecContents(ctx, request)
-	if err != nil {
-		return err
+	if spec.GetSizeBytes() > StreamingThreshold {
+		stream, err := client.StreamApiSpecContents(ctx, request)
+		if err != nil {
+			return err
+		}
+		if spec.MimeType, spec.Contents, err = receiveChunks(stream); err != nil {
+			return err
+		}
+	} else {
+		contents, err := client.GetApiSpecContents(ctx, request)
+		if err != nil {
+			return err
+		}
+		spec.MimeType = contents.GetContentType()
+		spec.Contents = contents.GetData()
 	}
-	spec.MimeType = contents.GetContentType()
-	spec.Contents = contents.GetData()
 	if mime.IsGZipCompressed(spec.MimeType) {
+		var err error
 		spec.MimeType = mime.GUnzippedType(spec.MimeType)
 		spec.Contents, err = compress.GUnzippedBytes(spec.Contents)
+		return err
 	}
-	return err
+	return nil
 }
 
 func FetchArtifactContents(ctx context.Context, client *gapic.RegistryClient, artifact *rpc.Artifact) error {
 	if artifact.Contents != nil {
 		return nil
 	}
-	contents, err := client.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{
+	request := &rpc.GetArtifactContentsRequest{
 		Name: artifact.GetName(),
-	})
+	}
+	if artifact.GetSizeBytes() > StreamingThreshold {
+		stream, err := client.StreamArtifactContents(ctx, request)
+		if err != nil {
+			return err
+		}
+		artifact.MimeType, artifact.Contents, err = receiveChunks(stream)
+		return err
+	}
+	contents, err := client.GetArtifactContents(ctx, request)
 	if err != nil {
 		return err
 	}
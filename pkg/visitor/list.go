@@ -19,14 +19,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/gapic"
-	"github.com/apigee/registry/pkg/mime"
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -198,33 +195,31 @@ func ListSpecs(ctx context.Context,
 		PageSize: pageSize,
 		Filter:   filter,
 	})
+	var batch []*rpc.ApiSpec
 	for r, err := it.Next(); err != iterator.Done; r, err = it.Next() {
 		if err != nil {
 			return err
 		}
 
 		if getContents {
-			ctx = metadata.AppendToOutgoingContext(ctx, "accept-encoding", "gzip")
-			resp, err := client.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{
-				Name: r.GetName(),
-			})
-			if err != nil {
-				return err
-			}
-			r.Contents = resp.GetData()
-			if mime.IsGZipCompressed(resp.ContentType) {
-				r.MimeType = mime.GUnzippedType(r.MimeType)
-				r.Contents, err = compress.GUnzippedBytes(r.Contents)
-				if err != nil {
+			// Contents are fetched in batches before the specs are handled.
+			batch = append(batch, r)
+			if len(batch) == batchSize {
+				if err := handleSpecsWithContents(ctx, client, batch, handler); err != nil {
 					return err
 				}
+				batch = nil
 			}
+			continue
 		}
 
 		if err := handler(ctx, r); err != nil {
 			return err
 		}
 	}
+	if len(batch) > 0 {
+		return handleSpecsWithContents(ctx, client, batch, handler)
+	}
 	return nil
 }
 
@@ -241,33 +236,31 @@ func ListSpecRevisions(ctx context.Context,
 		PageSize: pageSize,
 		Filter:   filter,
 	})
+	var batch []*rpc.ApiSpec
 	for r, err := it.Next(); err != iterator.Done; r, err = it.Next() {
 		if err != nil {
 			return err
 		}
 
 		if getContents {
-			ctx = metadata.AppendToOutgoingContext(ctx, "accept-encoding", "gzip")
-			resp, err := client.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{
-				Name: r.GetName(),
-			})
-			if err != nil {
-				return err
-			}
-			r.Contents = resp.GetData()
-			if mime.IsGZipCompressed(resp.ContentType) {
-				r.MimeType = mime.GUnzippedType(r.MimeType)
-				r.Contents, err = compress.GUnzippedBytes(r.Contents)
-				if err != nil {
+			// Contents are fetched in batches before the specs are handled.
+			batch = append(batch, r)
+			if len(batch) == batchSize {
+				if err := handleSpecsWithContents(ctx, client, batch, handler); err != nil {
 					return err
 				}
+				batch = nil
 			}
+			continue
 		}
 
 		if err := handler(ctx, r); err != nil {
 			return err
 		}
 	}
+	if len(batch) > 0 {
+		return handleSpecsWithContents(ctx, client, batch, handler)
+	}
 	return nil
 }
 
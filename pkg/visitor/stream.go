@@ -0,0 +1,133 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package visitor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apigee/registry/gapic"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamingThreshold is the size of contents above which they are sent and received
+// in chunks with the streaming RPCs, which aren't limited by the maximum size of a gRPC message.
+const StreamingThreshold = 1 << 20
+
+// streamingChunkSize is the size of the chunks of uploaded contents.
+const streamingChunkSize = 1 << 20
+
+// UpdateSpec updates a spec as UpdateApiSpec does. Large contents of specs
+// that are created or fully replaced are uploaded in chunks.
+func UpdateSpec(ctx context.Context, client *gapic.RegistryClient, req *rpc.UpdateApiSpecRequest) (*rpc.ApiSpec, error) {
+	if len(req.GetApiSpec().GetContents()) <= StreamingThreshold || !req.GetAllowMissing() || req.GetUpdateMask() != nil {
+		return client.UpdateApiSpec(ctx, req)
+	}
+	stream, err := client.UploadApiSpecContents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spec := proto.Clone(req.GetApiSpec()).(*rpc.ApiSpec)
+	spec.Contents = nil
+	err = sendChunks(req.GetApiSpec().GetContents(), func(data []byte, hash string, first bool) error {
+		m := &rpc.UploadApiSpecContentsRequest{Data: data, Sha256: hash}
+		if first {
+			m.ApiSpec = spec
+		}
+		return stream.Send(m)
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	// On io.EOF the server has closed the stream and its error is returned here.
+	return stream.CloseAndRecv()
+}
+
+// uploadArtifact creates or replaces an artifact, uploading its contents in chunks.
+func uploadArtifact(ctx context.Context, client *gapic.RegistryClient, artifact *rpc.Artifact) error {
+	stream, err := client.UploadArtifactContents(ctx)
+	if err != nil {
+		return err
+	}
+	header := proto.Clone(artifact).(*rpc.Artifact)
+	header.Contents = nil
+	err = sendChunks(artifact.GetContents(), func(data []byte, hash string, first bool) error {
+		m := &rpc.UploadArtifactContentsRequest{Data: data, Sha256: hash}
+		if first {
+			m.Artifact = header
+		}
+		return stream.Send(m)
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// sendChunks sends contents in chunks, with their hash in the last one.
+func sendChunks(contents []byte, send func(data []byte, hash string, first bool) error) error {
+	for offset := 0; ; offset += streamingChunkSize {
+		end := offset + streamingChunkSize
+		if end > len(contents) {
+			end = len(contents)
+		}
+		var hash string
+		if end == len(contents) {
+			hash = fmt.Sprintf("%x", sha256.Sum256(contents))
+		}
+		if err := send(contents[offset:end], hash, offset == 0); err != nil {
+			return err
+		}
+		if end == len(contents) {
+			return nil
+		}
+	}
+}
+
+// chunkReceiver is implemented by the clients of the download streams.
+type chunkReceiver interface {
+	Recv() (*rpc.ContentsChunk, error)
+}
+
+// receiveChunks collects streamed contents and their content type and checks their hash.
+func receiveChunks(stream chunkReceiver) (string, []byte, error) {
+	var contentType, hash string
+	var contents bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", nil, err
+		}
+		if chunk.GetContentType() != "" {
+			contentType = chunk.GetContentType()
+		}
+		if chunk.GetSha256() != "" {
+			hash = chunk.GetSha256()
+		}
+		contents.Write(chunk.GetData())
+	}
+	if sum := fmt.Sprintf("%x", sha256.Sum256(contents.Bytes())); sum != strings.ToLower(hash) {
+		return "", nil, fmt.Errorf("received contents have sha256 %s, expected %q", sum, hash)
+	}
+	return contentType, contents.Bytes(), nil
+}
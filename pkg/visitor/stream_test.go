@@ -0,0 +1,86 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package visitor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+)
+
+func TestStreamedContents(t *testing.T) {
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "stream-test", nil)
+	version := "projects/stream-test/locations/global/apis/a/versions/v"
+	if _, err := registryClient.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/stream-test/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create test api: %s", err)
+	}
+	if _, err := registryClient.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       "projects/stream-test/locations/global/apis/a",
+		ApiVersionId: "v",
+		ApiVersion:   &rpc.ApiVersion{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create test version: %s", err)
+	}
+
+	// These contents are larger than the default maximum size of a gRPC message,
+	// so they can only be transferred with the streaming methods.
+	contents := bytes.Repeat([]byte("streamed contents\n"), (5<<20)/18)
+
+	t.Run("spec", func(t *testing.T) {
+		spec, err := UpdateSpec(ctx, registryClient, &rpc.UpdateApiSpecRequest{
+			ApiSpec:      &rpc.ApiSpec{Name: version + "/specs/s", MimeType: "text/plain", Contents: contents},
+			AllowMissing: true,
+		})
+		if err != nil {
+			t.Fatalf("UpdateSpec() returned error: %s", err)
+		}
+		if spec.GetSizeBytes() != int32(len(contents)) {
+			t.Errorf("UpdateSpec() returned size %d, want %d", spec.GetSizeBytes(), len(contents))
+		}
+		if err := FetchSpecContents(ctx, registryClient, spec); err != nil {
+			t.Fatalf("FetchSpecContents() returned error: %s", err)
+		}
+		if !bytes.Equal(spec.Contents, contents) || spec.MimeType != "text/plain" {
+			t.Errorf("FetchSpecContents() returned %d bytes of %q, want %d bytes of %q", len(spec.Contents), spec.MimeType, len(contents), "text/plain")
+		}
+	})
+
+	t.Run("artifact", func(t *testing.T) {
+		name := version + "/artifacts/x"
+		for _, c := range [][]byte{contents, append(contents, contents...)} {
+			if err := SetArtifact(ctx, registryClient, &rpc.Artifact{Name: name, MimeType: "text/plain", Contents: c}); err != nil {
+				t.Fatalf("SetArtifact() returned error: %s", err)
+			}
+			artifact, err := registryClient.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: name})
+			if err != nil {
+				t.Fatalf("GetArtifact() returned error: %s", err)
+			}
+			if err := FetchArtifactContents(ctx, registryClient, artifact); err != nil {
+				t.Fatalf("FetchArtifactContents() returned error: %s", err)
+			}
+			if !bytes.Equal(artifact.Contents, c) {
+				t.Errorf("FetchArtifactContents() returned %d bytes, want %d", len(artifact.Contents), len(c))
+			}
+		}
+	})
+}
@@ -22,7 +22,10 @@ import (
 
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/pkg/tracing"
 	"github.com/apigee/registry/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Visitor interface {
@@ -47,7 +50,16 @@ type VisitorOptions struct {
 }
 
 // Visit traverses a registry, applying the Visitor to each selected resource.
-func Visit(ctx context.Context, v Visitor, options VisitorOptions) error {
+func Visit(ctx context.Context, v Visitor, options VisitorOptions) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "visitor.Visit", trace.WithAttributes(
+		attribute.String("registry.pattern", options.Pattern),
+		attribute.String("registry.filter", options.Filter),
+	))
+	defer func() { tracing.End(span, err) }()
+	return visit(ctx, v, options)
+}
+
+func visit(ctx context.Context, v Visitor, options VisitorOptions) error {
 	filter := options.Filter
 	name := options.Pattern
 	ac := options.AdminClient
@@ -35,14 +35,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Admin_GetStatus_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/GetStatus"
-	Admin_GetStorage_FullMethodName      = "/google.cloud.apigeeregistry.v1.Admin/GetStorage"
-	Admin_MigrateDatabase_FullMethodName = "/google.cloud.apigeeregistry.v1.Admin/MigrateDatabase"
-	Admin_ListProjects_FullMethodName    = "/google.cloud.apigeeregistry.v1.Admin/ListProjects"
-	Admin_GetProject_FullMethodName      = "/google.cloud.apigeeregistry.v1.Admin/GetProject"
-	Admin_CreateProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/CreateProject"
-	Admin_UpdateProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/UpdateProject"
-	Admin_DeleteProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/DeleteProject"
+	Admin_GetStatus_FullMethodName           = "/google.cloud.apigeeregistry.v1.Admin/GetStatus"
+	Admin_GetStorage_FullMethodName          = "/google.cloud.apigeeregistry.v1.Admin/GetStorage"
+	Admin_MigrateDatabase_FullMethodName     = "/google.cloud.apigeeregistry.v1.Admin/MigrateDatabase"
+	Admin_ReplayNotifications_FullMethodName = "/google.cloud.apigeeregistry.v1.Admin/ReplayNotifications"
+	Admin_ListAuditEntries_FullMethodName    = "/google.cloud.apigeeregistry.v1.Admin/ListAuditEntries"
+	Admin_ListProjects_FullMethodName        = "/google.cloud.apigeeregistry.v1.Admin/ListProjects"
+	Admin_GetProject_FullMethodName          = "/google.cloud.apigeeregistry.v1.Admin/GetProject"
+	Admin_CreateProject_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/CreateProject"
+	Admin_UpdateProject_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/UpdateProject"
+	Admin_DeleteProject_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/DeleteProject"
+	Admin_GetProjectQuota_FullMethodName     = "/google.cloud.apigeeregistry.v1.Admin/GetProjectQuota"
+	Admin_ExportProject_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/ExportProject"
+	Admin_ImportProject_FullMethodName       = "/google.cloud.apigeeregistry.v1.Admin/ImportProject"
 )
 
 // AdminClient is the client API for Admin service.
@@ -77,6 +82,23 @@ type AdminClient interface {
 	GetStorage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Storage, error)
 	// MigrateDatabase attempts to migrate the database to the current schema.
 	MigrateDatabase(ctx context.Context, in *MigrateDatabaseRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	// ReplayNotifications schedules stored notifications to be delivered again
+	// to all configured notification sinks, starting with a specified sequence
+	// number.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ReplayNotifications(ctx context.Context, in *ReplayNotificationsRequest, opts ...grpc.CallOption) (*ReplayNotificationsResponse, error)
+	// ListAuditEntries returns matching entries of the audit log, in the order
+	// that they were recorded.
+	// (-- api-linter: core::0132::http-uri-parent=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	//
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
 	// ListProjects returns matching projects.
 	// (-- api-linter: standard-methods=disabled --)
 	// (-- api-linter: core::0132::method-signature=disabled
@@ -100,6 +122,28 @@ type AdminClient interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetProjectQuota returns the limits of a project and its usage of them.
+	// (-- api-linter: core::0131::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error)
+	// ExportProject returns an archive of a project and all of its resources,
+	// revisions, tags and contents that can be restored with ImportProject.
+	// The archive is made before the call returns, so the returned operation is
+	// always done and can't be retrieved later.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ExportProject(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	// ImportProject creates a project from an archive that was returned by
+	// ExportProject. Revision IDs and timestamps are kept, so an exported
+	// project can be moved between servers or restored after it is deleted.
+	// The project is created before the call returns, so the returned operation
+	// is always done and can't be retrieved later.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ImportProject(ctx context.Context, in *ImportProjectRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 }
 
 type adminClient struct {
@@ -137,6 +181,24 @@ func (c *adminClient) MigrateDatabase(ctx context.Context, in *MigrateDatabaseRe
 	return out, nil
 }
 
+func (c *adminClient) ReplayNotifications(ctx context.Context, in *ReplayNotificationsRequest, opts ...grpc.CallOption) (*ReplayNotificationsResponse, error) {
+	out := new(ReplayNotificationsResponse)
+	err := c.cc.Invoke(ctx, Admin_ReplayNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, Admin_ListAuditEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, Admin_ListProjects_FullMethodName, in, out, opts...)
@@ -182,6 +244,33 @@ func (c *adminClient) DeleteProject(ctx context.Context, in *DeleteProjectReques
 	return out, nil
 }
 
+func (c *adminClient) GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error) {
+	out := new(ProjectQuota)
+	err := c.cc.Invoke(ctx, Admin_GetProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExportProject(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, Admin_ExportProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ImportProject(ctx context.Context, in *ImportProjectRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, Admin_ImportProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
@@ -214,6 +303,23 @@ type AdminServer interface {
 	GetStorage(context.Context, *emptypb.Empty) (*Storage, error)
 	// MigrateDatabase attempts to migrate the database to the current schema.
 	MigrateDatabase(context.Context, *MigrateDatabaseRequest) (*longrunning.Operation, error)
+	// ReplayNotifications schedules stored notifications to be delivered again
+	// to all configured notification sinks, starting with a specified sequence
+	// number.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ReplayNotifications(context.Context, *ReplayNotificationsRequest) (*ReplayNotificationsResponse, error)
+	// ListAuditEntries returns matching entries of the audit log, in the order
+	// that they were recorded.
+	// (-- api-linter: core::0132::http-uri-parent=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	//
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
 	// ListProjects returns matching projects.
 	// (-- api-linter: standard-methods=disabled --)
 	// (-- api-linter: core::0132::method-signature=disabled
@@ -237,6 +343,28 @@ type AdminServer interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// GetProjectQuota returns the limits of a project and its usage of them.
+	// (-- api-linter: core::0131::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*ProjectQuota, error)
+	// ExportProject returns an archive of a project and all of its resources,
+	// revisions, tags and contents that can be restored with ImportProject.
+	// The archive is made before the call returns, so the returned operation is
+	// always done and can't be retrieved later.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ExportProject(context.Context, *ExportProjectRequest) (*longrunning.Operation, error)
+	// ImportProject creates a project from an archive that was returned by
+	// ExportProject. Revision IDs and timestamps are kept, so an exported
+	// project can be moved between servers or restored after it is deleted.
+	// The project is created before the call returns, so the returned operation
+	// is always done and can't be retrieved later.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	ImportProject(context.Context, *ImportProjectRequest) (*longrunning.Operation, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -253,6 +381,12 @@ func (UnimplementedAdminServer) GetStorage(context.Context, *emptypb.Empty) (*St
 func (UnimplementedAdminServer) MigrateDatabase(context.Context, *MigrateDatabaseRequest) (*longrunning.Operation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateDatabase not implemented")
 }
+func (UnimplementedAdminServer) ReplayNotifications(context.Context, *ReplayNotificationsRequest) (*ReplayNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayNotifications not implemented")
+}
+func (UnimplementedAdminServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
 func (UnimplementedAdminServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
@@ -268,6 +402,15 @@ func (UnimplementedAdminServer) UpdateProject(context.Context, *UpdateProjectReq
 func (UnimplementedAdminServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedAdminServer) GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*ProjectQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectQuota not implemented")
+}
+func (UnimplementedAdminServer) ExportProject(context.Context, *ExportProjectRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportProject not implemented")
+}
+func (UnimplementedAdminServer) ImportProject(context.Context, *ImportProjectRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProject not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -335,6 +478,42 @@ func _Admin_MigrateDatabase_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ReplayNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReplayNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ReplayNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReplayNotifications(ctx, req.(*ReplayNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
@@ -425,6 +604,60 @@ func _Admin_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetProjectQuota(ctx, req.(*GetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExportProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ExportProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ExportProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ExportProject(ctx, req.(*ExportProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ImportProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ImportProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ImportProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ImportProject(ctx, req.(*ImportProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -444,6 +677,14 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateDatabase",
 			Handler:    _Admin_MigrateDatabase_Handler,
 		},
+		{
+			MethodName: "ReplayNotifications",
+			Handler:    _Admin_ReplayNotifications_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _Admin_ListAuditEntries_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _Admin_ListProjects_Handler,
@@ -464,6 +705,18 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Admin_DeleteProject_Handler,
 		},
+		{
+			MethodName: "GetProjectQuota",
+			Handler:    _Admin_GetProjectQuota_Handler,
+		},
+		{
+			MethodName: "ExportProject",
+			Handler:    _Admin_ExportProject_Handler,
+		},
+		{
+			MethodName: "ImportProject",
+			Handler:    _Admin_ImportProject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/admin_service.proto",
@@ -40,6 +40,8 @@ const (
 	Registry_CreateApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/CreateApi"
 	Registry_UpdateApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/UpdateApi"
 	Registry_DeleteApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/DeleteApi"
+	Registry_UndeleteApi_FullMethodName                 = "/google.cloud.apigeeregistry.v1.Registry/UndeleteApi"
+	Registry_BatchUpdateApis_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApis"
 	Registry_ListApiVersions_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/ListApiVersions"
 	Registry_GetApiVersion_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/GetApiVersion"
 	Registry_CreateApiVersion_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/CreateApiVersion"
@@ -48,6 +50,7 @@ const (
 	Registry_ListApiSpecs_FullMethodName                = "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecs"
 	Registry_GetApiSpec_FullMethodName                  = "/google.cloud.apigeeregistry.v1.Registry/GetApiSpec"
 	Registry_GetApiSpecContents_FullMethodName          = "/google.cloud.apigeeregistry.v1.Registry/GetApiSpecContents"
+	Registry_BatchGetApiSpecs_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/BatchGetApiSpecs"
 	Registry_CreateApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/CreateApiSpec"
 	Registry_UpdateApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/UpdateApiSpec"
 	Registry_DeleteApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpec"
@@ -68,8 +71,16 @@ const (
 	Registry_GetArtifact_FullMethodName                 = "/google.cloud.apigeeregistry.v1.Registry/GetArtifact"
 	Registry_GetArtifactContents_FullMethodName         = "/google.cloud.apigeeregistry.v1.Registry/GetArtifactContents"
 	Registry_CreateArtifact_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/CreateArtifact"
+	Registry_BatchCreateArtifacts_FullMethodName        = "/google.cloud.apigeeregistry.v1.Registry/BatchCreateArtifacts"
 	Registry_ReplaceArtifact_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/ReplaceArtifact"
 	Registry_DeleteArtifact_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifact"
+	Registry_WatchResources_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/WatchResources"
+	Registry_SearchResources_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/SearchResources"
+	Registry_PruneApiRevisions_FullMethodName           = "/google.cloud.apigeeregistry.v1.Registry/PruneApiRevisions"
+	Registry_UploadApiSpecContents_FullMethodName       = "/google.cloud.apigeeregistry.v1.Registry/UploadApiSpecContents"
+	Registry_StreamApiSpecContents_FullMethodName       = "/google.cloud.apigeeregistry.v1.Registry/StreamApiSpecContents"
+	Registry_UploadArtifactContents_FullMethodName      = "/google.cloud.apigeeregistry.v1.Registry/UploadArtifactContents"
+	Registry_StreamArtifactContents_FullMethodName      = "/google.cloud.apigeeregistry.v1.Registry/StreamArtifactContents"
 )
 
 // RegistryClient is the client API for Registry service.
@@ -85,8 +96,15 @@ type RegistryClient interface {
 	// UpdateApi can be used to modify a specified API.
 	UpdateApi(ctx context.Context, in *UpdateApiRequest, opts ...grpc.CallOption) (*Api, error)
 	// DeleteApi removes a specified API and all of the resources that it
-	// owns.
+	// owns. If the server has a purge window, the API can be restored with
+	// UndeleteApi until the window has passed.
 	DeleteApi(ctx context.Context, in *DeleteApiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and all of the resources that it
+	// owns. APIs can be restored until they are purged.
+	UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error)
+	// BatchUpdateApis updates a set of APIs in a single transaction.
+	// Either all of the updates succeed or none of them are applied.
+	BatchUpdateApis(ctx context.Context, in *BatchUpdateApisRequest, opts ...grpc.CallOption) (*BatchUpdateApisResponse, error)
 	// ListApiVersions returns matching versions.
 	ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error)
 	// GetApiVersion returns a specified version.
@@ -107,6 +125,9 @@ type RegistryClient interface {
 	// is to return the spec uncompressed (the mime_type response field
 	// indicates the exact format returned).
 	GetApiSpecContents(ctx context.Context, in *GetApiSpecContentsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// BatchGetApiSpecs returns a set of specs or spec revisions.
+	// If any of the specs can't be found, the request fails.
+	BatchGetApiSpecs(ctx context.Context, in *BatchGetApiSpecsRequest, opts ...grpc.CallOption) (*BatchGetApiSpecsResponse, error)
 	// CreateApiSpec creates a specified spec.
 	CreateApiSpec(ctx context.Context, in *CreateApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error)
 	// UpdateApiSpec can be used to modify a specified spec.
@@ -157,10 +178,71 @@ type RegistryClient interface {
 	GetArtifactContents(ctx context.Context, in *GetArtifactContentsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	// CreateArtifact creates a specified artifact.
 	CreateArtifact(ctx context.Context, in *CreateArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// BatchCreateArtifacts creates a set of artifacts in a single transaction.
+	// Either all of the artifacts are created or none of them are.
+	BatchCreateArtifacts(ctx context.Context, in *BatchCreateArtifactsRequest, opts ...grpc.CallOption) (*BatchCreateArtifactsResponse, error)
 	// ReplaceArtifact can be used to replace a specified artifact.
 	ReplaceArtifact(ctx context.Context, in *ReplaceArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchResources streams notifications of changes to resources under a
+	// specified parent as they are made.
+	// (-- api-linter: core::0131::http-uri-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	//
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error)
+	// SearchResources returns the APIs, versions, specs and deployments in a
+	// project whose names, descriptions or spec contents match a query.
+	// Results are ordered by decreasing relevance. Unlike the page tokens of
+	// List methods, page tokens of searches hold the positions of the next
+	// results, so results may be skipped or repeated if resources change
+	// while results are paged.
+	// (-- api-linter: core::0131::http-uri-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error)
+	// PruneApiRevisions deletes the spec and deployment revisions of an API
+	// that are not kept by its retention policy. Policies are RetentionPolicy
+	// artifacts named "retention-policy" that are stored in APIs or projects,
+	// and the policy of an API takes precedence over that of its project.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	PruneApiRevisions(ctx context.Context, in *PruneApiRevisionsRequest, opts ...grpc.CallOption) (*PruneApiRevisionsResponse, error)
+	// UploadApiSpecContents creates or updates a spec with contents that are
+	// sent in a stream of chunks, which allows contents that are larger than
+	// the maximum message size. The spec is created if it does not exist, as
+	// with UpdateApiSpec when allow_missing is set. Contents that are larger
+	// than the server's limit are rejected with RESOURCE_EXHAUSTED.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	UploadApiSpecContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadApiSpecContentsClient, error)
+	// StreamApiSpecContents returns the contents of a specified spec in a
+	// stream of chunks. The contents are the same as those returned by
+	// GetApiSpecContents.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	StreamApiSpecContents(ctx context.Context, in *GetApiSpecContentsRequest, opts ...grpc.CallOption) (Registry_StreamApiSpecContentsClient, error)
+	// UploadArtifactContents creates or replaces an artifact with contents
+	// that are sent in a stream of chunks. Contents that are larger than the
+	// server's limit are rejected with RESOURCE_EXHAUSTED.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	UploadArtifactContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadArtifactContentsClient, error)
+	// StreamArtifactContents returns the contents of a specified artifact in
+	// a stream of chunks. The contents are the same as those returned by
+	// GetArtifactContents.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	StreamArtifactContents(ctx context.Context, in *GetArtifactContentsRequest, opts ...grpc.CallOption) (Registry_StreamArtifactContentsClient, error)
 }
 
 type registryClient struct {
@@ -216,6 +298,24 @@ func (c *registryClient) DeleteApi(ctx context.Context, in *DeleteApiRequest, op
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	out := new(Api)
+	err := c.cc.Invoke(ctx, Registry_UndeleteApi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateApis(ctx context.Context, in *BatchUpdateApisRequest, opts ...grpc.CallOption) (*BatchUpdateApisResponse, error) {
+	out := new(BatchUpdateApisResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchUpdateApis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error) {
 	out := new(ListApiVersionsResponse)
 	err := c.cc.Invoke(ctx, Registry_ListApiVersions_FullMethodName, in, out, opts...)
@@ -288,6 +388,15 @@ func (c *registryClient) GetApiSpecContents(ctx context.Context, in *GetApiSpecC
 	return out, nil
 }
 
+func (c *registryClient) BatchGetApiSpecs(ctx context.Context, in *BatchGetApiSpecsRequest, opts ...grpc.CallOption) (*BatchGetApiSpecsResponse, error) {
+	out := new(BatchGetApiSpecsResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchGetApiSpecs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) CreateApiSpec(ctx context.Context, in *CreateApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
 	out := new(ApiSpec)
 	err := c.cc.Invoke(ctx, Registry_CreateApiSpec_FullMethodName, in, out, opts...)
@@ -468,6 +577,15 @@ func (c *registryClient) CreateArtifact(ctx context.Context, in *CreateArtifactR
 	return out, nil
 }
 
+func (c *registryClient) BatchCreateArtifacts(ctx context.Context, in *BatchCreateArtifactsRequest, opts ...grpc.CallOption) (*BatchCreateArtifactsResponse, error) {
+	out := new(BatchCreateArtifactsResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchCreateArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ReplaceArtifact(ctx context.Context, in *ReplaceArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
 	out := new(Artifact)
 	err := c.cc.Invoke(ctx, Registry_ReplaceArtifact_FullMethodName, in, out, opts...)
@@ -486,6 +604,188 @@ func (c *registryClient) DeleteArtifact(ctx context.Context, in *DeleteArtifactR
 	return out, nil
 }
 
+func (c *registryClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[0], Registry_WatchResources_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryWatchResourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_WatchResourcesClient interface {
+	Recv() (*Notification, error)
+	grpc.ClientStream
+}
+
+type registryWatchResourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryWatchResourcesClient) Recv() (*Notification, error) {
+	m := new(Notification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error) {
+	out := new(SearchResourcesResponse)
+	err := c.cc.Invoke(ctx, Registry_SearchResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) PruneApiRevisions(ctx context.Context, in *PruneApiRevisionsRequest, opts ...grpc.CallOption) (*PruneApiRevisionsResponse, error) {
+	out := new(PruneApiRevisionsResponse)
+	err := c.cc.Invoke(ctx, Registry_PruneApiRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) UploadApiSpecContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadApiSpecContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[1], Registry_UploadApiSpecContents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryUploadApiSpecContentsClient{stream}
+	return x, nil
+}
+
+type Registry_UploadApiSpecContentsClient interface {
+	Send(*UploadApiSpecContentsRequest) error
+	CloseAndRecv() (*ApiSpec, error)
+	grpc.ClientStream
+}
+
+type registryUploadApiSpecContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryUploadApiSpecContentsClient) Send(m *UploadApiSpecContentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *registryUploadApiSpecContentsClient) CloseAndRecv() (*ApiSpec, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ApiSpec)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) StreamApiSpecContents(ctx context.Context, in *GetApiSpecContentsRequest, opts ...grpc.CallOption) (Registry_StreamApiSpecContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[2], Registry_StreamApiSpecContents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryStreamApiSpecContentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_StreamApiSpecContentsClient interface {
+	Recv() (*ContentsChunk, error)
+	grpc.ClientStream
+}
+
+type registryStreamApiSpecContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryStreamApiSpecContentsClient) Recv() (*ContentsChunk, error) {
+	m := new(ContentsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) UploadArtifactContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadArtifactContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[3], Registry_UploadArtifactContents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryUploadArtifactContentsClient{stream}
+	return x, nil
+}
+
+type Registry_UploadArtifactContentsClient interface {
+	Send(*UploadArtifactContentsRequest) error
+	CloseAndRecv() (*Artifact, error)
+	grpc.ClientStream
+}
+
+type registryUploadArtifactContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryUploadArtifactContentsClient) Send(m *UploadArtifactContentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *registryUploadArtifactContentsClient) CloseAndRecv() (*Artifact, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Artifact)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) StreamArtifactContents(ctx context.Context, in *GetArtifactContentsRequest, opts ...grpc.CallOption) (Registry_StreamArtifactContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[4], Registry_StreamArtifactContents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryStreamArtifactContentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_StreamArtifactContentsClient interface {
+	Recv() (*ContentsChunk, error)
+	grpc.ClientStream
+}
+
+type registryStreamArtifactContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryStreamArtifactContentsClient) Recv() (*ContentsChunk, error) {
+	m := new(ContentsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RegistryServer is the server API for Registry service.
 // All implementations must embed UnimplementedRegistryServer
 // for forward compatibility
@@ -499,8 +799,15 @@ type RegistryServer interface {
 	// UpdateApi can be used to modify a specified API.
 	UpdateApi(context.Context, *UpdateApiRequest) (*Api, error)
 	// DeleteApi removes a specified API and all of the resources that it
-	// owns.
+	// owns. If the server has a purge window, the API can be restored with
+	// UndeleteApi until the window has passed.
 	DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and all of the resources that it
+	// owns. APIs can be restored until they are purged.
+	UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error)
+	// BatchUpdateApis updates a set of APIs in a single transaction.
+	// Either all of the updates succeed or none of them are applied.
+	BatchUpdateApis(context.Context, *BatchUpdateApisRequest) (*BatchUpdateApisResponse, error)
 	// ListApiVersions returns matching versions.
 	ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error)
 	// GetApiVersion returns a specified version.
@@ -521,6 +828,9 @@ type RegistryServer interface {
 	// is to return the spec uncompressed (the mime_type response field
 	// indicates the exact format returned).
 	GetApiSpecContents(context.Context, *GetApiSpecContentsRequest) (*httpbody.HttpBody, error)
+	// BatchGetApiSpecs returns a set of specs or spec revisions.
+	// If any of the specs can't be found, the request fails.
+	BatchGetApiSpecs(context.Context, *BatchGetApiSpecsRequest) (*BatchGetApiSpecsResponse, error)
 	// CreateApiSpec creates a specified spec.
 	CreateApiSpec(context.Context, *CreateApiSpecRequest) (*ApiSpec, error)
 	// UpdateApiSpec can be used to modify a specified spec.
@@ -571,10 +881,71 @@ type RegistryServer interface {
 	GetArtifactContents(context.Context, *GetArtifactContentsRequest) (*httpbody.HttpBody, error)
 	// CreateArtifact creates a specified artifact.
 	CreateArtifact(context.Context, *CreateArtifactRequest) (*Artifact, error)
+	// BatchCreateArtifacts creates a set of artifacts in a single transaction.
+	// Either all of the artifacts are created or none of them are.
+	BatchCreateArtifacts(context.Context, *BatchCreateArtifactsRequest) (*BatchCreateArtifactsResponse, error)
 	// ReplaceArtifact can be used to replace a specified artifact.
 	ReplaceArtifact(context.Context, *ReplaceArtifactRequest) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error)
+	// WatchResources streams notifications of changes to resources under a
+	// specified parent as they are made.
+	// (-- api-linter: core::0131::http-uri-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	//
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error
+	// SearchResources returns the APIs, versions, specs and deployments in a
+	// project whose names, descriptions or spec contents match a query.
+	// Results are ordered by decreasing relevance. Unlike the page tokens of
+	// List methods, page tokens of searches hold the positions of the next
+	// results, so results may be skipped or repeated if resources change
+	// while results are paged.
+	// (-- api-linter: core::0131::http-uri-name=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error)
+	// PruneApiRevisions deletes the spec and deployment revisions of an API
+	// that are not kept by its retention policy. Policies are RetentionPolicy
+	// artifacts named "retention-policy" that are stored in APIs or projects,
+	// and the policy of an API takes precedence over that of its project.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//
+	//	aip.dev/not-precedent: Not in the official API. --)
+	PruneApiRevisions(context.Context, *PruneApiRevisionsRequest) (*PruneApiRevisionsResponse, error)
+	// UploadApiSpecContents creates or updates a spec with contents that are
+	// sent in a stream of chunks, which allows contents that are larger than
+	// the maximum message size. The spec is created if it does not exist, as
+	// with UpdateApiSpec when allow_missing is set. Contents that are larger
+	// than the server's limit are rejected with RESOURCE_EXHAUSTED.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	UploadApiSpecContents(Registry_UploadApiSpecContentsServer) error
+	// StreamApiSpecContents returns the contents of a specified spec in a
+	// stream of chunks. The contents are the same as those returned by
+	// GetApiSpecContents.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	StreamApiSpecContents(*GetApiSpecContentsRequest, Registry_StreamApiSpecContentsServer) error
+	// UploadArtifactContents creates or replaces an artifact with contents
+	// that are sent in a stream of chunks. Contents that are larger than the
+	// server's limit are rejected with RESOURCE_EXHAUSTED.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	UploadArtifactContents(Registry_UploadArtifactContentsServer) error
+	// StreamArtifactContents returns the contents of a specified artifact in
+	// a stream of chunks. The contents are the same as those returned by
+	// GetArtifactContents.
+	// (-- api-linter: core::0127::http-annotation=disabled
+	//
+	//	aip.dev/not-precedent: Streaming methods are only available over gRPC. --)
+	StreamArtifactContents(*GetArtifactContentsRequest, Registry_StreamArtifactContentsServer) error
 	mustEmbedUnimplementedRegistryServer()
 }
 
@@ -597,6 +968,12 @@ func (UnimplementedRegistryServer) UpdateApi(context.Context, *UpdateApiRequest)
 func (UnimplementedRegistryServer) DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApi not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateApis(context.Context, *BatchUpdateApisRequest) (*BatchUpdateApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateApis not implemented")
+}
 func (UnimplementedRegistryServer) ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApiVersions not implemented")
 }
@@ -621,6 +998,9 @@ func (UnimplementedRegistryServer) GetApiSpec(context.Context, *GetApiSpecReques
 func (UnimplementedRegistryServer) GetApiSpecContents(context.Context, *GetApiSpecContentsRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApiSpecContents not implemented")
 }
+func (UnimplementedRegistryServer) BatchGetApiSpecs(context.Context, *BatchGetApiSpecsRequest) (*BatchGetApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetApiSpecs not implemented")
+}
 func (UnimplementedRegistryServer) CreateApiSpec(context.Context, *CreateApiSpecRequest) (*ApiSpec, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateApiSpec not implemented")
 }
@@ -681,12 +1061,36 @@ func (UnimplementedRegistryServer) GetArtifactContents(context.Context, *GetArti
 func (UnimplementedRegistryServer) CreateArtifact(context.Context, *CreateArtifactRequest) (*Artifact, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateArtifact not implemented")
 }
+func (UnimplementedRegistryServer) BatchCreateArtifacts(context.Context, *BatchCreateArtifactsRequest) (*BatchCreateArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateArtifacts not implemented")
+}
 func (UnimplementedRegistryServer) ReplaceArtifact(context.Context, *ReplaceArtifactRequest) (*Artifact, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceArtifact not implemented")
 }
 func (UnimplementedRegistryServer) DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifact not implemented")
 }
+func (UnimplementedRegistryServer) WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedRegistryServer) SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchResources not implemented")
+}
+func (UnimplementedRegistryServer) PruneApiRevisions(context.Context, *PruneApiRevisionsRequest) (*PruneApiRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneApiRevisions not implemented")
+}
+func (UnimplementedRegistryServer) UploadApiSpecContents(Registry_UploadApiSpecContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadApiSpecContents not implemented")
+}
+func (UnimplementedRegistryServer) StreamApiSpecContents(*GetApiSpecContentsRequest, Registry_StreamApiSpecContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamApiSpecContents not implemented")
+}
+func (UnimplementedRegistryServer) UploadArtifactContents(Registry_UploadArtifactContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadArtifactContents not implemented")
+}
+func (UnimplementedRegistryServer) StreamArtifactContents(*GetArtifactContentsRequest, Registry_StreamArtifactContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamArtifactContents not implemented")
+}
 func (UnimplementedRegistryServer) mustEmbedUnimplementedRegistryServer() {}
 
 // UnsafeRegistryServer may be embedded to opt out of forward compatibility for this service.
@@ -790,6 +1194,42 @@ func _Registry_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApi(ctx, req.(*UndeleteApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchUpdateApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateApisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchUpdateApis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchUpdateApis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchUpdateApis(ctx, req.(*BatchUpdateApisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_ListApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApiVersionsRequest)
 	if err := dec(in); err != nil {
@@ -934,6 +1374,24 @@ func _Registry_GetApiSpecContents_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_BatchGetApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchGetApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchGetApiSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchGetApiSpecs(ctx, req.(*BatchGetApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_CreateApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateApiSpecRequest)
 	if err := dec(in); err != nil {
@@ -1294,6 +1752,24 @@ func _Registry_CreateArtifact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_BatchCreateArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchCreateArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchCreateArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchCreateArtifacts(ctx, req.(*BatchCreateArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_ReplaceArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplaceArtifactRequest)
 	if err := dec(in); err != nil {
@@ -1330,6 +1806,157 @@ func _Registry_DeleteArtifact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).WatchResources(m, &registryWatchResourcesServer{stream})
+}
+
+type Registry_WatchResourcesServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type registryWatchResourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryWatchResourcesServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registry_SearchResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).SearchResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_SearchResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).SearchResources(ctx, req.(*SearchResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_PruneApiRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneApiRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).PruneApiRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_PruneApiRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).PruneApiRevisions(ctx, req.(*PruneApiRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UploadApiSpecContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RegistryServer).UploadApiSpecContents(&registryUploadApiSpecContentsServer{stream})
+}
+
+type Registry_UploadApiSpecContentsServer interface {
+	SendAndClose(*ApiSpec) error
+	Recv() (*UploadApiSpecContentsRequest, error)
+	grpc.ServerStream
+}
+
+type registryUploadApiSpecContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryUploadApiSpecContentsServer) SendAndClose(m *ApiSpec) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *registryUploadApiSpecContentsServer) Recv() (*UploadApiSpecContentsRequest, error) {
+	m := new(UploadApiSpecContentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Registry_StreamApiSpecContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetApiSpecContentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).StreamApiSpecContents(m, &registryStreamApiSpecContentsServer{stream})
+}
+
+type Registry_StreamApiSpecContentsServer interface {
+	Send(*ContentsChunk) error
+	grpc.ServerStream
+}
+
+type registryStreamApiSpecContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryStreamApiSpecContentsServer) Send(m *ContentsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registry_UploadArtifactContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RegistryServer).UploadArtifactContents(&registryUploadArtifactContentsServer{stream})
+}
+
+type Registry_UploadArtifactContentsServer interface {
+	SendAndClose(*Artifact) error
+	Recv() (*UploadArtifactContentsRequest, error)
+	grpc.ServerStream
+}
+
+type registryUploadArtifactContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryUploadArtifactContentsServer) SendAndClose(m *Artifact) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *registryUploadArtifactContentsServer) Recv() (*UploadArtifactContentsRequest, error) {
+	m := new(UploadArtifactContentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Registry_StreamArtifactContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArtifactContentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).StreamArtifactContents(m, &registryStreamArtifactContentsServer{stream})
+}
+
+type Registry_StreamArtifactContentsServer interface {
+	Send(*ContentsChunk) error
+	grpc.ServerStream
+}
+
+type registryStreamArtifactContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryStreamArtifactContentsServer) Send(m *ContentsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Registry_ServiceDesc is the grpc.ServiceDesc for Registry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1357,6 +1984,14 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApi",
 			Handler:    _Registry_DeleteApi_Handler,
 		},
+		{
+			MethodName: "UndeleteApi",
+			Handler:    _Registry_UndeleteApi_Handler,
+		},
+		{
+			MethodName: "BatchUpdateApis",
+			Handler:    _Registry_BatchUpdateApis_Handler,
+		},
 		{
 			MethodName: "ListApiVersions",
 			Handler:    _Registry_ListApiVersions_Handler,
@@ -1389,6 +2024,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApiSpecContents",
 			Handler:    _Registry_GetApiSpecContents_Handler,
 		},
+		{
+			MethodName: "BatchGetApiSpecs",
+			Handler:    _Registry_BatchGetApiSpecs_Handler,
+		},
 		{
 			MethodName: "CreateApiSpec",
 			Handler:    _Registry_CreateApiSpec_Handler,
@@ -1469,6 +2108,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateArtifact",
 			Handler:    _Registry_CreateArtifact_Handler,
 		},
+		{
+			MethodName: "BatchCreateArtifacts",
+			Handler:    _Registry_BatchCreateArtifacts_Handler,
+		},
 		{
 			MethodName: "ReplaceArtifact",
 			Handler:    _Registry_ReplaceArtifact_Handler,
@@ -1477,7 +2120,41 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArtifact",
 			Handler:    _Registry_DeleteArtifact_Handler,
 		},
+		{
+			MethodName: "SearchResources",
+			Handler:    _Registry_SearchResources_Handler,
+		},
+		{
+			MethodName: "PruneApiRevisions",
+			Handler:    _Registry_PruneApiRevisions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResources",
+			Handler:       _Registry_WatchResources_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadApiSpecContents",
+			Handler:       _Registry_UploadApiSpecContents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamApiSpecContents",
+			Handler:       _Registry_StreamApiSpecContents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadArtifactContents",
+			Handler:       _Registry_UploadArtifactContents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamArtifactContents",
+			Handler:       _Registry_StreamArtifactContents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/registry_service.proto",
 }
@@ -41,15 +41,20 @@ func (s *RegistryServer) CreateApi(ctx context.Context, req *rpc.CreateApiReques
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createApi(ctx, db, name, req.GetApi())
-		return err
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
 func (s *RegistryServer) createApi(ctx context.Context, db *storage.Client, name names.Api, body *rpc.Api) (*rpc.Api, error) {
+	if err := s.checkApiQuota(ctx, db, name.Project()); err != nil {
+		return nil, err
+	}
 	api, err := models.NewApi(name, body)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -70,14 +75,57 @@ func (s *RegistryServer) DeleteApi(ctx context.Context, req *rpc.DeleteApiReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockApis(ctx).DeleteApi(ctx, name, req.GetForce())
+		db.LockApis(ctx, name.ProjectID)
+		if err := checkApiEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
+		if s.purgeWindow > 0 {
+			// Keep the API so that it can be restored until it is purged.
+			if err := db.SoftDeleteApi(ctx, name, req.GetForce()); err != nil {
+				return err
+			}
+		} else if err := db.DeleteApi(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, req.GetName(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApi handles the corresponding API request.
+func (s *RegistryServer) UndeleteApi(ctx context.Context, req *rpc.UndeleteApiRequest) (*rpc.Api, error) {
+	// API name must be valid.
+	name, err := names.ParseApi(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var response *rpc.Api
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		db.LockApis(ctx, name.ProjectID)
+		if err := s.checkApiQuota(ctx, db, name.Project()); err != nil {
+			return err
+		}
+		api, err := db.UndeleteApi(ctx, name)
+		if err != nil {
+			return err
+		}
+		response, err = api.Message()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetApi handles the corresponding API request.
 func (s *RegistryServer) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*rpc.Api, error) {
 	db, err := s.getStorageClient(ctx)
@@ -124,10 +172,11 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 	}
 
 	listing, err := db.ListApis(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Order:  req.GetOrderBy(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -150,41 +199,59 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 
 // UpdateApi handles the corresponding API request.
 func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiRequest) (*rpc.Api, error) {
+	name, err := parseUpdateApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	var response *rpc.Api
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		before, err := storedResource(ctx, db.LockApis(ctx, name.ProjectID), name.String())
+		if err != nil {
+			return err
+		}
+		response, err = s.updateApi(ctx, db, name, req)
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, response.GetName(), req.GetUpdateMask(), before, response)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func parseUpdateApiRequest(req *rpc.UpdateApiRequest) (names.Api, error) {
 	// API body must be nonempty.
 	if req.GetApi() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid api %v: body must be provided", req.GetApi())
+		return names.Api{}, status.Errorf(codes.InvalidArgument, "invalid api %v: body must be provided", req.GetApi())
 	}
 	// API name must be valid.
 	name, err := names.ParseApi(req.Api.GetName())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return names.Api{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 	// Update mask must be valid.
 	if err := models.ValidateMask(req.GetApi(), req.GetUpdateMask()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
+		return names.Api{}, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
 	}
-	var response *rpc.Api
-	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		db.LockApis(ctx)
-		api, err := db.GetApi(ctx, name)
-		if err == nil {
-			if err := api.Update(req.GetApi(), models.ExpandMask(req.GetApi(), req.GetUpdateMask())); err != nil {
-				return err
-			}
-			if err := db.SaveApi(ctx, api); err != nil {
-				return err
-			}
-			response, err = api.Message()
-			return err
-		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
-			response, err = s.createApi(ctx, db, name, req.GetApi())
-			return err
-		} else {
-			return err
-		}
-	}); err != nil {
+	return name, nil
+}
+
+func (s *RegistryServer) updateApi(ctx context.Context, db *storage.Client, name names.Api, req *rpc.UpdateApiRequest) (*rpc.Api, error) {
+	if err := checkApiEtag(ctx, db, name, req.Api.GetEtag()); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
-	return response, nil
+	api, err := db.GetApi(ctx, name)
+	if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
+		return s.createApi(ctx, db, name, req.GetApi())
+	} else if err != nil {
+		return nil, err
+	}
+	if err := api.Update(req.GetApi(), models.ExpandMask(req.GetApi(), req.GetUpdateMask())); err != nil {
+		return nil, err
+	}
+	if err := db.SaveApi(ctx, api); err != nil {
+		return nil, err
+	}
+	return api.Message()
 }
@@ -105,7 +105,7 @@ func TestCreateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -351,7 +351,7 @@ func TestGetApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -470,7 +470,7 @@ func TestListApis(t *testing.T) {
 			},
 			wantToken: true,
 			// Ordering is not guaranteed by API, so any resource may be returned.
-			extraOpts: protocmp.IgnoreFields(new(rpc.Api), "name"),
+			extraOpts: protocmp.IgnoreFields(new(rpc.Api), "name", "etag"),
 		},
 		{
 			desc: "name equality filtering",
@@ -644,7 +644,7 @@ func TestListApis(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApisResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 				test.extraOpts,
 			}
 
@@ -861,7 +861,7 @@ func TestListApisSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Api) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -872,6 +872,105 @@ func TestListApisSequence(t *testing.T) {
 	}
 }
 
+// This test changes the collection between page requests. Resources that remain
+// should be listed exactly once, and resources added after the current position
+// should be included.
+func TestListApisConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seed := make([]*rpc.Api, 0, 10)
+	for i := 1; i <= cap(seed); i++ {
+		seed = append(seed, &rpc.Api{
+			Name: fmt.Sprintf("projects/my-project/locations/global/apis/a%02d", i),
+		})
+	}
+	if err := seeder.SeedApis(ctx, server, seed...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.ListApisRequest{
+		Parent:   "projects/my-project/locations/global",
+		PageSize: 3,
+	}
+	got, err := server.ListApis(ctx, req)
+	if err != nil {
+		t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+	}
+	listed := make([]string, 0)
+	for _, api := range got.GetApis() {
+		listed = append(listed, api.GetName())
+	}
+
+	// Delete a listed resource and an unlisted one, and add resources before and after the position.
+	for _, name := range []string{"a02", "a04"} {
+		req := &rpc.DeleteApiRequest{Name: "projects/my-project/locations/global/apis/" + name}
+		if _, err := server.DeleteApi(ctx, req); err != nil {
+			t.Fatalf("Setup: DeleteApi(%+v) returned error: %s", req, err)
+		}
+	}
+	for _, id := range []string{"a00", "a03b"} {
+		req := &rpc.CreateApiRequest{Parent: "projects/my-project/locations/global", ApiId: id, Api: &rpc.Api{}}
+		if _, err := server.CreateApi(ctx, req); err != nil {
+			t.Fatalf("Setup: CreateApi(%+v) returned error: %s", req, err)
+		}
+	}
+
+	for got.GetNextPageToken() != "" {
+		req.PageToken = got.GetNextPageToken()
+		got, err = server.ListApis(ctx, req)
+		if err != nil {
+			t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+		}
+		for _, api := range got.GetApis() {
+			listed = append(listed, api.GetName())
+		}
+	}
+
+	want := []string{"a01", "a02", "a03", "a03b", "a05", "a06", "a07", "a08", "a09", "a10"}
+	for i := range want {
+		want[i] = "projects/my-project/locations/global/apis/" + want[i]
+	}
+	if diff := cmp.Diff(want, listed); diff != "" {
+		t.Errorf("ListApis() returned unexpected sequence (-want +got):\n%s", diff)
+	}
+}
+
+func TestListApisTamperedPageToken(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seed := []*rpc.Api{
+		{Name: "projects/my-project/locations/global/apis/api1"},
+		{Name: "projects/my-project/locations/global/apis/api2"},
+	}
+	if err := seeder.SeedApis(ctx, server, seed...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.ListApisRequest{
+		Parent:   "projects/my-project/locations/global",
+		PageSize: 1,
+	}
+	got, err := server.ListApis(ctx, req)
+	if err != nil {
+		t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+	}
+	token := got.GetNextPageToken()
+	if token == "" {
+		t.Fatalf("ListApis(%+v) returned empty next_page_token, expected another page", req)
+	}
+
+	// Replace a character in the middle of the token.
+	i := len(token) / 2
+	replacement := "A"
+	if token[i:i+1] == replacement {
+		replacement = "B"
+	}
+	req.PageToken = token[:i] + replacement + token[i+1:]
+	if _, err := server.ListApis(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListApis(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.InvalidArgument, err)
+	}
+}
+
 // This test ensures filtering works correctly with paging and prevents the list
 // sequence from ending before a known filter match is listed.
 // For simplicity, it does not guarantee the resource is returned on a later page.
@@ -945,6 +1044,36 @@ func TestListApisLargeCollectionFiltering(t *testing.T) {
 			wantCount:     1000,
 			wantPageToken: true,
 		},
+		{
+			desc: "database select, single page",
+			req: &rpc.ListApisRequest{
+				Parent:   "projects/my-project/locations/global",
+				PageSize: 1000,
+				Filter:   "api_id != 'a099'",
+			},
+			wantCount:     1000,
+			wantPageToken: false,
+		},
+		{
+			desc: "database select, multiple pages",
+			req: &rpc.ListApisRequest{
+				Parent:   "projects/my-project/locations/global",
+				PageSize: 50,
+				Filter:   "api_id.startsWith('a1') || api_id > 'a999'",
+			},
+			wantCount:     50,
+			wantPageToken: true,
+		},
+		{
+			desc: "partial database select, single page",
+			req: &rpc.ListApisRequest{
+				Parent:   "projects/my-project/locations/global",
+				PageSize: 1000,
+				Filter:   "api_id.startsWith('a1') && name.endsWith('0')",
+			},
+			wantCount:     11,
+			wantPageToken: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -966,6 +1095,73 @@ func TestListApisLargeCollectionFiltering(t *testing.T) {
 	}
 }
 
+// This test pages through filtered listings to check that filters evaluated by
+// the database and filters evaluated in memory return the same resources.
+func TestListApisLabelFiltering(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seed := make([]*rpc.Api, 0, 30)
+	for i := 0; i < cap(seed); i++ {
+		api := &rpc.Api{
+			Name: fmt.Sprintf("projects/my-project/locations/global/apis/a%02d", i),
+		}
+		switch i % 3 {
+		case 0:
+			api.Labels = map[string]string{"tier": "gold"}
+		case 1:
+			api.Labels = map[string]string{"tier": "silver", "gold": "tier"}
+		}
+		seed = append(seed, api)
+	}
+	if err := seeder.SeedApis(ctx, server, seed...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	tests := []struct {
+		filter string
+		want   int
+	}{
+		{filter: `has(labels.tier) && labels.tier == "gold"`, want: 10},
+		{filter: `has(labels.tier) && labels["tier"] != "gold"`, want: 10},
+		{filter: `"tier" in labels && api_id < "a15"`, want: 10},
+		{filter: `!has(labels.tier)`, want: 10},
+		{filter: `has(labels.gold) || ("tier" in labels && labels.tier == "gold")`, want: 20},
+	}
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			var got int
+			req := &rpc.ListApisRequest{
+				Parent:   "projects/my-project/locations/global",
+				PageSize: 4,
+				Filter:   test.filter,
+			}
+			for {
+				resp, err := server.ListApis(ctx, req)
+				if err != nil {
+					t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+				}
+				got += len(resp.GetApis())
+				if resp.GetNextPageToken() == "" {
+					break
+				}
+				req.PageToken = resp.GetNextPageToken()
+			}
+			if got != test.want {
+				t.Errorf("ListApis(%q) returned %d APIs, want %d", test.filter, got, test.want)
+			}
+		})
+	}
+
+	// Looking up a label that some APIs don't have is an error.
+	req := &rpc.ListApisRequest{
+		Parent: "projects/my-project/locations/global",
+		Filter: `labels.tier == "gold"`,
+	}
+	if _, err := server.ListApis(ctx, req); err == nil {
+		t.Errorf("ListApis(%+v) succeeded, expected an error for APIs without the label", req)
+	}
+}
+
 func TestUpdateApi(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -1104,7 +1300,7 @@ func TestUpdateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
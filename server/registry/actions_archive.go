@@ -0,0 +1,463 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	longrunning "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// archiveFormat is the version of the ProjectArchive format that is
+// written by ExportProject and read by ImportProject.
+const archiveFormat = 1
+
+var errNotInArchivedProject = errors.New("resource is not in the archived project")
+
+// ExportProject handles the corresponding API request.
+func (s *RegistryServer) ExportProject(ctx context.Context, req *rpc.ExportProjectRequest) (*longrunning.Operation, error) {
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if name.ProjectID == "-" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name %q: project ID must be specified", req.GetName())
+	}
+
+	// Read in a transaction so that the archive is a consistent snapshot.
+	var archive *rpc.ProjectArchive
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var err error
+		archive, err = exportProject(ctx, db, name)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	b, err := proto.Marshal(archive)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := zw.Close(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	metadata, err := anypb.New(&rpc.ExportProjectMetadata{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response, err := anypb.New(&rpc.ExportProjectResponse{
+		Archive:       buf.Bytes(),
+		RevisionCount: int64(len(archive.SpecRevisions) + len(archive.DeploymentRevisions)),
+		ArtifactCount: int64(len(archive.Artifacts)),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &longrunning.Operation{
+		Name:     "export",
+		Metadata: metadata,
+		Done:     true,
+		Result:   &longrunning.Operation_Response{Response: response},
+	}, nil
+}
+
+// exportProject reads a project and all of its resources into an archive.
+// Etags are omitted because they are recomputed when resources are read.
+func exportProject(ctx context.Context, db *storage.Client, name names.Project) (*rpc.ProjectArchive, error) {
+	project, err := db.GetProject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := db.ListProjectResources(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &rpc.ProjectArchive{
+		Format:     archiveFormat,
+		ExportTime: timestamppb.Now(),
+		Project:    project.Message(),
+	}
+	for _, api := range resources.Apis {
+		m, err := api.Message()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		m.Etag = ""
+		archive.Apis = append(archive.Apis, m)
+	}
+	for _, version := range resources.Versions {
+		m, err := version.Message()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		m.Etag = ""
+		archive.Versions = append(archive.Versions, m)
+	}
+	for _, spec := range resources.Specs {
+		m, err := spec.BasicMessage(spec.RevisionName())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		m.Etag = ""
+		name, err := names.ParseSpecRevision(m.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		blob, err := db.GetSpecRevisionContents(ctx, name)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		} else if err == nil {
+			m.Contents = blob.Contents
+		}
+		archive.SpecRevisions = append(archive.SpecRevisions, m)
+	}
+	for _, deployment := range resources.Deployments {
+		m, err := deployment.BasicMessage(deployment.RevisionName())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		m.Etag = ""
+		archive.DeploymentRevisions = append(archive.DeploymentRevisions, m)
+	}
+	for _, artifact := range resources.Artifacts {
+		m, err := artifact.Message()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		m.Etag = ""
+		name, err := names.ParseArtifact(m.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		blob, err := db.GetArtifactContents(ctx, name)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		} else if err == nil {
+			m.Contents = blob.Contents
+		}
+		archive.Artifacts = append(archive.Artifacts, m)
+	}
+	for _, tag := range resources.SpecRevisionTags {
+		archive.SpecRevisionTags = append(archive.SpecRevisionTags, &rpc.ProjectArchive_RevisionTag{
+			Revision:   tag.ParentSpecKey,
+			Tag:        tag.Tag,
+			CreateTime: timestamppb.New(tag.CreateTime),
+			UpdateTime: timestamppb.New(tag.UpdateTime),
+		})
+	}
+	for _, tag := range resources.DeploymentRevisionTags {
+		archive.DeploymentRevisionTags = append(archive.DeploymentRevisionTags, &rpc.ProjectArchive_RevisionTag{
+			Revision:   tag.ParentDeploymentKey,
+			Tag:        tag.Tag,
+			CreateTime: timestamppb.New(tag.CreateTime),
+			UpdateTime: timestamppb.New(tag.UpdateTime),
+		})
+	}
+	return archive, nil
+}
+
+// ImportProject handles the corresponding API request.
+func (s *RegistryServer) ImportProject(ctx context.Context, req *rpc.ImportProjectRequest) (*longrunning.Operation, error) {
+	archive, err := readArchive(req.GetArchive())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid archive: %s", err)
+	}
+	exported, err := names.ParseProject(archive.GetProject().GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid archive: %s", err)
+	}
+	name := exported
+	if id := req.GetProjectId(); id != "" {
+		name = names.Project{ProjectID: id}
+	}
+	if err := name.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var response *rpc.Project
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var err error
+		response, err = s.importProject(ctx, db, archive, exported, name)
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
+	}); err != nil {
+		return nil, err
+	}
+
+	metadata, err := anypb.New(&rpc.ImportProjectMetadata{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result, err := anypb.New(response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &longrunning.Operation{
+		Name:     "import",
+		Metadata: metadata,
+		Done:     true,
+		Result:   &longrunning.Operation_Response{Response: result},
+	}, nil
+}
+
+// readArchive uncompresses and parses an archive that was written by ExportProject.
+func readArchive(b []byte) (*rpc.ProjectArchive, error) {
+	b, err := models.GUnzippedBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	archive := new(rpc.ProjectArchive)
+	if err := proto.Unmarshal(b, archive); err != nil {
+		return nil, err
+	}
+	if archive.GetFormat() != archiveFormat {
+		return nil, fmt.Errorf("unsupported format %d", archive.GetFormat())
+	}
+	return archive, nil
+}
+
+// importProject creates a project and all of its resources from an archive
+// that was exported from the project named exported. The names of archived
+// resources and references between them are moved to the imported project,
+// and the resources count against the quotas of the imported project.
+func (s *RegistryServer) importProject(ctx context.Context, db *storage.Client, archive *rpc.ProjectArchive, exported, name names.Project) (*rpc.Project, error) {
+	from, to := exported.String()+"/", name.String()+"/"
+	rename := func(n string) string {
+		if strings.HasPrefix(n, from) {
+			return to + strings.TrimPrefix(n, from)
+		}
+		return n
+	}
+	invalid := func(n string, err error) error {
+		return status.Errorf(codes.InvalidArgument, "invalid archive: resource %q: %s", n, err)
+	}
+
+	project := models.NewProject(name, archive.GetProject())
+	project.CreateTime = archivedTime(archive.GetProject().GetCreateTime(), project.CreateTime)
+	project.UpdateTime = archivedTime(archive.GetProject().GetUpdateTime(), project.UpdateTime)
+	if err := db.CreateProject(ctx, project); err != nil {
+		return nil, err
+	}
+
+	for _, m := range archive.GetApis() {
+		n, err := names.ParseApi(rename(m.GetName()))
+		if err == nil && n.ProjectID != name.ProjectID {
+			err = errNotInArchivedProject
+		}
+		if err != nil {
+			return nil, invalid(m.GetName(), err)
+		}
+		m.RecommendedVersion = rename(m.GetRecommendedVersion())
+		m.RecommendedDeployment = rename(m.GetRecommendedDeployment())
+		if err := s.checkApiQuota(ctx, db, name); err != nil {
+			return nil, err
+		}
+		api, err := models.NewApi(n, m)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		api.CreateTime = archivedTime(m.GetCreateTime(), api.CreateTime)
+		api.UpdateTime = archivedTime(m.GetUpdateTime(), api.UpdateTime)
+		if err := db.CreateApi(ctx, api); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range archive.GetVersions() {
+		n, err := names.ParseVersion(rename(m.GetName()))
+		if err == nil && n.ProjectID != name.ProjectID {
+			err = errNotInArchivedProject
+		}
+		if err != nil {
+			return nil, invalid(m.GetName(), err)
+		}
+		m.PrimarySpec = rename(m.GetPrimarySpec())
+		version, err := models.NewVersion(n, m)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		version.CreateTime = archivedTime(m.GetCreateTime(), version.CreateTime)
+		version.UpdateTime = archivedTime(m.GetUpdateTime(), version.UpdateTime)
+		if err := db.CreateVersion(ctx, version); err != nil {
+			return nil, err
+		}
+	}
+
+	// Revisions are created from oldest to newest, so that the newest
+	// revision of each spec is the one that is indexed for search.
+	specs := archive.GetSpecRevisions()
+	sort.SliceStable(specs, func(i, j int) bool {
+		return specs[i].GetRevisionCreateTime().AsTime().Before(specs[j].GetRevisionCreateTime().AsTime())
+	})
+	for _, m := range specs {
+		n, err := names.ParseSpecRevision(rename(m.GetName()))
+		if err == nil && n.ProjectID != name.ProjectID {
+			err = errNotInArchivedProject
+		} else if err == nil && n.RevisionID == "" {
+			err = errors.New("revision ID must be specified")
+		}
+		if err != nil {
+			return nil, invalid(m.GetName(), err)
+		}
+		spec, err := models.NewSpec(n.Spec(), m)
+		if err != nil {
+			return nil, err
+		}
+		spec.RevisionID = n.RevisionID
+		spec.CreateTime = archivedTime(m.GetCreateTime(), spec.CreateTime)
+		spec.RevisionCreateTime = archivedTime(m.GetRevisionCreateTime(), spec.RevisionCreateTime)
+		spec.RevisionUpdateTime = archivedTime(m.GetRevisionUpdateTime(), spec.RevisionUpdateTime)
+		if err := s.checkSpecRevisionQuota(ctx, db, n.Spec()); err != nil {
+			return nil, err
+		}
+		if err := s.checkBlobQuota(ctx, db, name, spec.RevisionName(), len(m.GetContents())); err != nil {
+			return nil, err
+		}
+		if err := db.CreateSpecRevision(ctx, spec); err != nil {
+			return nil, err
+		}
+		if err := db.SaveSpecRevisionContents(ctx, spec, m.GetContents()); err != nil {
+			return nil, err
+		}
+	}
+
+	deployments := archive.GetDeploymentRevisions()
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].GetRevisionCreateTime().AsTime().Before(deployments[j].GetRevisionCreateTime().AsTime())
+	})
+	for _, m := range deployments {
+		n, err := names.ParseDeploymentRevision(rename(m.GetName()))
+		if err == nil && n.ProjectID != name.ProjectID {
+			err = errNotInArchivedProject
+		} else if err == nil && n.RevisionID == "" {
+			err = errors.New("revision ID must be specified")
+		}
+		if err != nil {
+			return nil, invalid(m.GetName(), err)
+		}
+		m.ApiSpecRevision = rename(m.GetApiSpecRevision())
+		deployment, err := models.NewDeployment(n.Deployment(), m)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		deployment.RevisionID = n.RevisionID
+		deployment.CreateTime = archivedTime(m.GetCreateTime(), deployment.CreateTime)
+		deployment.RevisionCreateTime = archivedTime(m.GetRevisionCreateTime(), deployment.RevisionCreateTime)
+		deployment.RevisionUpdateTime = archivedTime(m.GetRevisionUpdateTime(), deployment.RevisionUpdateTime)
+		if err := db.CreateDeploymentRevision(ctx, deployment); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range archive.GetArtifacts() {
+		n, err := names.ParseArtifact(rename(m.GetName()))
+		if err == nil && n.ProjectID() != name.ProjectID {
+			err = errNotInArchivedProject
+		}
+		if err != nil {
+			return nil, invalid(m.GetName(), err)
+		}
+		artifact, err := models.NewArtifact(n, m)
+		if err != nil {
+			return nil, err
+		}
+		artifact.CreateTime = archivedTime(m.GetCreateTime(), artifact.CreateTime)
+		artifact.UpdateTime = archivedTime(m.GetUpdateTime(), artifact.UpdateTime)
+		if err := s.checkBlobQuota(ctx, db, name, artifact.Name(), len(m.GetContents())); err != nil {
+			return nil, err
+		}
+		if err := db.CreateArtifact(ctx, artifact); err != nil {
+			return nil, err
+		}
+		if err := db.SaveArtifactContents(ctx, artifact, m.GetContents()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Tags must refer to archived revisions.
+	revisions := make(map[string]bool, len(specs)+len(deployments))
+	for _, m := range specs {
+		revisions[rename(m.GetName())] = true
+	}
+	for _, m := range deployments {
+		revisions[rename(m.GetName())] = true
+	}
+	for _, t := range archive.GetSpecRevisionTags() {
+		n, err := names.ParseSpecRevision(rename(t.GetRevision()))
+		if err == nil && !revisions[n.String()] {
+			err = errors.New("tagged revision is not in the archive")
+		}
+		if err != nil {
+			return nil, invalid(t.GetRevision(), err)
+		}
+		tag := models.NewSpecRevisionTag(n, t.GetTag())
+		tag.CreateTime = archivedTime(t.GetCreateTime(), tag.CreateTime)
+		tag.UpdateTime = archivedTime(t.GetUpdateTime(), tag.UpdateTime)
+		if err := db.SaveSpecRevisionTag(ctx, tag); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range archive.GetDeploymentRevisionTags() {
+		n, err := names.ParseDeploymentRevision(rename(t.GetRevision()))
+		if err == nil && !revisions[n.String()] {
+			err = errors.New("tagged revision is not in the archive")
+		}
+		if err != nil {
+			return nil, invalid(t.GetRevision(), err)
+		}
+		tag := models.NewDeploymentRevisionTag(n, t.GetTag())
+		tag.CreateTime = archivedTime(t.GetCreateTime(), tag.CreateTime)
+		tag.UpdateTime = archivedTime(t.GetUpdateTime(), tag.UpdateTime)
+		if err := db.SaveDeploymentRevisionTag(ctx, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	return project.Message(), nil
+}
+
+// archivedTime returns an archived timestamp, or a default value if the
+// timestamp is missing.
+func archivedTime(t *timestamppb.Timestamp, def time.Time) time.Time {
+	if t == nil {
+		return def
+	}
+	return t.AsTime().Round(time.Microsecond)
+}
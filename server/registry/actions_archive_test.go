@@ -0,0 +1,379 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// archiveTestServer returns a server with the revisions of pruneTestServer,
+// an artifact of a spec revision, a project artifact and a deleted API.
+func archiveTestServer(t *testing.T) (*RegistryServer, pruneTestRevisions) {
+	t.Helper()
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	for _, req := range []*rpc.CreateArtifactRequest{
+		{
+			Parent:     revisions.specs[2],
+			ArtifactId: "lint",
+			Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: []byte("ok")},
+		},
+		{
+			Parent:     pruneProject,
+			ArtifactId: "empty",
+			Artifact:   &rpc.Artifact{},
+		},
+	} {
+		if _, err := server.CreateArtifact(ctx, req); err != nil {
+			t.Fatalf("Setup: CreateArtifact(%+v) returned error: %s", req, err)
+		}
+	}
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{Name: pruneApi, RecommendedDeployment: pruneDeployment},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: pruneProject,
+		ApiId:  "deleted",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApi() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: pruneProject + "/apis/deleted"}); err != nil {
+		t.Fatalf("Setup: DeleteApi() returned error: %s", err)
+	}
+	return server, revisions
+}
+
+func exportArchive(t *testing.T, server *RegistryServer, name string) []byte {
+	t.Helper()
+	op, err := server.ExportProject(context.Background(), &rpc.ExportProjectRequest{Name: name})
+	if err != nil {
+		t.Fatalf("ExportProject(%q) returned error: %s", name, err)
+	}
+	response := new(rpc.ExportProjectResponse)
+	if err := op.GetResponse().UnmarshalTo(response); err != nil {
+		t.Fatalf("ExportProject(%q) returned unexpected response: %s", name, err)
+	}
+	return response.GetArchive()
+}
+
+func importArchive(t *testing.T, server *RegistryServer, id string, archive []byte) *rpc.Project {
+	t.Helper()
+	op, err := server.ImportProject(context.Background(), &rpc.ImportProjectRequest{ProjectId: id, Archive: archive})
+	if err != nil {
+		t.Fatalf("ImportProject(%q) returned error: %s", id, err)
+	}
+	project := new(rpc.Project)
+	if err := op.GetResponse().UnmarshalTo(project); err != nil {
+		t.Fatalf("ImportProject(%q) returned unexpected response: %s", id, err)
+	}
+	return project
+}
+
+func TestExportImportProject(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := archiveTestServer(t)
+	archive := exportArchive(t, server, "projects/my-project")
+
+	got, err := readArchive(archive)
+	if err != nil {
+		t.Fatalf("readArchive() returned error: %s", err)
+	}
+	if n := len(got.GetApis()); n != 1 {
+		t.Errorf("Archive has %d APIs, expected 1 without the deleted API", n)
+	}
+	if n := len(got.GetSpecRevisions()); n != len(revisions.specs) {
+		t.Errorf("Archive has %d spec revisions, expected %d", n, len(revisions.specs))
+	}
+	if n := len(got.GetDeploymentRevisions()); n != len(revisions.deployments) {
+		t.Errorf("Archive has %d deployment revisions, expected %d", n, len(revisions.deployments))
+	}
+
+	// Restoring the project on another server reproduces it exactly.
+	restored := serverWithSinks(t)
+	project := importArchive(t, restored, "", archive)
+	if project.GetName() != "projects/my-project" {
+		t.Errorf("ImportProject() returned project %q, expected %q", project.GetName(), "projects/my-project")
+	}
+	want, err := readArchive(exportArchive(t, restored, "projects/my-project"))
+	if err != nil {
+		t.Fatalf("readArchive() returned error: %s", err)
+	}
+	opts := cmp.Options{protocmp.Transform(), protocmp.IgnoreFields(&rpc.ProjectArchive{}, "export_time")}
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Errorf("Restored project has unexpected diff (-want +got):\n%s", diff)
+	}
+	for _, server := range []*RegistryServer{server, restored} {
+		spec, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: pruneSpec + "@keep"})
+		if err != nil {
+			t.Fatalf("GetApiSpec(%q) returned error: %s", pruneSpec+"@keep", err)
+		}
+		if name := pruneSpec + "@" + spec.GetRevisionId(); name != revisions.specs[2] {
+			t.Errorf("GetApiSpec(%q) returned revision %q, expected %q", pruneSpec+"@keep", name, revisions.specs[2])
+		}
+	}
+
+	// Importing the project with another ID moves its names and references.
+	importArchive(t, server, "copy", archive)
+	copied := strings.ReplaceAll(revisions.specs[3], "projects/my-project/", "projects/copy/")
+	deployment, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{
+		Name: strings.ReplaceAll(revisions.deployments[2], "projects/my-project/", "projects/copy/"),
+	})
+	if err != nil {
+		t.Fatalf("GetApiDeployment() returned error: %s", err)
+	}
+	if deployment.GetApiSpecRevision() != copied {
+		t.Errorf("GetApiDeployment() returned spec revision %q, expected %q", deployment.GetApiSpecRevision(), copied)
+	}
+	contents, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: copied})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents(%q) returned error: %s", copied, err)
+	}
+	if string(contents.GetData()) != "3" {
+		t.Errorf("GetApiSpecContents(%q) returned %q, expected %q", copied, contents.GetData(), "3")
+	}
+	artifact := strings.ReplaceAll(revisions.specs[2], "projects/my-project/", "projects/copy/") + "/artifacts/lint"
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: artifact}); err != nil {
+		t.Errorf("GetArtifact(%q) returned error: %s", artifact, err)
+	}
+}
+
+func TestExportProjectErrors(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithSinks(t)
+	tests := []struct {
+		name string
+		want codes.Code
+	}{
+		{name: "invalid", want: codes.InvalidArgument},
+		{name: "projects/-", want: codes.InvalidArgument},
+		{name: "projects/missing", want: codes.NotFound},
+	}
+	for _, test := range tests {
+		_, err := server.ExportProject(ctx, &rpc.ExportProjectRequest{Name: test.name})
+		if status.Code(err) != test.want {
+			t.Errorf("ExportProject(%q) returned status code %q, want %q: %v", test.name, status.Code(err), test.want, err)
+		}
+	}
+}
+
+func TestImportProjectRecomputesHashes(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := archiveTestServer(t)
+	archive, err := readArchive(exportArchive(t, server, "projects/my-project"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range archive.GetSpecRevisions() {
+		m.Hash, m.SizeBytes = "tampered", 1
+	}
+	for _, m := range archive.GetArtifacts() {
+		m.Hash, m.SizeBytes = "tampered", 1
+	}
+	importArchive(t, server, "copy", compressArchive(t, archive))
+
+	for _, name := range revisions.specs {
+		want, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetApiSpec(%q) returned error: %s", name, err)
+		}
+		copied := strings.ReplaceAll(name, "projects/my-project/", "projects/copy/")
+		got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: copied})
+		if err != nil {
+			t.Fatalf("GetApiSpec(%q) returned error: %s", copied, err)
+		}
+		if got.GetHash() != want.GetHash() || got.GetSizeBytes() != want.GetSizeBytes() {
+			t.Errorf("GetApiSpec(%q) returned hash %q and size %d, expected %q and %d", copied, got.GetHash(), got.GetSizeBytes(), want.GetHash(), want.GetSizeBytes())
+		}
+	}
+	want, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: revisions.specs[2] + "/artifacts/lint"})
+	if err != nil {
+		t.Fatalf("GetArtifact() returned error: %s", err)
+	}
+	artifact := strings.ReplaceAll(want.GetName(), "projects/my-project/", "projects/copy/")
+	got, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: artifact})
+	if err != nil {
+		t.Fatalf("GetArtifact(%q) returned error: %s", artifact, err)
+	}
+	if got.GetHash() != want.GetHash() || got.GetSizeBytes() != want.GetSizeBytes() {
+		t.Errorf("GetArtifact(%q) returned hash %q and size %d, expected %q and %d", artifact, got.GetHash(), got.GetSizeBytes(), want.GetHash(), want.GetSizeBytes())
+	}
+}
+
+func TestImportProjectErrors(t *testing.T) {
+	ctx := context.Background()
+	server, _ := archiveTestServer(t)
+	archive := exportArchive(t, server, "projects/my-project")
+	newerFormat, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newerFormat.Format = archiveFormat + 1
+	foreign, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign.Apis = append(foreign.Apis, &rpc.Api{Name: "projects/other/locations/global/apis/a"})
+	untagged, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	untagged.SpecRevisions = untagged.SpecRevisions[1:]
+	untagged.SpecRevisionTags = append(untagged.SpecRevisionTags, &rpc.ProjectArchive_RevisionTag{
+		Revision: archiveTestRevision(t, archive),
+		Tag:      "missing",
+	})
+
+	tests := []struct {
+		desc string
+		req  *rpc.ImportProjectRequest
+		want codes.Code
+	}{
+		{
+			desc: "existing project",
+			req:  &rpc.ImportProjectRequest{Archive: archive},
+			want: codes.AlreadyExists,
+		},
+		{
+			desc: "invalid project id",
+			req:  &rpc.ImportProjectRequest{ProjectId: "Invalid!", Archive: archive},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "uncompressed archive",
+			req:  &rpc.ImportProjectRequest{ProjectId: "a", Archive: []byte("archive")},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "newer format",
+			req:  &rpc.ImportProjectRequest{ProjectId: "b", Archive: compressArchive(t, newerFormat)},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "resource in another project",
+			req:  &rpc.ImportProjectRequest{ProjectId: "c", Archive: compressArchive(t, foreign)},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "tag of missing revision",
+			req:  &rpc.ImportProjectRequest{ProjectId: "d", Archive: compressArchive(t, untagged)},
+			want: codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := server.ImportProject(ctx, test.req)
+			if status.Code(err) != test.want {
+				t.Errorf("ImportProject() returned status code %q, want %q: %v", status.Code(err), test.want, err)
+			}
+		})
+	}
+
+	// Failed imports leave nothing behind.
+	for _, id := range []string{"c", "d"} {
+		if _, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: "projects/" + id}); status.Code(err) != codes.NotFound {
+			t.Errorf("GetProject(%q) returned status code %q, want %q: %v", id, status.Code(err), codes.NotFound, err)
+		}
+	}
+}
+
+func TestImportProjectQuotas(t *testing.T) {
+	ctx := context.Background()
+	source, _ := archiveTestServer(t)
+	archive := exportArchive(t, source, "projects/my-project")
+	contents, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blobBytes int64
+	for _, m := range contents.GetSpecRevisions() {
+		blobBytes += int64(len(m.GetContents()))
+	}
+	for _, m := range contents.GetArtifacts() {
+		blobBytes += int64(len(m.GetContents()))
+	}
+	revisions := int64(len(contents.GetSpecRevisions()))
+	extraApi := proto.Clone(contents).(*rpc.ProjectArchive)
+	extraApi.Apis = append(extraApi.Apis, &rpc.Api{Name: "projects/my-project/locations/global/apis/extra"})
+
+	server := serverWithQuotas(t, QuotaConfig{Projects: map[string]Limits{
+		"apis":      {MaxApis: 1},
+		"revisions": {MaxSpecRevisions: revisions - 1},
+		"blobs":     {MaxBlobBytes: blobBytes - 1},
+		"enough":    {MaxApis: 1, MaxSpecRevisions: revisions, MaxBlobBytes: blobBytes},
+	}})
+	tests := []struct {
+		id      string
+		archive []byte
+		subject string
+	}{
+		{id: "apis", archive: compressArchive(t, extraApi), subject: "projects/apis"},
+		{id: "revisions", archive: archive, subject: strings.ReplaceAll(pruneSpec, "projects/my-project/", "projects/revisions/")},
+		{id: "blobs", archive: archive, subject: "projects/blobs"},
+	}
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			_, err := server.ImportProject(ctx, &rpc.ImportProjectRequest{ProjectId: test.id, Archive: test.archive})
+			checkQuotaFailure(t, err, test.subject)
+			if _, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: "projects/" + test.id}); status.Code(err) != codes.NotFound {
+				t.Errorf("GetProject(%q) returned status code %q, want %q: %v", test.id, status.Code(err), codes.NotFound, err)
+			}
+		})
+	}
+
+	// Archives that fit the quotas are imported.
+	importArchive(t, server, "enough", archive)
+}
+
+// archiveTestRevision returns the name of the oldest spec revision in an archive.
+func archiveTestRevision(t *testing.T, archive []byte) string {
+	t.Helper()
+	a, err := readArchive(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.GetSpecRevisions()[0].GetName()
+}
+
+// compressArchive returns an archive in the format that ImportProject reads.
+func compressArchive(t *testing.T, m *rpc.ProjectArchive) []byte {
+	t.Helper()
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -62,61 +62,80 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 	}
 	var response *rpc.Artifact
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		// Creation should only succeed when the parent exists.
-		var err error
-		switch typedParent := parent.(type) {
-		case names.Project:
-			_, err = db.LockProjects(ctx).GetProject(ctx, typedParent)
-		case names.Api:
-			_, err = db.LockApis(ctx).GetApi(ctx, typedParent)
-		case names.Version:
-			_, err = db.LockVersions(ctx).GetVersion(ctx, typedParent)
-		case names.Spec:
-			// assign to latest revision
-			var spec *models.Spec
-			spec, err = db.LockSpecs(ctx).GetSpec(ctx, typedParent)
-			if err == nil {
-				parent = parent.(names.Spec).Revision(spec.RevisionID)
-			}
-		case names.SpecRevision:
-			_, err = db.LockSpecs(ctx).GetSpecRevision(ctx, typedParent)
-		case names.Deployment:
-			// assign to latest revision
-			var deployment *models.Deployment
-			deployment, err = db.LockDeployments(ctx).GetDeployment(ctx, typedParent)
-			if err == nil {
-				parent = parent.(names.Deployment).Revision(deployment.RevisionID)
-			}
-		}
+		name, err := resolveArtifactName(ctx, db, parent, req.GetArtifactId())
 		if err != nil {
 			return err
 		}
-		// Artifact name must be valid.
-		name := parent.Artifact(req.GetArtifactId())
-		if err := name.Validate(); err != nil {
-			return status.Error(codes.InvalidArgument, err.Error())
-		}
-
-		artifact, err := models.NewArtifact(name, req.GetArtifact())
+		response, err = s.createArtifact(ctx, db, name, req.GetArtifact())
 		if err != nil {
 			return err
 		}
-		if err := db.CreateArtifact(ctx, artifact); err != nil {
-			return err
-		}
-		if err := db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents()); err != nil {
-			return err
-		}
-		response, err = artifact.Message()
-		return err
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
+// resolveArtifactName returns the name of an artifact to be created under
+// the specified parent. Artifacts of specs and deployments are assigned to
+// their latest revisions.
+func resolveArtifactName(ctx context.Context, db *storage.Client, parent artifactParent, id string) (names.Artifact, error) {
+	// Creation should only succeed when the parent exists.
+	var err error
+	switch typedParent := parent.(type) {
+	case names.Project:
+		_, err = db.LockProjects(ctx, typedParent.ProjectID).GetProject(ctx, typedParent)
+	case names.Api:
+		_, err = db.LockApis(ctx, typedParent.ProjectID).GetApi(ctx, typedParent)
+	case names.Version:
+		_, err = db.LockVersions(ctx, typedParent.ProjectID).GetVersion(ctx, typedParent)
+	case names.Spec:
+		// assign to latest revision
+		var spec *models.Spec
+		spec, err = db.LockSpecs(ctx, typedParent.ProjectID).GetSpec(ctx, typedParent)
+		if err == nil {
+			parent = parent.(names.Spec).Revision(spec.RevisionID)
+		}
+	case names.SpecRevision:
+		_, err = db.LockSpecs(ctx, typedParent.ProjectID).GetSpecRevision(ctx, typedParent)
+	case names.Deployment:
+		// assign to latest revision
+		var deployment *models.Deployment
+		deployment, err = db.LockDeployments(ctx, typedParent.ProjectID).GetDeployment(ctx, typedParent)
+		if err == nil {
+			parent = parent.(names.Deployment).Revision(deployment.RevisionID)
+		}
+	}
+	if err != nil {
+		return names.Artifact{}, err
+	}
+	// Artifact name must be valid.
+	name := parent.Artifact(id)
+	if err := name.Validate(); err != nil {
+		return names.Artifact{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return name, nil
+}
+
+func (s *RegistryServer) createArtifact(ctx context.Context, db *storage.Client, name names.Artifact, body *rpc.Artifact) (*rpc.Artifact, error) {
+	artifact, err := models.NewArtifact(name, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBlobQuota(ctx, db, name.Project(), artifact.Name(), len(body.GetContents())); err != nil {
+		return nil, err
+	}
+	if err := db.CreateArtifact(ctx, artifact); err != nil {
+		return nil, err
+	}
+	if err := db.SaveArtifactContents(ctx, artifact, body.GetContents()); err != nil {
+		return nil, err
+	}
+	return artifact.Message()
+}
+
 // DeleteArtifact handles the corresponding API request.
 func (s *RegistryServer) DeleteArtifact(ctx context.Context, req *rpc.DeleteArtifactRequest) (*emptypb.Empty, error) {
 	// Artifact name must be valid.
@@ -125,11 +144,20 @@ func (s *RegistryServer) DeleteArtifact(ctx context.Context, req *rpc.DeleteArti
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.DeleteArtifact(ctx, name)
+		if err := checkArtifactEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
+		if err := db.DeleteArtifact(ctx, name); err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, req.GetName(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
@@ -287,38 +315,56 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 
 // ReplaceArtifact handles the corresponding API request.
 func (s *RegistryServer) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceArtifactRequest) (*rpc.Artifact, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-
 	name, err := names.ParseArtifact(req.Artifact.GetName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var artifact *models.Artifact
-	err = db.Transaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		// Replacement should only succeed on artifacts that currently exist.
-		art, err := db.GetArtifact(ctx, name, true)
+	var response *rpc.Artifact
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		before, err := storedResource(ctx, db, name.String())
 		if err != nil {
 			return err
 		}
-		artifact, err = models.NewArtifact(name, req.GetArtifact())
+		response, err = s.replaceArtifact(ctx, db, name, req.GetArtifact())
 		if err != nil {
 			return err
 		}
-		artifact.CreateTime = art.CreateTime // preserve creation time
-		artifact.RevisionID = art.RevisionID // revision is optional in request
-		if err := db.SaveArtifact(ctx, artifact); err != nil {
-			return err
-		}
-		return db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents())
-	})
-	if err != nil {
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, name.String(), nil, before, response)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
+	return response, nil
+}
+
+func (s *RegistryServer) replaceArtifact(ctx context.Context, db *storage.Client, name names.Artifact, body *rpc.Artifact) (*rpc.Artifact, error) {
+	// Replacement should only succeed on artifacts that currently exist.
+	art, err := db.GetArtifact(ctx, name, true)
+	if err != nil {
+		return nil, err
+	}
+	current, err := art.Message()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := checkEtag(body.GetEtag(), current); err != nil {
+		return nil, err
+	}
+	artifact, err := models.NewArtifact(name, body)
+	if err != nil {
+		return nil, err
+	}
+	artifact.CreateTime = art.CreateTime // preserve creation time
+	artifact.RevisionID = art.RevisionID // revision is optional in request
+	if err := s.checkBlobQuota(ctx, db, name.Project(), artifact.Name(), len(body.GetContents())); err != nil {
+		return nil, err
+	}
+	if err := db.SaveArtifact(ctx, artifact); err != nil {
+		return nil, err
+	}
+	if err := db.SaveArtifactContents(ctx, artifact, body.GetContents()); err != nil {
+		return nil, err
+	}
 	return artifact.Message()
 }
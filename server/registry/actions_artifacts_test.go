@@ -165,7 +165,7 @@ func TestCreateArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -455,7 +455,7 @@ func TestGetArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -716,7 +716,7 @@ func TestListArtifacts(t *testing.T) {
 			},
 			wantToken: true,
 			// Ordering is not guaranteed by API, so any resource may be returned.
-			extraOpts: protocmp.IgnoreFields(new(rpc.Artifact), "name"),
+			extraOpts: protocmp.IgnoreFields(new(rpc.Artifact), "name", "etag"),
 		},
 		{
 			desc: "name equality filtering",
@@ -948,7 +948,7 @@ func TestListArtifacts(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListArtifactsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 				test.extraOpts,
 			}
 
@@ -1199,7 +1199,7 @@ func TestListArtifactsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Artifact) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -1311,7 +1311,7 @@ func TestReplaceArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
@@ -1610,7 +1610,7 @@ func TestSpecRevisionArtifacts(t *testing.T) {
 
 		opts := cmp.Options{
 			protocmp.Transform(),
-			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 		}
 
 		if !cmp.Equal(want, artifact, opts) {
@@ -1643,7 +1643,7 @@ func TestSpecRevisionArtifacts(t *testing.T) {
 
 		opts := cmp.Options{
 			protocmp.Transform(),
-			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 		}
 
 		if !cmp.Equal(want, artifact, opts) {
@@ -1686,7 +1686,7 @@ func TestSpecRevisionArtifacts(t *testing.T) {
 
 				opts := cmp.Options{
 					protocmp.Transform(),
-					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes"),
+					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes", "etag"),
 				}
 
 				if !cmp.Equal(test.want, got, opts) {
@@ -1823,7 +1823,7 @@ func TestSpecRevisionArtifacts(t *testing.T) {
 				opts := cmp.Options{
 					protocmp.Transform(),
 					protocmp.IgnoreFields(new(rpc.ListArtifactsResponse), "next_page_token"),
-					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes"),
+					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes", "etag"),
 				}
 
 				if !cmp.Equal(test.want, got, opts) {
@@ -1957,7 +1957,7 @@ func TestDeploymentRevisionArtifacts(t *testing.T) {
 
 		opts := cmp.Options{
 			protocmp.Transform(),
-			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 		}
 
 		if !cmp.Equal(want, artifact, opts) {
@@ -1990,7 +1990,7 @@ func TestDeploymentRevisionArtifacts(t *testing.T) {
 
 		opts := cmp.Options{
 			protocmp.Transform(),
-			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+			protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "etag"),
 		}
 
 		if !cmp.Equal(want, artifact, opts) {
@@ -2033,7 +2033,7 @@ func TestDeploymentRevisionArtifacts(t *testing.T) {
 
 				opts := cmp.Options{
 					protocmp.Transform(),
-					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes"),
+					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes", "etag"),
 				}
 
 				if !cmp.Equal(test.want, got, opts) {
@@ -2156,7 +2156,7 @@ func TestDeploymentRevisionArtifacts(t *testing.T) {
 				opts := cmp.Options{
 					protocmp.Transform(),
 					protocmp.IgnoreFields(new(rpc.ListArtifactsResponse), "next_page_token"),
-					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes"),
+					protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time", "hash", "mime_type", "size_bytes", "etag"),
 				}
 
 				if !cmp.Equal(test.want, got, opts) {
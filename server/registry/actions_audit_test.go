@@ -0,0 +1,223 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/authz"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// methodStream provides the method name of a call to handlers that are called directly.
+type methodStream struct {
+	method string
+}
+
+func (s methodStream) Method() string                  { return s.method }
+func (s methodStream) SetHeader(metadata.MD) error     { return nil }
+func (s methodStream) SendHeader(metadata.MD) error    { return nil }
+func (s methodStream) SetTrailer(md metadata.MD) error { return nil }
+
+// callContext returns the context of a call of a method by a caller.
+func callContext(caller, method string) context.Context {
+	ctx := authz.NewContext(context.Background(), caller)
+	return grpc.NewContextWithServerTransportStream(ctx, methodStream{method: "/google.cloud.apigeeregistry.v1.Registry/" + method})
+}
+
+func listAuditEntries(t *testing.T, server *RegistryServer, filter string) []*rpc.AuditEntry {
+	t.Helper()
+	var entries []*rpc.AuditEntry
+	req := &rpc.ListAuditEntriesRequest{Filter: filter, PageSize: 2}
+	for {
+		resp, err := server.ListAuditEntries(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListAuditEntries(%+v) returned error: %s", req, err)
+		}
+		entries = append(entries, resp.GetAuditEntries()...)
+		if resp.GetNextPageToken() == "" {
+			return entries
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func TestAuditEntries(t *testing.T) {
+	server := serverWithSinks(t)
+	createProjects(t, server, "my-project")
+	api := "projects/my-project/locations/global/apis/a"
+	if _, err := server.CreateApi(callContext("alice@example.com", "CreateApi"), &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	if _, err := server.UpdateApi(callContext("bob@example.com", "UpdateApi"), &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: api, DisplayName: "A"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	// A change that fails must not be recorded.
+	if _, err := server.CreateApi(callContext("alice@example.com", "CreateApi"), &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("CreateApi() returned status code %s, want %s", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := server.DeleteApi(callContext("alice@example.com", "DeleteApi"), &rpc.DeleteApiRequest{Name: api}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+
+	entries := listAuditEntries(t, server, "")
+	want := []*rpc.AuditEntry{
+		{Sequence: 1, Resource: "projects/my-project", Change: rpc.Notification_CREATED},
+		{Sequence: 2, Resource: api, Change: rpc.Notification_CREATED, Principal: "alice@example.com", Method: "CreateApi"},
+		{Sequence: 3, Resource: api, Change: rpc.Notification_UPDATED, Principal: "bob@example.com", Method: "UpdateApi",
+			UpdateMaskPaths: []string{"display_name"}},
+		{Sequence: 4, Resource: api, Change: rpc.Notification_DELETED, Principal: "alice@example.com", Method: "DeleteApi"},
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&rpc.AuditEntry{}, "create_time", "before_hash", "after_hash"),
+	}
+	if diff := cmp.Diff(want, entries, opts); diff != "" {
+		t.Errorf("ListAuditEntries() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if len(entries) != len(want) {
+		t.FailNow()
+	}
+
+	// Hashes record the state of the API before and after each change.
+	if entries[1].GetBeforeHash() != "" || entries[1].GetAfterHash() == "" {
+		t.Errorf("Creation has hashes %q and %q, want an empty before hash and an after hash", entries[1].GetBeforeHash(), entries[1].GetAfterHash())
+	}
+	if entries[2].GetBeforeHash() != entries[1].GetAfterHash() || entries[2].GetAfterHash() == entries[2].GetBeforeHash() {
+		t.Errorf("Update has hashes %q and %q, want the hash after creation and a new hash", entries[2].GetBeforeHash(), entries[2].GetAfterHash())
+	}
+	if entries[3].GetBeforeHash() != entries[2].GetAfterHash() || entries[3].GetAfterHash() != "" {
+		t.Errorf("Deletion has hashes %q and %q, want the hash after update and an empty after hash", entries[3].GetBeforeHash(), entries[3].GetAfterHash())
+	}
+
+	// Entries are kept when their project is deleted.
+	if _, err := server.DeleteProject(context.Background(), &rpc.DeleteProjectRequest{Name: "projects/my-project", Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	if got := listAuditEntries(t, server, `project_id == "my-project"`); len(got) != 5 {
+		t.Errorf("ListAuditEntries() returned %d entries of the deleted project, want 5", len(got))
+	}
+}
+
+func TestAuditHashesOfStoredResources(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithSinks(t)
+	createProjects(t, server, "my-project")
+	api := "projects/my-project/locations/global/apis/a"
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+
+	// Change the API in storage directly, so that the change isn't recorded.
+	db, err := server.getStorageClient(ctx)
+	if err != nil {
+		t.Fatalf("getStorageClient() returned error: %s", err)
+	}
+	stored, err := db.GetApi(ctx, names.Api{ProjectID: "my-project", ApiID: "a"})
+	if err != nil {
+		t.Fatalf("GetApi() returned error: %s", err)
+	}
+	stored.DisplayName = "Changed"
+	if err := db.SaveApi(ctx, stored); err != nil {
+		t.Fatalf("SaveApi() returned error: %s", err)
+	}
+	changed, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: api})
+	if err != nil {
+		t.Fatalf("GetApi() returned error: %s", err)
+	}
+	want, err := auditHash(changed)
+	if err != nil {
+		t.Fatalf("auditHash() returned error: %s", err)
+	}
+
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: api, DisplayName: "A"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	entries := listAuditEntries(t, server, `change == "UPDATED"`)
+	if len(entries) != 1 {
+		t.Fatalf("ListAuditEntries() returned %d updates, want 1", len(entries))
+	}
+	// The hash before the update is of the API as it was stored, not as it was after its creation.
+	if got := entries[0].GetBeforeHash(); got != want {
+		t.Errorf("Update has before hash %q, want %q", got, want)
+	}
+}
+
+func TestListAuditEntriesFilter(t *testing.T) {
+	server := serverWithSinks(t)
+	createProjects(t, server, "a", "b", "c")
+	if _, err := server.DeleteProject(context.Background(), &rpc.DeleteProjectRequest{Name: "projects/b"}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []int64
+	}{
+		{filter: "", want: []int64{1, 2, 3, 4}},
+		{filter: `change == "DELETED"`, want: []int64{4}},
+		{filter: `resource == "projects/b"`, want: []int64{2, 4}},
+		{filter: `sequence > 2`, want: []int64{3, 4}},
+		{filter: `resource.startsWith("projects/") && change == "CREATED"`, want: []int64{1, 2, 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			got := []int64{}
+			for _, e := range listAuditEntries(t, server, test.filter) {
+				got = append(got, e.GetSequence())
+			}
+			if !cmp.Equal(test.want, got) {
+				t.Errorf("ListAuditEntries(%q) returned entries %v, want %v", test.filter, got, test.want)
+			}
+		})
+	}
+
+	for _, req := range []*rpc.ListAuditEntriesRequest{
+		{PageSize: -1},
+		{Filter: "unknown == 1"},
+		{PageToken: "invalid"},
+	} {
+		if _, err := server.ListAuditEntries(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ListAuditEntries(%+v) returned status code %s, want %s", req, status.Code(err), codes.InvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxBatchSize is the largest number of resources that can be handled by a batch request.
+const maxBatchSize = 1000
+
+// parseBatchParent validates the parent and size of a batch request.
+func parseBatchParent(parent string, size int) (names.Project, error) {
+	project, err := names.ParseProjectWithLocation(parent)
+	if err != nil {
+		return names.Project{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if size == 0 {
+		return names.Project{}, status.Error(codes.InvalidArgument, "batch must not be empty")
+	} else if size > maxBatchSize {
+		return names.Project{}, status.Errorf(codes.InvalidArgument, "batch size %d exceeds the maximum of %d", size, maxBatchSize)
+	}
+	return project, nil
+}
+
+// checkBatchProject returns an error if a resource in a batch request does not belong to the batch's project.
+func checkBatchProject(project names.Project, projectID string, name string) error {
+	if projectID != project.ProjectID {
+		return status.Errorf(codes.InvalidArgument, "%q is not in %q", name, project)
+	}
+	return nil
+}
+
+// BatchUpdateApis handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateApis(ctx context.Context, req *rpc.BatchUpdateApisRequest) (*rpc.BatchUpdateApisResponse, error) {
+	project, err := parseBatchParent(req.GetParent(), len(req.GetRequests()))
+	if err != nil {
+		return nil, err
+	}
+	apiNames := make([]names.Api, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if apiNames[i], err = parseUpdateApiRequest(r); err != nil {
+			return nil, err
+		}
+		if err := checkBatchProject(project, apiNames[i].ProjectID, apiNames[i].String()); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &rpc.BatchUpdateApisResponse{
+		Apis: make([]*rpc.Api, len(req.GetRequests())),
+	}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		db.LockApis(ctx, project.ProjectID)
+		for i, r := range req.GetRequests() {
+			before, err := storedResource(ctx, db, apiNames[i].String())
+			if err != nil {
+				return err
+			}
+			api, err := s.updateApi(ctx, db, apiNames[i], r)
+			if err != nil {
+				return err
+			}
+			if err := s.recordChange(ctx, db, rpc.Notification_UPDATED, api.GetName(), r.GetUpdateMask(), before, api); err != nil {
+				return err
+			}
+			response.Apis[i] = api
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// BatchGetApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchGetApiSpecs(ctx context.Context, req *rpc.BatchGetApiSpecsRequest) (*rpc.BatchGetApiSpecsResponse, error) {
+	project, err := parseBatchParent(req.GetParent(), len(req.GetNames()))
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range req.GetNames() {
+		if name, err := names.ParseSpec(n); err == nil {
+			err = checkBatchProject(project, name.ProjectID, n)
+			if err != nil {
+				return nil, err
+			}
+		} else if name, err := names.ParseSpecRevision(n); err == nil {
+			err = checkBatchProject(project, name.ProjectID, n)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resource name %q, must be an API spec or revision", n)
+		}
+	}
+
+	response := &rpc.BatchGetApiSpecsResponse{
+		ApiSpecs: make([]*rpc.ApiSpec, len(req.GetNames())),
+	}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		for i, n := range req.GetNames() {
+			var spec *models.Spec
+			var revision names.SpecRevision
+			if name, err := names.ParseSpec(n); err == nil {
+				if spec, err = db.GetSpec(ctx, name); err != nil {
+					return err
+				}
+				revision = name.Revision(spec.RevisionID)
+			} else if name, err := names.ParseSpecRevision(n); err == nil {
+				if spec, err = db.GetSpecRevision(ctx, name); err != nil {
+					return err
+				}
+				revision = name.Spec().Revision(spec.RevisionID)
+			}
+			message, err := spec.BasicMessage(n)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if req.GetIncludeContents() {
+				blob, err := db.GetSpecRevisionContents(ctx, revision)
+				if err != nil {
+					return err
+				}
+				message.Contents = blob.Contents
+			}
+			response.ApiSpecs[i] = message
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// BatchCreateArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchCreateArtifacts(ctx context.Context, req *rpc.BatchCreateArtifactsRequest) (*rpc.BatchCreateArtifactsResponse, error) {
+	project, err := parseBatchParent(req.GetParent(), len(req.GetRequests()))
+	if err != nil {
+		return nil, err
+	}
+	parents := make([]artifactParent, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if parents[i], err = parseArtifactParent(r.GetParent()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := checkBatchProject(project, parents[i].Artifact(r.GetArtifactId()).ProjectID(), r.GetParent()); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &rpc.BatchCreateArtifactsResponse{
+		Artifacts: make([]*rpc.Artifact, len(req.GetRequests())),
+	}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		for i, r := range req.GetRequests() {
+			name, err := resolveArtifactName(ctx, db, parents[i], r.GetArtifactId())
+			if err != nil {
+				return err
+			}
+			change := rpc.Notification_CREATED
+			var before proto.Message
+			var artifact *rpc.Artifact
+			if req.GetReplaceExisting() {
+				var existing *models.Artifact
+				if existing, err = db.GetArtifact(ctx, name, true); err == nil {
+					change = rpc.Notification_UPDATED
+					if before, err = existing.Message(); err != nil {
+						return status.Error(codes.Internal, err.Error())
+					}
+					artifact, err = s.replaceArtifact(ctx, db, name, r.GetArtifact())
+				} else if isNotFound(err) {
+					artifact, err = s.createArtifact(ctx, db, name, r.GetArtifact())
+				}
+			} else {
+				artifact, err = s.createArtifact(ctx, db, name, r.GetArtifact())
+			}
+			if err != nil {
+				return err
+			}
+			if err := s.recordChange(ctx, db, change, artifact.GetName(), nil, before, artifact); err != nil {
+				return err
+			}
+			response.Artifacts[i] = artifact
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const batchProject = "projects/my-project/locations/global"
+
+func batchTestServer(t *testing.T) TestServer {
+	t.Helper()
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{
+			Name:     batchProject + "/apis/a/versions/v1/specs/s1",
+			MimeType: "text/plain",
+			Contents: []byte("one"),
+		},
+		&rpc.ApiSpec{
+			Name:     batchProject + "/apis/a/versions/v1/specs/s2",
+			MimeType: "text/plain",
+			Contents: []byte("two"),
+		},
+		&rpc.Api{Name: batchProject + "/apis/b"},
+		&rpc.Project{Name: "projects/other-project"},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	return server
+}
+
+func TestBatchCreateArtifacts(t *testing.T) {
+	ctx := context.Background()
+	server := batchTestServer(t)
+
+	requests := []*rpc.CreateArtifactRequest{
+		{
+			Parent:     batchProject + "/apis/a/versions/v1/specs/s1",
+			ArtifactId: "x",
+			Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: []byte("x")},
+		},
+		{
+			Parent:     batchProject + "/apis/b",
+			ArtifactId: "y",
+			Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: []byte("y")},
+		},
+	}
+	got, err := server.BatchCreateArtifacts(ctx, &rpc.BatchCreateArtifactsRequest{
+		Parent:   batchProject,
+		Requests: requests,
+	})
+	if err != nil {
+		t.Fatalf("BatchCreateArtifacts() returned error: %s", err)
+	}
+	if len(got.GetArtifacts()) != 2 {
+		t.Fatalf("BatchCreateArtifacts() returned %d artifacts, expected 2", len(got.GetArtifacts()))
+	}
+	spec, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: requests[0].Parent})
+	if err != nil {
+		t.Fatalf("GetApiSpec() returned error: %s", err)
+	}
+	if want := fmt.Sprintf("%s@%s/artifacts/x", requests[0].Parent, spec.GetRevisionId()); got.Artifacts[0].GetName() != want {
+		t.Errorf("BatchCreateArtifacts() returned %q, expected %q", got.Artifacts[0].GetName(), want)
+	}
+	if want := batchProject + "/apis/b/artifacts/y"; got.Artifacts[1].GetName() != want {
+		t.Errorf("BatchCreateArtifacts() returned %q, expected %q", got.Artifacts[1].GetName(), want)
+	}
+
+	t.Run("existing artifacts fail the batch", func(t *testing.T) {
+		_, err := server.BatchCreateArtifacts(ctx, &rpc.BatchCreateArtifactsRequest{
+			Parent: batchProject,
+			Requests: []*rpc.CreateArtifactRequest{
+				{
+					Parent:     batchProject + "/apis/a",
+					ArtifactId: "z",
+					Artifact:   &rpc.Artifact{MimeType: "text/plain"},
+				},
+				requests[1],
+			},
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("BatchCreateArtifacts() returned status %s, expected %s: %v", status.Code(err), codes.AlreadyExists, err)
+		}
+		// The batch is all-or-nothing, so the first artifact was not created.
+		_, err = server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: batchProject + "/apis/a/artifacts/z"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("GetArtifact() returned status %s, expected %s: %v", status.Code(err), codes.NotFound, err)
+		}
+	})
+
+	t.Run("existing artifacts can be replaced", func(t *testing.T) {
+		requests[1].Artifact.Contents = []byte("updated")
+		_, err := server.BatchCreateArtifacts(ctx, &rpc.BatchCreateArtifactsRequest{
+			Parent:          batchProject,
+			Requests:        requests[1:],
+			ReplaceExisting: true,
+		})
+		if err != nil {
+			t.Fatalf("BatchCreateArtifacts() returned error: %s", err)
+		}
+		contents, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: batchProject + "/apis/b/artifacts/y"})
+		if err != nil {
+			t.Fatalf("GetArtifactContents() returned error: %s", err)
+		}
+		if !bytes.Equal(contents.GetData(), []byte("updated")) {
+			t.Errorf("GetArtifactContents() returned %q, expected %q", contents.GetData(), "updated")
+		}
+	})
+}
+
+func TestBatchGetApiSpecs(t *testing.T) {
+	ctx := context.Background()
+	server := batchTestServer(t)
+
+	spec, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: batchProject + "/apis/a/versions/v1/specs/s1"})
+	if err != nil {
+		t.Fatalf("GetApiSpec() returned error: %s", err)
+	}
+	names := []string{
+		batchProject + "/apis/a/versions/v1/specs/s2",
+		batchProject + "/apis/a/versions/v1/specs/s1@" + spec.GetRevisionId(),
+	}
+
+	got, err := server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+		Parent:          batchProject,
+		Names:           names,
+		IncludeContents: true,
+	})
+	if err != nil {
+		t.Fatalf("BatchGetApiSpecs() returned error: %s", err)
+	}
+	for i, want := range []string{"two", "one"} {
+		if got.ApiSpecs[i].GetName() != names[i] {
+			t.Errorf("BatchGetApiSpecs() returned %q, expected %q", got.ApiSpecs[i].GetName(), names[i])
+		}
+		if string(got.ApiSpecs[i].GetContents()) != want {
+			t.Errorf("BatchGetApiSpecs() returned contents %q for %q, expected %q", got.ApiSpecs[i].GetContents(), names[i], want)
+		}
+	}
+
+	got, err = server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+		Parent: batchProject,
+		Names:  names,
+	})
+	if err != nil {
+		t.Fatalf("BatchGetApiSpecs() returned error: %s", err)
+	}
+	for _, s := range got.ApiSpecs {
+		if len(s.GetContents()) > 0 {
+			t.Errorf("BatchGetApiSpecs() returned contents for %q without include_contents", s.GetName())
+		}
+	}
+}
+
+func TestBatchUpdateApis(t *testing.T) {
+	ctx := context.Background()
+	server := batchTestServer(t)
+
+	got, err := server.BatchUpdateApis(ctx, &rpc.BatchUpdateApisRequest{
+		Parent: batchProject,
+		Requests: []*rpc.UpdateApiRequest{
+			{
+				Api:        &rpc.Api{Name: batchProject + "/apis/a", DisplayName: "A"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			},
+			{
+				Api:          &rpc.Api{Name: batchProject + "/apis/c", DisplayName: "C"},
+				AllowMissing: true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchUpdateApis() returned error: %s", err)
+	}
+	for i, want := range []string{"A", "C"} {
+		if got.Apis[i].GetDisplayName() != want {
+			t.Errorf("BatchUpdateApis() returned display name %q for %q, expected %q", got.Apis[i].GetDisplayName(), got.Apis[i].GetName(), want)
+		}
+	}
+
+	// The batch is all-or-nothing, so a missing API prevents the other updates.
+	_, err = server.BatchUpdateApis(ctx, &rpc.BatchUpdateApisRequest{
+		Parent: batchProject,
+		Requests: []*rpc.UpdateApiRequest{
+			{
+				Api:        &rpc.Api{Name: batchProject + "/apis/b", DisplayName: "B"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			},
+			{
+				Api: &rpc.Api{Name: batchProject + "/apis/missing"},
+			},
+		},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("BatchUpdateApis() returned status %s, expected %s: %v", status.Code(err), codes.NotFound, err)
+	}
+	api, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: batchProject + "/apis/b"})
+	if err != nil {
+		t.Fatalf("GetApi() returned error: %s", err)
+	}
+	if api.GetDisplayName() != "" {
+		t.Errorf("GetApi() returned display name %q, expected the update to be rolled back", api.GetDisplayName())
+	}
+}
+
+func TestBatchRequestErrors(t *testing.T) {
+	ctx := context.Background()
+	server := batchTestServer(t)
+
+	tooMany := make([]string, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%s/apis/a/versions/v1/specs/s%d", batchProject, i)
+	}
+
+	tests := []struct {
+		desc string
+		call func() error
+	}{
+		{
+			desc: "invalid parent",
+			call: func() error {
+				_, err := server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+					Parent: "projects/my-project",
+					Names:  []string{batchProject + "/apis/a/versions/v1/specs/s1"},
+				})
+				return err
+			},
+		},
+		{
+			desc: "empty batch",
+			call: func() error {
+				_, err := server.BatchUpdateApis(ctx, &rpc.BatchUpdateApisRequest{Parent: batchProject})
+				return err
+			},
+		},
+		{
+			desc: "batch too large",
+			call: func() error {
+				_, err := server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+					Parent: batchProject,
+					Names:  tooMany,
+				})
+				return err
+			},
+		},
+		{
+			desc: "spec in another project",
+			call: func() error {
+				_, err := server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+					Parent: "projects/other-project/locations/global",
+					Names:  []string{batchProject + "/apis/a/versions/v1/specs/s1"},
+				})
+				return err
+			},
+		},
+		{
+			desc: "invalid spec name",
+			call: func() error {
+				_, err := server.BatchGetApiSpecs(ctx, &rpc.BatchGetApiSpecsRequest{
+					Parent: batchProject,
+					Names:  []string{batchProject + "/apis/a"},
+				})
+				return err
+			},
+		},
+		{
+			desc: "artifact in another project",
+			call: func() error {
+				_, err := server.BatchCreateArtifacts(ctx, &rpc.BatchCreateArtifactsRequest{
+					Parent: "projects/other-project/locations/global",
+					Requests: []*rpc.CreateArtifactRequest{
+						{Parent: batchProject + "/apis/a", ArtifactId: "x", Artifact: &rpc.Artifact{}},
+					},
+				})
+				return err
+			},
+		},
+		{
+			desc: "api in another project",
+			call: func() error {
+				_, err := server.BatchUpdateApis(ctx, &rpc.BatchUpdateApisRequest{
+					Parent: "projects/other-project/locations/global",
+					Requests: []*rpc.UpdateApiRequest{
+						{Api: &rpc.Api{Name: batchProject + "/apis/a"}},
+					},
+				})
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if err := test.call(); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("returned status %s, expected %s: %v", status.Code(err), codes.InvalidArgument, err)
+			}
+		})
+	}
+}
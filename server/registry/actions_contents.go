@@ -0,0 +1,191 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// contentsChunkSize is the size of the chunks of streamed contents.
+const contentsChunkSize = 1 << 20
+
+// defaultMaxContentsBytes is the largest contents that upload streams accept
+// when Config.MaxContentsBytes is unset.
+const defaultMaxContentsBytes = 256 << 20
+
+// contentsMessage is a message of an upload stream.
+type contentsMessage interface {
+	GetData() []byte
+	GetSha256() string
+}
+
+// receiveContents collects the chunks of an upload stream, starting with the first
+// message, and checks their hash. Streams with more than limit bytes are rejected.
+func receiveContents(first contentsMessage, recv func() (contentsMessage, error), limit int) ([]byte, error) {
+	var contents bytes.Buffer
+	var hash string
+	for m := first; ; {
+		if contents.Len()+len(m.GetData()) > limit {
+			return nil, status.Errorf(codes.ResourceExhausted, "contents are larger than the limit of %d bytes", limit)
+		}
+		contents.Write(m.GetData())
+		if h := m.GetSha256(); h != "" {
+			hash = h
+		}
+		var err error
+		if m, err = recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "sha256 of the contents is required in the last message")
+	}
+	if sum := fmt.Sprintf("%x", sha256.Sum256(contents.Bytes())); sum != strings.ToLower(hash) {
+		return nil, status.Errorf(codes.DataLoss, "received contents have sha256 %s, expected %s", sum, hash)
+	}
+	return contents.Bytes(), nil
+}
+
+// sendContents sends contents in chunks. The first chunk has the content type
+// and the last one has the hash of the contents.
+func sendContents(send func(*rpc.ContentsChunk) error, body *httpbody.HttpBody) error {
+	data := body.GetData()
+	for offset := 0; ; offset += contentsChunkSize {
+		end := offset + contentsChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := &rpc.ContentsChunk{Data: data[offset:end]}
+		if offset == 0 {
+			chunk.ContentType = body.GetContentType()
+		}
+		if end == len(data) {
+			chunk.Sha256 = fmt.Sprintf("%x", sha256.Sum256(data))
+		}
+		if err := send(chunk); err != nil {
+			return err
+		}
+		if end == len(data) {
+			return nil
+		}
+	}
+}
+
+// UploadApiSpecContents handles the corresponding API request.
+func (s *RegistryServer) UploadApiSpecContents(stream rpc.Registry_UploadApiSpecContentsServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "api_spec is required in the first message")
+	} else if err != nil {
+		return err
+	}
+	if first.GetApiSpec() == nil {
+		return status.Error(codes.InvalidArgument, "api_spec is required in the first message")
+	}
+	if len(first.GetApiSpec().GetContents()) > 0 {
+		return status.Error(codes.InvalidArgument, "api_spec.contents must be empty, contents are sent in chunks")
+	}
+	contents, err := receiveContents(first, func() (contentsMessage, error) {
+		return stream.Recv()
+	}, s.maxContents)
+	if err != nil {
+		return err
+	}
+
+	spec := proto.Clone(first.GetApiSpec()).(*rpc.ApiSpec)
+	spec.Contents = contents
+	response, err := s.UpdateApiSpec(stream.Context(), &rpc.UpdateApiSpecRequest{
+		ApiSpec:      spec,
+		AllowMissing: true,
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// StreamApiSpecContents handles the corresponding API request.
+func (s *RegistryServer) StreamApiSpecContents(req *rpc.GetApiSpecContentsRequest, stream rpc.Registry_StreamApiSpecContentsServer) error {
+	body, err := s.GetApiSpecContents(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return sendContents(stream.Send, body)
+}
+
+// UploadArtifactContents handles the corresponding API request.
+func (s *RegistryServer) UploadArtifactContents(stream rpc.Registry_UploadArtifactContentsServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "artifact is required in the first message")
+	} else if err != nil {
+		return err
+	}
+	if first.GetArtifact() == nil {
+		return status.Error(codes.InvalidArgument, "artifact is required in the first message")
+	}
+	if len(first.GetArtifact().GetContents()) > 0 {
+		return status.Error(codes.InvalidArgument, "artifact.contents must be empty, contents are sent in chunks")
+	}
+	name, err := names.ParseArtifact(first.GetArtifact().GetName())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	contents, err := receiveContents(first, func() (contentsMessage, error) {
+		return stream.Recv()
+	}, s.maxContents)
+	if err != nil {
+		return err
+	}
+
+	artifact := proto.Clone(first.GetArtifact()).(*rpc.Artifact)
+	artifact.Contents = contents
+	response, err := s.CreateArtifact(stream.Context(), &rpc.CreateArtifactRequest{
+		Parent:     name.Parent(),
+		ArtifactId: name.ArtifactID(),
+		Artifact:   artifact,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		response, err = s.ReplaceArtifact(stream.Context(), &rpc.ReplaceArtifactRequest{
+			Artifact: artifact,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// StreamArtifactContents handles the corresponding API request.
+func (s *RegistryServer) StreamArtifactContents(req *rpc.GetArtifactContentsRequest, stream rpc.Registry_StreamArtifactContentsServer) error {
+	body, err := s.GetArtifactContents(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return sendContents(stream.Send, body)
+}
@@ -0,0 +1,344 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadSpecStream is a fake Registry_UploadApiSpecContentsServer.
+type uploadSpecStream struct {
+	grpc.ServerStream
+	requests []*rpc.UploadApiSpecContentsRequest
+	response *rpc.ApiSpec
+}
+
+func (s *uploadSpecStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadSpecStream) Recv() (*rpc.UploadApiSpecContentsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadSpecStream) SendAndClose(spec *rpc.ApiSpec) error {
+	s.response = spec
+	return nil
+}
+
+// uploadArtifactStream is a fake Registry_UploadArtifactContentsServer.
+type uploadArtifactStream struct {
+	grpc.ServerStream
+	requests []*rpc.UploadArtifactContentsRequest
+	response *rpc.Artifact
+}
+
+func (s *uploadArtifactStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadArtifactStream) Recv() (*rpc.UploadArtifactContentsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadArtifactStream) SendAndClose(artifact *rpc.Artifact) error {
+	s.response = artifact
+	return nil
+}
+
+// chunkStream is a fake server-streaming server that records the chunks it is sent.
+type chunkStream struct {
+	grpc.ServerStream
+	chunks []*rpc.ContentsChunk
+}
+
+func (s *chunkStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *chunkStream) Send(chunk *rpc.ContentsChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// largeContents returns contents that take more than two chunks to stream.
+func largeContents() []byte {
+	return bytes.Repeat([]byte("0123456789abcdef"), (contentsChunkSize*5/2)/16)
+}
+
+func sha256Hex(b []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// checkChunks verifies that streamed chunks reassemble to the expected contents.
+func checkChunks(t *testing.T, chunks []*rpc.ContentsChunk, contentType string, contents []byte) {
+	t.Helper()
+	if want := (len(contents) + contentsChunkSize - 1) / contentsChunkSize; len(chunks) != want {
+		t.Fatalf("Stream sent %d chunks, want %d", len(chunks), want)
+	}
+	if chunks[0].GetContentType() != contentType {
+		t.Errorf("First chunk has content type %q, want %q", chunks[0].GetContentType(), contentType)
+	}
+	var got []byte
+	for i, c := range chunks {
+		if len(c.GetData()) > contentsChunkSize {
+			t.Errorf("Chunk %d has %d bytes, want at most %d", i, len(c.GetData()), contentsChunkSize)
+		}
+		if i < len(chunks)-1 && c.GetSha256() != "" {
+			t.Errorf("Chunk %d has a hash, want it only in the last chunk", i)
+		}
+		got = append(got, c.GetData()...)
+	}
+	if !bytes.Equal(got, contents) {
+		t.Errorf("Streamed contents differ from the uploaded contents")
+	}
+	if hash := chunks[len(chunks)-1].GetSha256(); hash != sha256Hex(contents) {
+		t.Errorf("Last chunk has sha256 %q, want %q", hash, sha256Hex(contents))
+	}
+}
+
+func TestUploadAndStreamApiSpecContents(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	version := "projects/my-project/locations/global/apis/a/versions/v"
+	if err := seeder.SeedRegistry(ctx, server, &rpc.ApiVersion{Name: version}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	contents := largeContents()
+	spec := &rpc.ApiSpec{Name: version + "/specs/s", MimeType: "application/x.openapi"}
+	upload := &uploadSpecStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: spec, Data: contents[:contentsChunkSize]},
+		{Data: contents[contentsChunkSize : 2*contentsChunkSize]},
+		{Data: contents[2*contentsChunkSize:], Sha256: sha256Hex(contents)},
+	}}
+	if err := server.UploadApiSpecContents(upload); err != nil {
+		t.Fatalf("UploadApiSpecContents() returned error: %s", err)
+	}
+	if upload.response.GetName() != spec.Name || upload.response.GetSizeBytes() != int32(len(contents)) || upload.response.GetHash() != sha256Hex(contents) {
+		t.Errorf("UploadApiSpecContents() returned %s (%d bytes, hash %s), want %s (%d bytes, hash %s)",
+			upload.response.GetName(), upload.response.GetSizeBytes(), upload.response.GetHash(), spec.Name, len(contents), sha256Hex(contents))
+	}
+
+	body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.Name})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	}
+	if !bytes.Equal(body.GetData(), contents) {
+		t.Errorf("GetApiSpecContents() returned contents that differ from the uploaded contents")
+	}
+
+	stream := &chunkStream{}
+	if err := server.StreamApiSpecContents(&rpc.GetApiSpecContentsRequest{Name: spec.Name}, stream); err != nil {
+		t.Fatalf("StreamApiSpecContents() returned error: %s", err)
+	}
+	checkChunks(t, stream.chunks, spec.MimeType, contents)
+
+	// Uploading again replaces the contents with a new revision.
+	upload = &uploadSpecStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: spec, Data: []byte("small"), Sha256: sha256Hex([]byte("small"))},
+	}}
+	if err := server.UploadApiSpecContents(upload); err != nil {
+		t.Fatalf("UploadApiSpecContents() returned error: %s", err)
+	}
+	stream = &chunkStream{}
+	if err := server.StreamApiSpecContents(&rpc.GetApiSpecContentsRequest{Name: spec.Name}, stream); err != nil {
+		t.Fatalf("StreamApiSpecContents() returned error: %s", err)
+	}
+	checkChunks(t, stream.chunks, spec.MimeType, []byte("small"))
+}
+
+func TestUploadApiSpecContentsErrors(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	version := "projects/my-project/locations/global/apis/a/versions/v"
+	if err := seeder.SeedRegistry(ctx, server, &rpc.ApiVersion{Name: version}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	spec := &rpc.ApiSpec{Name: version + "/specs/s"}
+	contents := []byte("contents")
+
+	tests := []struct {
+		desc     string
+		requests []*rpc.UploadApiSpecContentsRequest
+		want     codes.Code
+	}{
+		{
+			desc: "empty stream",
+			want: codes.InvalidArgument,
+		},
+		{
+			desc:     "missing spec",
+			requests: []*rpc.UploadApiSpecContentsRequest{{Data: contents, Sha256: sha256Hex(contents)}},
+			want:     codes.InvalidArgument,
+		},
+		{
+			desc: "contents in spec",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: &rpc.ApiSpec{Name: spec.Name, Contents: contents}, Sha256: sha256Hex(contents)},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc:     "missing hash",
+			requests: []*rpc.UploadApiSpecContentsRequest{{ApiSpec: spec, Data: contents}},
+			want:     codes.InvalidArgument,
+		},
+		{
+			desc: "wrong hash",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: spec, Data: contents},
+				{Data: contents, Sha256: sha256Hex(contents)},
+			},
+			want: codes.DataLoss,
+		},
+		{
+			desc: "missing parent",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: &rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/a/versions/missing/specs/s"}, Data: contents, Sha256: sha256Hex(contents)},
+			},
+			want: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := server.UploadApiSpecContents(&uploadSpecStream{requests: test.requests})
+			if status.Code(err) != test.want {
+				t.Errorf("UploadApiSpecContents() returned status code %q, want %q: %v", status.Code(err), test.want, err)
+			}
+		})
+	}
+
+	if _, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec.Name}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApiSpec() returned status code %q after failed uploads, want %q", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestUploadContentsLimit(t *testing.T) {
+	ctx := context.Background()
+	server, err := New(Config{
+		Database:         "sqlite3",
+		DBConfig:         fmt.Sprintf("%s/registry.db", t.TempDir()),
+		MaxContentsBytes: 8,
+	})
+	if err != nil {
+		t.Fatalf("Setup: Failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+	version := "projects/my-project/locations/global/apis/a/versions/v"
+	if err := seeder.SeedRegistry(ctx, server, &rpc.ApiVersion{Name: version}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	spec := &rpc.ApiSpec{Name: version + "/specs/s"}
+	contents := []byte("contents")
+
+	err = server.UploadApiSpecContents(&uploadSpecStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: spec, Data: contents, Sha256: sha256Hex(contents)},
+	}})
+	if err != nil {
+		t.Fatalf("UploadApiSpecContents() returned error for contents at the limit: %s", err)
+	}
+	err = server.UploadApiSpecContents(&uploadSpecStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: spec, Data: contents},
+		{Data: []byte("!"), Sha256: sha256Hex(append(contents, '!'))},
+	}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("UploadApiSpecContents() returned status code %q, want %q: %v", status.Code(err), codes.ResourceExhausted, err)
+	}
+	err = server.UploadArtifactContents(&uploadArtifactStream{requests: []*rpc.UploadArtifactContentsRequest{
+		{Artifact: &rpc.Artifact{Name: version + "/artifacts/x"}, Data: append(contents, '!'), Sha256: sha256Hex(append(contents, '!'))},
+	}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("UploadArtifactContents() returned status code %q, want %q: %v", status.Code(err), codes.ResourceExhausted, err)
+	}
+}
+
+func TestUploadAndStreamArtifactContents(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	api := "projects/my-project/locations/global/apis/a"
+	if err := seeder.SeedRegistry(ctx, server, &rpc.Api{Name: api}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	artifact := &rpc.Artifact{Name: api + "/artifacts/x", MimeType: "application/octet-stream"}
+	for _, contents := range [][]byte{largeContents(), []byte("replaced")} {
+		var requests []*rpc.UploadArtifactContentsRequest
+		for offset := 0; offset < len(contents); offset += contentsChunkSize {
+			end := offset + contentsChunkSize
+			if end > len(contents) {
+				end = len(contents)
+			}
+			requests = append(requests, &rpc.UploadArtifactContentsRequest{Data: contents[offset:end]})
+		}
+		requests[0].Artifact = artifact
+		requests[len(requests)-1].Sha256 = sha256Hex(contents)
+
+		upload := &uploadArtifactStream{requests: requests}
+		if err := server.UploadArtifactContents(upload); err != nil {
+			t.Fatalf("UploadArtifactContents() returned error: %s", err)
+		}
+		if upload.response.GetSizeBytes() != int32(len(contents)) || upload.response.GetHash() != sha256Hex(contents) {
+			t.Errorf("UploadArtifactContents() returned %d bytes with hash %s, want %d bytes with hash %s",
+				upload.response.GetSizeBytes(), upload.response.GetHash(), len(contents), sha256Hex(contents))
+		}
+
+		stream := &chunkStream{}
+		if err := server.StreamArtifactContents(&rpc.GetArtifactContentsRequest{Name: artifact.Name}, stream); err != nil {
+			t.Fatalf("StreamArtifactContents() returned error: %s", err)
+		}
+		checkChunks(t, stream.chunks, artifact.MimeType, contents)
+	}
+
+	err := server.UploadArtifactContents(&uploadArtifactStream{requests: []*rpc.UploadArtifactContentsRequest{
+		{Artifact: &rpc.Artifact{Name: "invalid"}, Sha256: sha256Hex(nil)},
+	}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UploadArtifactContents() with invalid name returned status code %q, want %q", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestStreamContentsMissing(t *testing.T) {
+	server := defaultTestServer(t)
+	name := "projects/my-project/locations/global/apis/a/versions/v/specs/s"
+	if err := server.StreamApiSpecContents(&rpc.GetApiSpecContentsRequest{Name: name}, &chunkStream{}); status.Code(err) != codes.NotFound {
+		t.Errorf("StreamApiSpecContents() returned status code %q, want %q", status.Code(err), codes.NotFound)
+	}
+	name = "projects/my-project/locations/global/apis/a/artifacts/x"
+	if err := server.StreamArtifactContents(&rpc.GetArtifactContentsRequest{Name: name}, &chunkStream{}); status.Code(err) != codes.NotFound {
+		t.Errorf("StreamArtifactContents() returned status code %q, want %q", status.Code(err), codes.NotFound)
+	}
+}
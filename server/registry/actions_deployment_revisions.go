@@ -79,10 +79,17 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 	}
 	var response *rpc.ApiDeployment
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkDeploymentRevisionEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
 		if err := db.DeleteDeploymentRevision(ctx, name); err != nil {
 			return err
 		}
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, name.String(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -91,7 +98,6 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return response, nil
 }
 
@@ -115,7 +121,6 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	var response *rpc.ApiDeployment
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// The revision to be tagged must exist.
 		revision, err := db.GetDeploymentRevision(ctx, name)
@@ -128,6 +133,10 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 		if err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
+		before, err := revision.BasicMessage(name.String())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 		tag := models.NewDeploymentRevisionTag(name, req.GetTag())
 		if err := db.SaveDeploymentRevisionTag(ctx, tag); err != nil {
 			return err
@@ -136,12 +145,10 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 		if err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
-		revisionName = name.String()
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, name.String(), nil, before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, revisionName)
 	return response, nil
 }
 
@@ -157,7 +164,6 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	var response *rpc.ApiDeployment
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// Get the target deployment revision to use as a base for the new rollback revision.
 		name := parent.Revision(req.GetRevisionId())
@@ -174,11 +180,9 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 		if err != nil {
 			return err
 		}
-		revisionName = rollback.RevisionName()
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, rollback.RevisionName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, revisionName)
 	return response, nil
 }
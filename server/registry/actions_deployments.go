@@ -42,11 +42,13 @@ func (s *RegistryServer) CreateApiDeployment(ctx context.Context, req *rpc.Creat
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createDeployment(ctx, db, name, req.GetApiDeployment())
-		return err
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -79,11 +81,21 @@ func (s *RegistryServer) DeleteApiDeployment(ctx context.Context, req *rpc.Delet
 	}
 
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockDeployments(ctx).DeleteDeployment(ctx, name, req.GetForce())
+		db.LockDeployments(ctx, name.ProjectID)
+		if err := checkDeploymentEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
+		if err := db.DeleteDeployment(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, req.GetName(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
@@ -198,6 +210,15 @@ func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.Updat
 	}
 	var response *rpc.ApiDeployment
 	if err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		// Lock the deployments of the project so that the deployment can't change between the etag check and the update.
+		db.LockDeployments(ctx, name.ProjectID)
+		if err := checkDeploymentEtag(ctx, db, name, req.ApiDeployment.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
 		deployment, err := db.GetDeployment(ctx, name)
 		if err == nil {
 			// Apply the update to the deployment - possibly changing the revision ID.
@@ -210,19 +231,18 @@ func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.Updat
 				return err
 			}
 			response, err = deployment.BasicMessage(name.String())
-			return err
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createDeployment(ctx, db, name, req.GetApiDeployment())
 			if status.Code(err) == codes.AlreadyExists {
 				err = status.Error(codes.Aborted, err.Error())
 			}
-			return err
-		} else {
+		}
+		if err != nil {
 			return err
 		}
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, response.GetName(), req.GetUpdateMask(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
@@ -16,6 +16,7 @@ package registry
 
 import (
 	"context"
+	"fmt"
 
 	longrunning "cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"github.com/apigee/registry/rpc"
@@ -26,7 +27,7 @@ import (
 
 // MigrateDatabase handles the corresponding API request.
 func (s *RegistryServer) MigrateDatabase(ctx context.Context, req *rpc.MigrateDatabaseRequest) (*longrunning.Operation, error) {
-	if req.Kind != "" && req.Kind != "auto" {
+	if req.Kind != "" && req.Kind != "auto" && req.Kind != "blobs" {
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported migration kind %q", req.Kind)
 	}
 	db, err := s.getStorageClient(ctx)
@@ -34,8 +35,14 @@ func (s *RegistryServer) MigrateDatabase(ctx context.Context, req *rpc.MigrateDa
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
 
-	err = db.Migrate(ctx)
-	if err != nil {
+	message := "OK"
+	if req.Kind == "blobs" {
+		moved, err := db.MoveBlobContents(ctx)
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf("Moved %d blob contents to the blob store", moved)
+	} else if err := db.Migrate(ctx); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -44,7 +51,7 @@ func (s *RegistryServer) MigrateDatabase(ctx context.Context, req *rpc.MigrateDa
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	response, err := anypb.New(&rpc.MigrateDatabaseResponse{
-		Message: "OK",
+		Message: message,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -88,6 +88,11 @@ func TestMigrateDatabaseKinds(t *testing.T) {
 			req:  &rpc.MigrateDatabaseRequest{Kind: "auto"},
 			want: codes.OK,
 		},
+		{
+			desc: "migrate blobs without a blob store",
+			req:  &rpc.MigrateDatabaseRequest{Kind: "blobs"},
+			want: codes.FailedPrecondition,
+		},
 		{
 			desc: "migrate with kind unsupported",
 			req:  &rpc.MigrateDatabaseRequest{Kind: "unsupported"},
@@ -23,6 +23,7 @@ import (
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -37,11 +38,13 @@ func (s *RegistryServer) CreateProject(ctx context.Context, req *rpc.CreateProje
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createProject(ctx, db, name, req.GetProject())
-		return err
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -63,11 +66,17 @@ func (s *RegistryServer) DeleteProject(ctx context.Context, req *rpc.DeleteProje
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockProjects(ctx).DeleteProject(ctx, name, req.GetForce())
+		before, err := storedResource(ctx, db.LockProjects(ctx, name.ProjectID), name.String())
+		if err != nil {
+			return err
+		}
+		if err := db.DeleteProject(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, req.GetName(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
@@ -145,24 +154,25 @@ func (s *RegistryServer) UpdateProject(ctx context.Context, req *rpc.UpdateProje
 	}
 	var response *rpc.Project
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		db.LockProjects(ctx)
+		db.LockProjects(ctx, name.ProjectID)
+		var before proto.Message
 		project, err := db.GetProject(ctx, name)
 		if err == nil {
+			before = project.Message()
 			project.Update(req.GetProject(), models.ExpandMask(req.GetProject(), req.GetUpdateMask()))
 			if err := db.SaveProject(ctx, project); err != nil {
 				return err
 			}
 			response = project.Message()
-			return nil
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createProject(ctx, db, name, req.GetProject())
-			return err
-		} else {
+		}
+		if err != nil {
 			return err
 		}
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, response.GetName(), req.GetUpdateMask(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
@@ -0,0 +1,339 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/encoding"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// RetentionPolicyID is the id of the project and API artifacts that hold
+// retention policies.
+const RetentionPolicyID = "retention-policy"
+
+// PruneApiRevisions handles the corresponding API request.
+func (s *RegistryServer) PruneApiRevisions(ctx context.Context, req *rpc.PruneApiRevisionsRequest) (*rpc.PruneApiRevisionsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseApi(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if name.ProjectID == "-" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name %q: project ID must be specified", req.GetName())
+	}
+	if name.ApiID == "-" {
+		if _, err := db.GetProject(ctx, name.Project()); err != nil {
+			return nil, err
+		}
+	} else if _, err := db.GetApi(ctx, name); err != nil {
+		return nil, err
+	}
+
+	pruned, err := s.pruneApiRevisions(ctx, name, time.Now(), req.GetValidateOnly())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.PruneApiRevisionsResponse{RevisionNames: pruned}, nil
+}
+
+// pruneApiRevisions deletes the revisions of an API, which may be "-" to
+// include all APIs in its project, that its retention policy doesn't keep.
+// It returns the sorted names of the revisions, which are only listed if
+// validateOnly is set.
+func (s *RegistryServer) pruneApiRevisions(ctx context.Context, api names.Api, now time.Time, validateOnly bool) ([]string, error) {
+	specNames, deploymentNames, err := s.prunableRevisions(ctx, api, now)
+	if err != nil {
+		return nil, err
+	}
+	if validateOnly {
+		pruned := append(append([]string{}, specNames...), deploymentNames...)
+		sort.Strings(pruned)
+		return pruned, nil
+	}
+	return s.deleteRevisions(ctx, specNames, deploymentNames)
+}
+
+// prunableRevisions returns the names of the spec and deployment revisions
+// of an API, which may be "-" to include all APIs in its project, that its
+// retention policy doesn't keep.
+func (s *RegistryServer) prunableRevisions(ctx context.Context, api names.Api, now time.Time) ([]string, []string, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unavailable, err.Error())
+	}
+	specs, err := db.ListSpecRevisionHistory(ctx, api)
+	if err != nil {
+		return nil, nil, err
+	}
+	deployments, err := db.ListDeploymentRevisionHistory(ctx, api)
+	if err != nil {
+		return nil, nil, err
+	}
+	refs, err := db.ListReferencedSpecRevisions(ctx, api.Project())
+	if err != nil {
+		return nil, nil, err
+	}
+	referenced := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		referenced[ref.String()] = true
+	}
+
+	policies := &policyCache{s: s, project: api.Project(), apis: map[string]*controller.RetentionPolicy{}}
+	specNames, err := policies.prunable(ctx, specs, referenced, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	deploymentNames, err := policies.prunable(ctx, deployments, nil, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return specNames, deploymentNames, nil
+}
+
+// deleteRevisions deletes spec and deployment revisions, each in its own
+// transaction, and returns the sorted names of the deleted revisions.
+// Revisions that were deleted, tagged or referenced after they were listed
+// are skipped.
+func (s *RegistryServer) deleteRevisions(ctx context.Context, specNames, deploymentNames []string) ([]string, error) {
+	retained := map[string]bool{}
+	for _, n := range specNames {
+		name, err := names.ParseSpecRevision(n)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			// Revisions that were tagged or referenced after they were listed are kept.
+			keep, err := db.LockSpecs(ctx, name.ProjectID).SpecRevisionRetained(ctx, name)
+			if err != nil {
+				return err
+			} else if keep {
+				retained[n] = true
+				return nil
+			}
+			before, err := storedResource(ctx, db, name.String())
+			if err != nil {
+				return err
+			}
+			if err := db.DeleteSpecRevision(ctx, name); err != nil {
+				return err
+			}
+			return s.recordChange(ctx, db, rpc.Notification_DELETED, name.String(), nil, before, nil)
+		})
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+	}
+	for _, n := range deploymentNames {
+		name, err := names.ParseDeploymentRevision(n)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			// Revisions that were tagged after they were listed are kept.
+			keep, err := db.LockDeployments(ctx, name.ProjectID).DeploymentRevisionTagged(ctx, name)
+			if err != nil {
+				return err
+			} else if keep {
+				retained[n] = true
+				return nil
+			}
+			before, err := storedResource(ctx, db, name.String())
+			if err != nil {
+				return err
+			}
+			if err := db.DeleteDeploymentRevision(ctx, name); err != nil {
+				return err
+			}
+			return s.recordChange(ctx, db, rpc.Notification_DELETED, name.String(), nil, before, nil)
+		})
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	var deleted []string
+	for _, n := range append(append([]string{}, specNames...), deploymentNames...) {
+		if !retained[n] {
+			deleted = append(deleted, n)
+		}
+	}
+	sort.Strings(deleted)
+	return deleted, nil
+}
+
+// policyCache finds and caches the retention policies of the APIs of a project.
+type policyCache struct {
+	s       *RegistryServer
+	project names.Project
+	// apis holds the policies of APIs by API ID, which are nil for APIs
+	// that have neither their own policies nor project policies.
+	apis map[string]*controller.RetentionPolicy
+	// projectPolicy is the policy of the project, once it has been read.
+	projectPolicy *controller.RetentionPolicy
+	projectRead   bool
+}
+
+// prunable returns the names of revisions that the policies of their APIs
+// don't keep. Revisions that are named in referenced are kept.
+func (c *policyCache) prunable(ctx context.Context, revisions []storage.Revision, referenced map[string]bool, now time.Time) ([]string, error) {
+	parents := map[string][]storage.Revision{}
+	for _, r := range revisions {
+		parents[r.Parent] = append(parents[r.Parent], r)
+	}
+	var pruned []string
+	for _, group := range parents {
+		policy, err := c.policy(ctx, group[0].ApiID)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			pruned = append(pruned, prunable(group, policy, referenced, now)...)
+		}
+	}
+	return pruned, nil
+}
+
+// policy returns the retention policy of an API, which is the policy of its
+// project if the API has none, or nil if neither has one.
+func (c *policyCache) policy(ctx context.Context, apiID string) (*controller.RetentionPolicy, error) {
+	if policy, ok := c.apis[apiID]; ok {
+		return policy, nil
+	}
+	policy, err := c.s.getRetentionPolicy(ctx, c.project.Api(apiID).Artifact(RetentionPolicyID))
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		if !c.projectRead {
+			c.projectPolicy, err = c.s.getRetentionPolicy(ctx, c.project.Artifact(RetentionPolicyID))
+			if err != nil {
+				return nil, err
+			}
+			c.projectRead = true
+		}
+		policy = c.projectPolicy
+	}
+	c.apis[apiID] = policy
+	return policy, nil
+}
+
+// getRetentionPolicy returns the retention policy stored in an artifact,
+// or nil if the artifact doesn't exist.
+func (s *RegistryServer) getRetentionPolicy(ctx context.Context, name names.Artifact) (*controller.RetentionPolicy, error) {
+	contents, err := s.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name.String()})
+	if isNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	policy := new(controller.RetentionPolicy)
+	if err := unmarshalRetentionPolicy(contents.GetData(), contents.GetContentType(), policy); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid retention policy %s: %s", name, err)
+	}
+	if err := validateRetentionPolicy(policy); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid retention policy %s: %s", name, err)
+	}
+	return policy, nil
+}
+
+// unmarshalRetentionPolicy reads a policy that is stored as a protocol buffer
+// or as YAML, as when it is applied with "registry apply".
+func unmarshalRetentionPolicy(contents []byte, mimeType string, policy *controller.RetentionPolicy) error {
+	messageType, err := mime.MessageTypeForMimeType(mimeType)
+	if err != nil {
+		return err
+	}
+	if want := string(policy.ProtoReflect().Descriptor().FullName()); messageType != want {
+		return errors.New("contents must have type " + want)
+	}
+	if !mime.IsYamlKind(mimeType) {
+		return proto.Unmarshal(contents, policy)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(contents, &node); err != nil {
+		return err
+	}
+	encoding.StyleForJSON(&node)
+	bytes, err := yaml.Marshal(&node)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(bytes, policy)
+}
+
+// validateRetentionPolicy returns an error if a policy has negative limits.
+func validateRetentionPolicy(policy *controller.RetentionPolicy) error {
+	if policy.GetKeepCount() < 0 {
+		return errors.New("keep_count must not be negative")
+	}
+	if d := policy.GetKeepDuration(); d != nil {
+		if err := d.CheckValid(); err != nil {
+			return err
+		}
+		if d.AsDuration() < 0 {
+			return errors.New("keep_duration must not be negative")
+		}
+	}
+	return nil
+}
+
+// prunable returns the names of the revisions of a spec or deployment that
+// a policy doesn't keep. The most recent revision, tagged revisions and
+// revisions that are named in referenced are always kept. Other revisions are
+// kept if they are among the most recent keep_count revisions or are younger
+// than keep_duration. Policies without limits keep all revisions.
+func prunable(revisions []storage.Revision, policy *controller.RetentionPolicy, referenced map[string]bool, now time.Time) []string {
+	keepCount := int(policy.GetKeepCount())
+	keepDuration := policy.GetKeepDuration()
+	if keepCount == 0 && keepDuration == nil {
+		return nil
+	}
+	sorted := make([]storage.Revision, len(revisions))
+	copy(sorted, revisions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created.After(sorted[j].Created)
+	})
+	var pruned []string
+	for i, r := range sorted {
+		switch {
+		case i == 0, r.Tagged, referenced[r.Name]:
+			continue
+		case keepCount > 0 && i < keepCount:
+			continue
+		case keepDuration != nil && now.Sub(r.Created) < keepDuration.AsDuration():
+			continue
+		}
+		pruned = append(pruned, r.Name)
+	}
+	return pruned
+}
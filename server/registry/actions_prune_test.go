@@ -0,0 +1,534 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	pruneProject    = "projects/my-project/locations/global"
+	pruneApi        = pruneProject + "/apis/a"
+	pruneSpec       = pruneApi + "/versions/v1/specs/s"
+	pruneDeployment = pruneApi + "/deployments/d"
+)
+
+// pruneTestRevisions names the revisions created by pruneTestServer.
+type pruneTestRevisions struct {
+	// specs are the spec revisions from the oldest to the newest. The third
+	// is tagged and the fourth is referenced by the deployment.
+	specs []string
+	// deployments are the deployment revisions from the oldest to the newest.
+	deployments []string
+}
+
+// pruneTestServer returns a server that holds a spec with six revisions
+// and a deployment with three revisions.
+func pruneTestServer(t *testing.T) (*RegistryServer, pruneTestRevisions) {
+	t.Helper()
+	ctx := context.Background()
+	server := serverWithSinks(t)
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: pruneSpec, Contents: []byte("seed")},
+		&rpc.ApiDeployment{Name: pruneDeployment},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	spec, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: pruneSpec})
+	if err != nil {
+		t.Fatalf("Setup: GetApiSpec() returned error: %s", err)
+	}
+	deployment, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: pruneDeployment})
+	if err != nil {
+		t.Fatalf("Setup: GetApiDeployment() returned error: %s", err)
+	}
+	revisions := pruneTestRevisions{
+		specs:       []string{spec.GetName() + "@" + spec.GetRevisionId()},
+		deployments: []string{deployment.GetName() + "@" + deployment.GetRevisionId()},
+	}
+	for i := 1; i < 6; i++ {
+		spec, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec: &rpc.ApiSpec{Name: pruneSpec, Contents: []byte(fmt.Sprint(i))},
+		})
+		if err != nil {
+			t.Fatalf("Setup: UpdateApiSpec() returned error: %s", err)
+		}
+		revisions.specs = append(revisions.specs, spec.GetName()+"@"+spec.GetRevisionId())
+	}
+	if _, err := server.TagApiSpecRevision(ctx, &rpc.TagApiSpecRevisionRequest{
+		Name: revisions.specs[2],
+		Tag:  "keep",
+	}); err != nil {
+		t.Fatalf("Setup: TagApiSpecRevision() returned error: %s", err)
+	}
+
+	for _, uri := range []string{"https://a.example.com", "https://b.example.com"} {
+		deployment, err := server.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+			ApiDeployment: &rpc.ApiDeployment{
+				Name:            pruneDeployment,
+				EndpointUri:     uri,
+				ApiSpecRevision: revisions.specs[3],
+			},
+		})
+		if err != nil {
+			t.Fatalf("Setup: UpdateApiDeployment() returned error: %s", err)
+		}
+		revisions.deployments = append(revisions.deployments, deployment.GetName()+"@"+deployment.GetRevisionId())
+	}
+	return server, revisions
+}
+
+// setRetentionPolicy stores a policy in an artifact of a project or API.
+func setRetentionPolicy(t *testing.T, server *RegistryServer, parent string, policy *controller.RetentionPolicy) {
+	t.Helper()
+	contents, err := proto.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	createRetentionPolicy(t, server, parent, mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.controller.RetentionPolicy"), contents)
+}
+
+func createRetentionPolicy(t *testing.T, server *RegistryServer, parent, mimeType string, contents []byte) {
+	t.Helper()
+	if _, err := server.CreateArtifact(context.Background(), &rpc.CreateArtifactRequest{
+		Parent:     parent,
+		ArtifactId: RetentionPolicyID,
+		Artifact:   &rpc.Artifact{MimeType: mimeType, Contents: contents},
+	}); err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+}
+
+func listRevisionNames(t *testing.T, server *RegistryServer) []string {
+	t.Helper()
+	ctx := context.Background()
+	var names []string
+	specs, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: pruneSpec + "@-"})
+	if err != nil {
+		t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+	}
+	for _, s := range specs.GetApiSpecs() {
+		names = append(names, s.GetName())
+	}
+	deployments, err := server.ListApiDeploymentRevisions(ctx, &rpc.ListApiDeploymentRevisionsRequest{Name: pruneDeployment + "@-"})
+	if err != nil {
+		t.Fatalf("ListApiDeploymentRevisions() returned error: %s", err)
+	}
+	for _, d := range deployments.GetApiDeployments() {
+		names = append(names, d.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPruneApiRevisions(t *testing.T) {
+	tests := []struct {
+		desc       string
+		name       string
+		project    *controller.RetentionPolicy
+		api        *controller.RetentionPolicy
+		wantSpecs  []int
+		wantDeploy []int
+	}{
+		{
+			desc: "no policy",
+			name: pruneApi,
+		},
+		{
+			desc: "policy without limits",
+			name: pruneApi,
+			project: &controller.RetentionPolicy{
+				KeepCount: 0,
+			},
+		},
+		{
+			desc: "project policy",
+			name: pruneApi,
+			project: &controller.RetentionPolicy{
+				KeepCount: 1,
+			},
+			wantSpecs:  []int{0, 1, 4},
+			wantDeploy: []int{0, 1},
+		},
+		{
+			desc: "project policy for all apis",
+			name: pruneProject + "/apis/-",
+			project: &controller.RetentionPolicy{
+				KeepCount: 1,
+			},
+			wantSpecs:  []int{0, 1, 4},
+			wantDeploy: []int{0, 1},
+		},
+		{
+			desc: "keep count",
+			name: pruneApi,
+			project: &controller.RetentionPolicy{
+				KeepCount: 2,
+			},
+			wantSpecs:  []int{0, 1},
+			wantDeploy: []int{0},
+		},
+		{
+			desc: "keep duration",
+			name: pruneApi,
+			project: &controller.RetentionPolicy{
+				KeepDuration: durationpb.New(time.Hour),
+			},
+		},
+		{
+			desc: "api policy takes precedence",
+			name: pruneApi,
+			project: &controller.RetentionPolicy{
+				KeepCount: 1,
+			},
+			api: &controller.RetentionPolicy{
+				KeepCount: 10,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			server, revisions := pruneTestServer(t)
+			if test.project != nil {
+				setRetentionPolicy(t, server, pruneProject, test.project)
+			}
+			if test.api != nil {
+				setRetentionPolicy(t, server, pruneApi, test.api)
+			}
+			var want []string
+			for _, i := range test.wantSpecs {
+				want = append(want, revisions.specs[i])
+			}
+			for _, i := range test.wantDeploy {
+				want = append(want, revisions.deployments[i])
+			}
+			sort.Strings(want)
+			before := listRevisionNames(t, server)
+
+			req := &rpc.PruneApiRevisionsRequest{Name: test.name, ValidateOnly: true}
+			got, err := server.PruneApiRevisions(ctx, req)
+			if err != nil {
+				t.Fatalf("PruneApiRevisions(%+v) returned error: %s", req, err)
+			}
+			if diff := cmp.Diff(want, got.GetRevisionNames(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("PruneApiRevisions(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+			}
+			if diff := cmp.Diff(before, listRevisionNames(t, server)); diff != "" {
+				t.Errorf("PruneApiRevisions(%+v) changed revisions (-want +got):\n%s", req, diff)
+			}
+
+			req.ValidateOnly = false
+			got, err = server.PruneApiRevisions(ctx, req)
+			if err != nil {
+				t.Fatalf("PruneApiRevisions(%+v) returned error: %s", req, err)
+			}
+			if diff := cmp.Diff(want, got.GetRevisionNames(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("PruneApiRevisions(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+			}
+			remaining := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+			wantRemaining := difference(before, want)
+			if diff := cmp.Diff(wantRemaining, listRevisionNames(t, server), remaining); diff != "" {
+				t.Errorf("PruneApiRevisions(%+v) left unexpected revisions (-want +got):\n%s", req, diff)
+			}
+		})
+	}
+}
+
+func difference(a, b []string) []string {
+	remove := map[string]bool{}
+	for _, s := range b {
+		remove[s] = true
+	}
+	var d []string
+	for _, s := range a {
+		if !remove[s] {
+			d = append(d, s)
+		}
+	}
+	return d
+}
+
+func TestPruneApiRevisionsResponseCodes(t *testing.T) {
+	tests := []struct {
+		desc   string
+		name   string
+		policy *controller.RetentionPolicy
+		want   codes.Code
+	}{
+		{
+			desc: "invalid name",
+			name: pruneSpec,
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "all projects",
+			name: "projects/-/locations/global/apis/-",
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing api",
+			name: pruneProject + "/apis/missing",
+			want: codes.NotFound,
+		},
+		{
+			desc: "missing project",
+			name: "projects/missing/locations/global/apis/-",
+			want: codes.NotFound,
+		},
+		{
+			desc:   "negative keep count",
+			name:   pruneApi,
+			policy: &controller.RetentionPolicy{KeepCount: -1},
+			want:   codes.FailedPrecondition,
+		},
+		{
+			desc:   "negative keep duration",
+			name:   pruneApi,
+			policy: &controller.RetentionPolicy{KeepDuration: durationpb.New(-time.Hour)},
+			want:   codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server, _ := pruneTestServer(t)
+			if test.policy != nil {
+				setRetentionPolicy(t, server, pruneProject, test.policy)
+			}
+			req := &rpc.PruneApiRevisionsRequest{Name: test.name}
+			if _, err := server.PruneApiRevisions(context.Background(), req); status.Code(err) != test.want {
+				t.Errorf("PruneApiRevisions(%+v) returned status code %q, want %q: %v", req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestPruneYamlPolicy(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	createRetentionPolicy(t, server, pruneApi, mime.YamlMimeTypeForKind("RetentionPolicy"), []byte("keepCount: 5\n"))
+	req := &rpc.PruneApiRevisionsRequest{Name: pruneApi, ValidateOnly: true}
+	got, err := server.PruneApiRevisions(ctx, req)
+	if err != nil {
+		t.Fatalf("PruneApiRevisions(%+v) returned error: %s", req, err)
+	}
+	if diff := cmp.Diff([]string{revisions.specs[0]}, got.GetRevisionNames()); diff != "" {
+		t.Errorf("PruneApiRevisions(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+}
+
+func TestPruneReferencesFromOtherProjects(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	setRetentionPolicy(t, server, pruneProject, &controller.RetentionPolicy{KeepCount: 1})
+	// The first revision is referenced by name and the second is referenced
+	// relative to the latest revision, from deployments of another project.
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiDeployment{
+			Name:            "projects/other-project/locations/global/apis/a/deployments/named",
+			ApiSpecRevision: revisions.specs[0],
+		},
+		&rpc.ApiDeployment{
+			Name:            "projects/other-project/locations/global/apis/a/deployments/relative",
+			ApiSpecRevision: pruneSpec + "@{-4}",
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.PruneApiRevisionsRequest{Name: pruneApi}
+	got, err := server.PruneApiRevisions(ctx, req)
+	if err != nil {
+		t.Fatalf("PruneApiRevisions(%+v) returned error: %s", req, err)
+	}
+	want := []string{revisions.deployments[0], revisions.deployments[1], revisions.specs[4]}
+	sort.Strings(want)
+	if diff := cmp.Diff(want, got.GetRevisionNames()); diff != "" {
+		t.Errorf("PruneApiRevisions(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+}
+
+// createReferencingDeployment creates an API of the prune test project with
+// a deployment that refers to a spec revision.
+func createReferencingDeployment(t *testing.T, server *RegistryServer, apiID, specRevision string) names.Deployment {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{Parent: pruneProject, ApiId: apiID, Api: &rpc.Api{}}); err != nil {
+		t.Fatalf("Setup: CreateApi() returned error: %s", err)
+	}
+	name := names.Deployment{ProjectID: "my-project", ApiID: apiID, DeploymentID: "d"}
+	if _, err := server.CreateApiDeployment(ctx, &rpc.CreateApiDeploymentRequest{
+		Parent:          name.Api().String(),
+		ApiDeploymentId: name.DeploymentID,
+		ApiDeployment:   &rpc.ApiDeployment{ApiSpecRevision: specRevision},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiDeployment() returned error: %s", err)
+	}
+	return name
+}
+
+func TestPruneReferencesFromDeletedApis(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	server.purgeWindow = time.Hour
+	setRetentionPolicy(t, server, pruneApi, &controller.RetentionPolicy{KeepCount: 1})
+	// The first revision is referenced from a deployment of a deleted API
+	// that can still be restored.
+	deleted := createReferencingDeployment(t, server, "deleted", revisions.specs[0])
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deleted.Api().String(), Force: true}); err != nil {
+		t.Fatalf("Setup: DeleteApi() returned error: %s", err)
+	}
+
+	req := &rpc.PruneApiRevisionsRequest{Name: pruneApi}
+	got, err := server.PruneApiRevisions(ctx, req)
+	if err != nil {
+		t.Fatalf("PruneApiRevisions(%+v) returned error: %s", req, err)
+	}
+	want := []string{revisions.deployments[0], revisions.deployments[1], revisions.specs[1], revisions.specs[4]}
+	sort.Strings(want)
+	if diff := cmp.Diff(want, got.GetRevisionNames()); diff != "" {
+		t.Errorf("PruneApiRevisions(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+}
+
+func TestPruneRevisionsChangedAfterListing(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	setRetentionPolicy(t, server, pruneApi, &controller.RetentionPolicy{KeepCount: 1})
+	specNames, deploymentNames, err := server.prunableRevisions(ctx, names.Api{ProjectID: "my-project", ApiID: "a"}, time.Now())
+	if err != nil {
+		t.Fatalf("prunableRevisions() returned error: %s", err)
+	}
+
+	// After the revisions are listed, a spec revision is referenced by a
+	// new deployment and a deployment revision is tagged.
+	createReferencingDeployment(t, server, "b", revisions.specs[0])
+	if _, err := server.TagApiDeploymentRevision(ctx, &rpc.TagApiDeploymentRevisionRequest{
+		Name: revisions.deployments[0],
+		Tag:  "keep",
+	}); err != nil {
+		t.Fatalf("Setup: TagApiDeploymentRevision() returned error: %s", err)
+	}
+
+	got, err := server.deleteRevisions(ctx, specNames, deploymentNames)
+	if err != nil {
+		t.Fatalf("deleteRevisions() returned error: %s", err)
+	}
+	want := []string{revisions.deployments[1], revisions.specs[1], revisions.specs[4]}
+	sort.Strings(want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("deleteRevisions() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	remaining := listRevisionNames(t, server)
+	for _, name := range []string{revisions.specs[0], revisions.deployments[0]} {
+		found := false
+		for _, r := range remaining {
+			found = found || r == name
+		}
+		if !found {
+			t.Errorf("deleteRevisions() deleted %s, which changed after it was listed", name)
+		}
+	}
+}
+
+func TestPrunable(t *testing.T) {
+	now := time.Now()
+	revisions := []storage.Revision{
+		{Name: "r4", Created: now.Add(-1 * time.Hour)},
+		{Name: "r3", Created: now.Add(-2 * time.Hour)},
+		{Name: "r2", Created: now.Add(-3 * time.Hour), Tagged: true},
+		{Name: "r1", Created: now.Add(-4 * time.Hour)},
+		{Name: "r0", Created: now.Add(-5 * time.Hour)},
+	}
+	tests := []struct {
+		desc       string
+		policy     *controller.RetentionPolicy
+		referenced map[string]bool
+		want       []string
+	}{
+		{
+			desc:   "no limits",
+			policy: &controller.RetentionPolicy{},
+		},
+		{
+			desc:   "keep newest",
+			policy: &controller.RetentionPolicy{KeepCount: 1},
+			want:   []string{"r3", "r1", "r0"},
+		},
+		{
+			desc:       "keep referenced",
+			policy:     &controller.RetentionPolicy{KeepCount: 1},
+			referenced: map[string]bool{"r1": true},
+			want:       []string{"r3", "r0"},
+		},
+		{
+			desc:   "keep recent",
+			policy: &controller.RetentionPolicy{KeepDuration: durationpb.New(150 * time.Minute)},
+			want:   []string{"r1", "r0"},
+		},
+		{
+			desc:   "keep count or duration",
+			policy: &controller.RetentionPolicy{KeepCount: 4, KeepDuration: durationpb.New(150 * time.Minute)},
+			want:   []string{"r0"},
+		},
+		{
+			desc:   "keep nothing recent",
+			policy: &controller.RetentionPolicy{KeepDuration: durationpb.New(0)},
+			want:   []string{"r3", "r1", "r0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := prunable(revisions, test.policy, test.referenced, now)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("prunable() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackgroundPruning(t *testing.T) {
+	server, revisions := pruneTestServer(t)
+	setRetentionPolicy(t, server, pruneProject, &controller.RetentionPolicy{KeepCount: 1})
+	server.prune(context.Background(), time.Now())
+	want := []string{
+		revisions.specs[2],
+		revisions.specs[3],
+		revisions.specs[5],
+		revisions.deployments[2],
+	}
+	sort.Strings(want)
+	if diff := cmp.Diff(want, listRevisionNames(t, server)); diff != "" {
+		t.Errorf("prune() left unexpected revisions (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProjectQuota handles the corresponding API request.
+func (s *RegistryServer) GetProjectQuota(ctx context.Context, req *rpc.GetProjectQuotaRequest) (*rpc.ProjectQuota, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := db.GetProject(ctx, name); err != nil {
+		return nil, err
+	}
+
+	apis, err := db.CountApis(ctx, name.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := db.MaxSpecRevisions(ctx, name.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := db.BlobBytes(ctx, name.ProjectID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	limits := s.quotas.limits(name.ProjectID)
+	response := &rpc.ProjectQuota{
+		Name: name.String(),
+		Metrics: []*rpc.QuotaMetric{
+			{Metric: apisMetric, Limit: limits.MaxApis, Usage: apis},
+			{Metric: specRevisionsMetric, Limit: limits.MaxSpecRevisions, Usage: revisions},
+			{Metric: blobBytesMetric, Limit: limits.MaxBlobBytes, Usage: bytes},
+		},
+	}
+	if s.rateLimiter != nil {
+		response.RequestsPerSecond = float64(s.rateLimiter.limit)
+		response.RequestBurst = int32(s.rateLimiter.burst)
+	}
+	return response, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplayNotifications handles the corresponding API request.
+func (s *RegistryServer) ReplayNotifications(ctx context.Context, req *rpc.ReplayNotificationsRequest) (*rpc.ReplayNotificationsResponse, error) {
+	if req.GetStartSequence() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_sequence %d: must not be negative", req.GetStartSequence())
+	}
+	if !s.hasSinks() {
+		return nil, status.Error(codes.FailedPrecondition, "no notification sinks are configured")
+	}
+	var count int64
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var err error
+		count, err = db.ResetEvents(ctx, req.GetStartSequence())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &rpc.ReplayNotificationsResponse{Count: count}, nil
+}
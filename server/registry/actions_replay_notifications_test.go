@@ -0,0 +1,46 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReplayNotifications(t *testing.T) {
+	ctx := context.Background()
+	sink := &recordingSink{}
+	server := serverWithSinks(t, sink)
+	createProjects(t, server, "a", "b", "c")
+	waitForSequences(t, sink, []int64{1, 2, 3})
+
+	resp, err := server.ReplayNotifications(ctx, &rpc.ReplayNotificationsRequest{StartSequence: 2})
+	if err != nil {
+		t.Fatalf("ReplayNotifications() returned error: %v", err)
+	}
+	if resp.GetCount() != 2 {
+		t.Errorf("ReplayNotifications() scheduled %d notifications, want 2", resp.GetCount())
+	}
+	waitForSequences(t, sink, []int64{1, 2, 3, 2, 3})
+
+	_, err = server.ReplayNotifications(ctx, &rpc.ReplayNotificationsRequest{StartSequence: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReplayNotifications() returned status code %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const searchProject = "projects/my-project/locations/global"
+
+const invoicesSpec = `openapi: 3.0.0
+info:
+  title: Invoices
+paths:
+  /invoices:
+    get:
+      operationId: ListInvoices
+      x-internal-id: 1234
+`
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatalf("Setup: Failed to compress contents: %s", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Setup: Failed to compress contents: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func searchTestServer(t *testing.T) TestServer {
+	t.Helper()
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.Api{
+			Name:        searchProject + "/apis/billing",
+			DisplayName: "Billing",
+			Description: "Manages invoices and payments.",
+		},
+		&rpc.ApiSpec{
+			Name:     searchProject + "/apis/billing/versions/v1/specs/openapi",
+			MimeType: "application/x.openapi+gzip;version=3",
+			Contents: gzipped(t, invoicesSpec),
+		},
+		&rpc.ApiSpec{
+			Name:        searchProject + "/apis/accounts/versions/v1/specs/notes",
+			MimeType:    "text/plain",
+			Description: "Notes about billing.",
+			Contents:    []byte("Accounts are created before invoices are sent."),
+		},
+		&rpc.ApiDeployment{
+			Name:        searchProject + "/apis/accounts/deployments/prod",
+			DisplayName: "Production",
+		},
+		&rpc.Api{
+			Name:        "projects/other-project/locations/global/apis/billing",
+			Description: "Another billing API.",
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	return server
+}
+
+func search(t *testing.T, server TestServer, query string) []string {
+	t.Helper()
+	response, err := server.SearchResources(context.Background(), &rpc.SearchResourcesRequest{
+		Parent: searchProject,
+		Query:  query,
+	})
+	if err != nil {
+		t.Fatalf("SearchResources(%q) returned error: %s", query, err)
+	}
+	results := make([]string, len(response.GetResults()))
+	for i, r := range response.GetResults() {
+		results[i] = strings.TrimPrefix(r.GetName(), searchProject+"/")
+	}
+	return results
+}
+
+func TestSearchResources(t *testing.T) {
+	server := searchTestServer(t)
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"x-internal-id", []string{"apis/billing/versions/v1/specs/openapi"}},
+		{"listinvoices", []string{"apis/billing/versions/v1/specs/openapi"}},
+		{`"ListInvoices" get`, []string{"apis/billing/versions/v1/specs/openapi"}},
+		{"production", []string{"apis/accounts/deployments/prod"}},
+		{"invoices sent", []string{"apis/accounts/versions/v1/specs/notes"}},
+		{`"invoices sent"`, []string{}},
+		{"unknown", []string{}},
+		// Matches in names and descriptions rank above matches in contents.
+		{"billing", []string{"apis/billing", "apis/accounts/versions/v1/specs/notes"}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			if got := search(t, server, test.query); !cmp.Equal(test.want, got) {
+				t.Errorf("SearchResources(%q) returned unexpected diff (-want +got):\n%s", test.query, cmp.Diff(test.want, got))
+			}
+		})
+	}
+
+	t.Run("snippets", func(t *testing.T) {
+		response, err := server.SearchResources(context.Background(), &rpc.SearchResourcesRequest{
+			Parent: searchProject,
+			Query:  "ListInvoices",
+		})
+		if err != nil {
+			t.Fatalf("SearchResources() returned error: %s", err)
+		}
+		if snippet := response.GetResults()[0].GetSnippet(); !strings.Contains(snippet, "<b>ListInvoices</b>") {
+			t.Errorf("SearchResources() returned snippet %q, expected it to highlight %q", snippet, "ListInvoices")
+		}
+	})
+}
+
+func TestSearchResourcesFollowsChanges(t *testing.T) {
+	ctx := context.Background()
+	server := searchTestServer(t)
+	spec := searchProject + "/apis/accounts/versions/v1/specs/notes"
+
+	updated, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: spec, Contents: []byte("Accounts can be closed.")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	if got := search(t, server, "closed"); len(got) != 1 {
+		t.Errorf("SearchResources() returned %v for updated contents, expected the spec", got)
+	}
+	if got := search(t, server, "sent"); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v for replaced contents, expected none", got)
+	}
+
+	if _, err := server.DeleteApiSpecRevision(ctx, &rpc.DeleteApiSpecRevisionRequest{Name: spec + "@" + updated.GetRevisionId()}); err != nil {
+		t.Fatalf("DeleteApiSpecRevision() returned error: %s", err)
+	}
+	if got := search(t, server, "sent"); len(got) != 1 {
+		t.Errorf("SearchResources() returned %v after deleting the latest revision, expected the spec", got)
+	}
+
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: spec}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	if got := search(t, server, "sent"); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v for a deleted spec, expected none", got)
+	}
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: searchProject + "/apis/billing", Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if got := search(t, server, "ListInvoices"); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v for a deleted API, expected none", got)
+	}
+}
+
+func TestSearchResourcesOfSoftDeletedApis(t *testing.T) {
+	ctx := context.Background()
+	server := purgeTestServer(t, time.Hour)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if got := search(t, server, "hello"); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v for a deleted API, expected none", got)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi}); err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+	if got := search(t, server, "hello"); len(got) != 1 {
+		t.Errorf("SearchResources() returned %v for a restored API, expected its spec", got)
+	}
+}
+
+func TestSearchResourcesPages(t *testing.T) {
+	ctx := context.Background()
+	server := searchTestServer(t)
+
+	req := &rpc.SearchResourcesRequest{
+		Parent:   searchProject,
+		Query:    "billing",
+		PageSize: 1,
+	}
+	var got []string
+	for {
+		response, err := server.SearchResources(ctx, req)
+		if err != nil {
+			t.Fatalf("SearchResources() returned error: %s", err)
+		}
+		for _, r := range response.GetResults() {
+			got = append(got, r.GetName())
+		}
+		if response.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = response.GetNextPageToken()
+	}
+	if len(got) != 2 || got[0] == got[1] {
+		t.Errorf("SearchResources() returned %v across pages, expected 2 results", got)
+	}
+
+	req.Query = "invoices"
+	if _, err := server.SearchResources(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SearchResources() returned status %s for a token with a different query, expected %s: %v", status.Code(err), codes.InvalidArgument, err)
+	}
+}
+
+func TestSearchResourcesErrors(t *testing.T) {
+	ctx := context.Background()
+	server := searchTestServer(t)
+
+	tests := []struct {
+		desc string
+		req  *rpc.SearchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "invalid parent",
+			req:  &rpc.SearchResourcesRequest{Parent: "projects/my-project", Query: "billing"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "empty query",
+			req:  &rpc.SearchResourcesRequest{Parent: searchProject},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "query without words",
+			req:  &rpc.SearchResourcesRequest{Parent: searchProject, Query: `- "" *`},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "negative page size",
+			req:  &rpc.SearchResourcesRequest{Parent: searchProject, Query: "billing", PageSize: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid page token",
+			req:  &rpc.SearchResourcesRequest{Parent: searchProject, Query: "billing", PageToken: "invalid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing project",
+			req:  &rpc.SearchResourcesRequest{Parent: "projects/missing/locations/global", Query: "billing"},
+			want: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.SearchResources(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("SearchResources(%+v) returned status %s, expected %s: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
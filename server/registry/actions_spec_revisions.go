@@ -79,10 +79,17 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 	}
 	var response *rpc.ApiSpec
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkSpecRevisionEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
 		if err := db.DeleteSpecRevision(ctx, name); err != nil {
 			return err
 		}
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, name.String(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -92,7 +99,6 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 		// The get will fail if we are deleting the only revision.
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return response, nil
 }
 
@@ -116,7 +122,6 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	var response *rpc.ApiSpec
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// The revision to be tagged must exist.
 		revision, err := db.GetSpecRevision(ctx, name)
@@ -129,6 +134,10 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 		if err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
+		before, err := revision.BasicMessage(name.String())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 		tag := models.NewSpecRevisionTag(name, req.GetTag())
 		if err := db.SaveSpecRevisionTag(ctx, tag); err != nil {
 			return err
@@ -137,12 +146,10 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 		if err != nil {
 			return err
 		}
-		revisionName = name.String()
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, name.String(), nil, before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, revisionName)
 	return response, nil
 }
 
@@ -158,7 +165,6 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	var response *rpc.ApiSpec
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// Get the target spec revision to use as a base for the new rollback revision.
 		name := parent.Revision(req.GetRevisionId())
@@ -167,6 +173,9 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 			return err
 		}
 		// Save a new rollback revision based on the target revision.
+		if err := s.checkSpecRevisionQuota(ctx, db, parent); err != nil {
+			return err
+		}
 		rollback := target.NewRevision()
 		if err := db.SaveSpecRevision(ctx, rollback); err != nil {
 			return err
@@ -177,6 +186,9 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		}
 		// Save a new copy of the target revision blob for the rollback revision.
 		blob.RevisionID = name.RevisionID
+		if err := s.checkBlobQuota(ctx, db, parent.Project(), rollback.RevisionName(), len(blob.Contents)); err != nil {
+			return err
+		}
 		if err := db.SaveSpecRevisionContents(ctx, rollback, blob.Contents); err != nil {
 			return err
 		}
@@ -184,11 +196,9 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		if err != nil {
 			return err
 		}
-		revisionName = rollback.RevisionName()
-		return nil
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, rollback.RevisionName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, revisionName)
 	return response, nil
 }
@@ -296,7 +296,7 @@ func TestRollbackApiSpec(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	if !cmp.Equal(want, rollback, opts) {
@@ -598,7 +598,7 @@ func TestListApiSpecRevisions(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiSpecRevisionsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -648,6 +648,7 @@ func TestListApiSpecRevisionsSequence(t *testing.T) {
 		RevisionCreateTime: firstRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: firstRevision.GetRevisionUpdateTime(),
 		RevisionId:         firstRevision.GetRevisionId(),
+		Etag:               firstRevision.GetEtag(),
 	}
 
 	updateReq := &rpc.UpdateApiSpecRequest{
@@ -672,6 +673,7 @@ func TestListApiSpecRevisionsSequence(t *testing.T) {
 		RevisionCreateTime: secondRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: secondRevision.GetRevisionUpdateTime(),
 		RevisionId:         secondRevision.GetRevisionId(),
+		Etag:               secondRevision.GetEtag(),
 	}
 
 	opts := cmp.Options{
@@ -738,6 +740,67 @@ func TestListApiSpecRevisionsSequence(t *testing.T) {
 	})
 }
 
+// This test creates new revisions while revisions are being listed.
+// Revisions that existed when listing began should be listed exactly once.
+func TestListApiSpecRevisionsConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	spec := &rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/my-api/versions/v1/specs/my-spec"}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	updates := 0
+	update := func() {
+		t.Helper()
+		updates++
+		req := &rpc.UpdateApiSpecRequest{
+			ApiSpec: &rpc.ApiSpec{
+				Name:     spec.GetName(),
+				Contents: []byte(fmt.Sprintf("contents-%d", updates)),
+			},
+		}
+		if _, err := server.UpdateApiSpec(ctx, req); err != nil {
+			t.Fatalf("Setup: UpdateApiSpec(%+v) returned error: %s", req, err)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		update()
+	}
+
+	req := &rpc.ListApiSpecRevisionsRequest{
+		Name:     spec.GetName(),
+		PageSize: 2,
+	}
+	seen := make(map[string]int)
+	for {
+		got, err := server.ListApiSpecRevisions(ctx, req)
+		if err != nil {
+			t.Fatalf("ListApiSpecRevisions(%+v) returned error: %s", req, err)
+		}
+		for _, r := range got.GetApiSpecs() {
+			seen[r.GetRevisionId()]++
+		}
+		if got.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = got.GetNextPageToken()
+		// New revisions come first in the listing, so they shouldn't be returned on later pages.
+		update()
+	}
+
+	listed := 0
+	for id, count := range seen {
+		if count > 1 {
+			t.Errorf("ListApiSpecRevisions() returned revision %q %d times", id, count)
+		}
+		listed += count
+	}
+	if listed != 5 {
+		t.Errorf("ListApiSpecRevisions() returned %d revisions, want 5", listed)
+	}
+}
+
 func TestListApiSpecRevisionsLargeCollection(t *testing.T) {
 	ctx := context.Background()
 	server := defaultTestServer(t)
@@ -801,7 +864,7 @@ func TestUpdateApiSpecRevisions(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	t.Run("modify revision without content changes", func(t *testing.T) {
@@ -866,3 +929,159 @@ func TestUpdateApiSpecRevisions(t *testing.T) {
 		}
 	})
 }
+
+func TestRelativeRevisionReferences(t *testing.T) {
+	ctx := context.Background()
+	server, revisions := pruneTestServer(t)
+	revisionID := func(name string) string {
+		return name[strings.LastIndex(name, "@")+1:]
+	}
+
+	specTests := []struct {
+		ref  string
+		want string // the expected revision name, if found
+	}{
+		{ref: "latest", want: revisions.specs[5]},
+		{ref: "{-0}", want: revisions.specs[5]},
+		{ref: "{-1}", want: revisions.specs[4]},
+		{ref: "{-5}", want: revisions.specs[0]},
+		{ref: "{-6}"},
+		{ref: "keep~0", want: revisions.specs[2]},
+		{ref: "keep~2", want: revisions.specs[0]},
+		{ref: "keep~3"},
+		{ref: revisionID(revisions.specs[4]) + "~1", want: revisions.specs[3]},
+		{ref: "missing~1"},
+	}
+	for _, test := range specTests {
+		t.Run("spec@"+test.ref, func(t *testing.T) {
+			name := pruneSpec + "@" + test.ref
+			got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+			if test.want == "" {
+				if status.Code(err) != codes.NotFound {
+					t.Errorf("GetApiSpec(%q) returned status code %q, want %q: %v", name, status.Code(err), codes.NotFound, err)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("GetApiSpec(%q) returned error: %s", name, err)
+			}
+			if got.GetRevisionId() != revisionID(test.want) {
+				t.Errorf("GetApiSpec(%q) returned revision %q, want %q", name, got.GetRevisionId(), revisionID(test.want))
+			}
+
+			contents, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: name})
+			if err != nil {
+				t.Fatalf("GetApiSpecContents(%q) returned error: %s", name, err)
+			}
+			want, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: test.want})
+			if err != nil {
+				t.Fatalf("GetApiSpecContents(%q) returned error: %s", test.want, err)
+			}
+			if !cmp.Equal(want, contents, protocmp.Transform()) {
+				t.Errorf("GetApiSpecContents(%q) returned %q, want %q", name, contents.GetData(), want.GetData())
+			}
+
+			list, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: name})
+			if err != nil {
+				t.Fatalf("ListApiSpecRevisions(%q) returned error: %s", name, err)
+			}
+			if len(list.GetApiSpecs()) != 1 || list.GetApiSpecs()[0].GetName() != test.want {
+				t.Errorf("ListApiSpecRevisions(%q) returned %v, want only %q", name, list.GetApiSpecs(), test.want)
+			}
+		})
+	}
+
+	deploymentTests := []struct {
+		ref  string
+		want string
+	}{
+		{ref: "latest", want: revisions.deployments[2]},
+		{ref: "{-2}", want: revisions.deployments[0]},
+		{ref: revisionID(revisions.deployments[2]) + "~1", want: revisions.deployments[1]},
+		{ref: "{-3}"},
+	}
+	for _, test := range deploymentTests {
+		t.Run("deployment@"+test.ref, func(t *testing.T) {
+			name := pruneDeployment + "@" + test.ref
+			got, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: name})
+			if test.want == "" {
+				if status.Code(err) != codes.NotFound {
+					t.Errorf("GetApiDeployment(%q) returned status code %q, want %q: %v", name, status.Code(err), codes.NotFound, err)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("GetApiDeployment(%q) returned error: %s", name, err)
+			}
+			if got.GetRevisionId() != revisionID(test.want) {
+				t.Errorf("GetApiDeployment(%q) returned revision %q, want %q", name, got.GetRevisionId(), revisionID(test.want))
+			}
+		})
+	}
+
+	t.Run("latest tag", func(t *testing.T) {
+		if _, err := server.TagApiSpecRevision(ctx, &rpc.TagApiSpecRevisionRequest{
+			Name: revisions.specs[1],
+			Tag:  "latest",
+		}); err != nil {
+			t.Fatalf("TagApiSpecRevision() returned error: %s", err)
+		}
+		name := pruneSpec + "@latest"
+		got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetApiSpec(%q) returned error: %s", name, err)
+		}
+		if got.GetRevisionId() != revisionID(revisions.specs[1]) {
+			t.Errorf("GetApiSpec(%q) returned revision %q, want the tagged revision %q", name, got.GetRevisionId(), revisionID(revisions.specs[1]))
+		}
+	})
+}
+
+func TestRelativeRevisionReferencesWithTies(t *testing.T) {
+	ctx := context.Background()
+	server, _ := archiveTestServer(t)
+	// Revisions that were created at the same time are imported from an archive.
+	archive, err := readArchive(exportArchive(t, server, "projects/my-project"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := archive.GetSpecRevisions()[0].GetRevisionCreateTime()
+	for _, m := range archive.GetSpecRevisions() {
+		m.RevisionCreateTime = created
+	}
+	importArchive(t, server, "ties", compressArchive(t, archive))
+	spec := strings.ReplaceAll(pruneSpec, "projects/my-project/", "projects/ties/")
+
+	list, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: spec + "@-"})
+	if err != nil {
+		t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+	}
+	revisions := list.GetApiSpecs()
+	if got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec}); err != nil {
+		t.Fatalf("GetApiSpec(%q) returned error: %s", spec, err)
+	} else if got.GetRevisionId() != revisions[0].GetRevisionId() {
+		t.Errorf("GetApiSpec(%q) returned revision %q, want the first listed revision %q", spec, got.GetRevisionId(), revisions[0].GetRevisionId())
+	}
+	// References count revisions in the order that they are listed.
+	for i, base := range revisions {
+		for n := 0; i+n <= len(revisions); n++ {
+			name := fmt.Sprintf("%s@%s~%d", spec, base.GetRevisionId(), n)
+			got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+			if i+n == len(revisions) {
+				if status.Code(err) != codes.NotFound {
+					t.Errorf("GetApiSpec(%q) returned status code %q, want %q: %v", name, status.Code(err), codes.NotFound, err)
+				}
+				continue
+			} else if err != nil {
+				t.Fatalf("GetApiSpec(%q) returned error: %s", name, err)
+			}
+			if want := revisions[i+n].GetRevisionId(); got.GetRevisionId() != want {
+				t.Errorf("GetApiSpec(%q) returned revision %q, want %q", name, got.GetRevisionId(), want)
+			}
+		}
+		name := fmt.Sprintf("%s@{-%d}", spec, i)
+		if got, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name}); err != nil {
+			t.Fatalf("GetApiSpec(%q) returned error: %s", name, err)
+		} else if got.GetRevisionId() != base.GetRevisionId() {
+			t.Errorf("GetApiSpec(%q) returned revision %q, want %q", name, got.GetRevisionId(), base.GetRevisionId())
+		}
+	}
+}
@@ -46,11 +46,13 @@ func (s *RegistryServer) CreateApiSpec(ctx context.Context, req *rpc.CreateApiSp
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createSpec(ctx, db, name, req.GetApiSpec())
-		return err
+		if err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_CREATED, response.GetName(), nil, nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -66,6 +68,9 @@ func (s *RegistryServer) createSpec(ctx context.Context, db *storage.Client, nam
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := s.checkBlobQuota(ctx, db, name.Project(), spec.RevisionName(), len(body.GetContents())); err != nil {
+		return nil, err
+	}
 
 	if err := db.CreateSpecRevision(ctx, spec); err != nil {
 		return nil, err
@@ -87,18 +92,26 @@ func (s *RegistryServer) DeleteApiSpec(ctx context.Context, req *rpc.DeleteApiSp
 	}
 
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockSpecs(ctx).DeleteSpec(ctx, name, req.GetForce())
+		db.LockSpecs(ctx, name.ProjectID)
+		if err := checkSpecEtag(ctx, db, name, req.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
+		if err := db.DeleteSpec(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.recordChange(ctx, db, rpc.Notification_DELETED, req.GetName(), nil, before, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
 // GetApiSpec handles the corresponding API request.
 func (s *RegistryServer) GetApiSpec(ctx context.Context, req *rpc.GetApiSpecRequest) (*rpc.ApiSpec, error) {
-	s.begin()
-	defer s.end()
 	if name, err := names.ParseSpec(req.GetName()); err == nil {
 		return s.getApiSpec(ctx, name)
 	} else if name, err := names.ParseSpecRevision(req.GetName()); err == nil {
@@ -165,7 +178,7 @@ func (s *RegistryServer) GetApiSpecContents(ctx context.Context, req *rpc.GetApi
 		if spec, err = db.GetSpecRevision(ctx, name); err != nil {
 			return nil, err
 		}
-		revisionName = name
+		revisionName = name.Spec().Revision(spec.RevisionID)
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid resource name %q, must be an API spec or revision", specName)
 	}
@@ -252,38 +265,58 @@ func (s *RegistryServer) UpdateApiSpec(ctx context.Context, req *rpc.UpdateApiSp
 	}
 	var response *rpc.ApiSpec
 	if err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		// Lock the specs of the project so that the spec can't change between the etag check and the update.
+		db.LockSpecs(ctx, name.ProjectID)
+		if err := checkSpecEtag(ctx, db, name, req.ApiSpec.GetEtag()); err != nil {
+			return err
+		}
+		before, err := storedResource(ctx, db, name.String())
+		if err != nil {
+			return err
+		}
 		spec, err := db.GetSpec(ctx, name)
 		if err == nil {
 			// Apply the update to the spec - possibly changing the revision ID.
 			maskExpansion := models.ExpandMask(req.GetApiSpec(), req.GetUpdateMask())
+			revisionID := spec.RevisionID
 			if err := spec.Update(req.GetApiSpec(), maskExpansion); err != nil {
 				return err
 			}
+			updatesContents := len(fieldmaskpb.Intersect(maskExpansion, &fieldmaskpb.FieldMask{Paths: []string{"contents"}}).GetPaths()) > 0
+			if spec.RevisionID != revisionID {
+				if err := s.checkSpecRevisionQuota(ctx, db, name); err != nil {
+					return err
+				}
+			}
+			if updatesContents {
+				if err := s.checkBlobQuota(ctx, db, name.Project(), spec.RevisionName(), len(req.ApiSpec.GetContents())); err != nil {
+					return err
+				}
+			}
 			// Save the updated/current spec. This creates a new revision or updates the previous one.
 			if err := db.SaveSpecRevision(ctx, spec); err != nil {
 				return err
 			}
 			// If the spec contents were updated, save a new blob.
-			if len(fieldmaskpb.Intersect(maskExpansion, &fieldmaskpb.FieldMask{Paths: []string{"contents"}}).GetPaths()) > 0 {
+			if updatesContents {
 				if err := db.SaveSpecRevisionContents(ctx, spec, req.ApiSpec.GetContents()); err != nil {
 					return err
 				}
 			}
 			response, err = spec.BasicMessage(name.String())
-			return err
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createSpec(ctx, db, name, req.GetApiSpec())
 			if status.Code(err) == codes.AlreadyExists {
 				err = status.Error(codes.Aborted, err.Error())
 			}
-			return err
-		} else {
+		}
+		if err != nil {
 			return err
 		}
+		return s.recordChange(ctx, db, rpc.Notification_UPDATED, response.GetName(), req.GetUpdateMask(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
 
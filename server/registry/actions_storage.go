@@ -46,8 +46,14 @@ func (s *RegistryServer) GetStorage(ctx context.Context, req *emptypb.Empty) (*r
 			},
 		)
 	}
+	logical, physical, err := db.BlobSizes(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &rpc.Storage{
-		Description: db.DatabaseName(ctx),
-		Collections: collections,
+		Description:       db.DatabaseName(ctx),
+		Collections:       collections,
+		LogicalSizeBytes:  logical,
+		PhysicalSizeBytes: physical,
 	}, nil
 }
@@ -15,12 +15,16 @@
 package registry
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestGetStorage(t *testing.T) {
@@ -39,7 +43,7 @@ func TestGetStorage(t *testing.T) {
 
 	// Ensure that we get the set of tables that we expect.
 	// Tables should be returned in alphabetical order.
-	want := []string{"apis", "artifacts", "blobs", "deployment_revision_tags", "deployments", "projects", "spec_revision_tags", "specs", "versions"}
+	want := []string{"apis", "artifacts", "audit_entries", "blob_contents", "blobs", "deployment_revision_tags", "deployments", "events", "projects", "search_documents", "settings", "spec_revision_tags", "specs", "versions"}
 	got := make([]string, 0)
 	for _, c := range resp.Collections {
 		got = append(got, c.Name)
@@ -48,3 +52,84 @@ func TestGetStorage(t *testing.T) {
 		t.Errorf("GetStorage(%+v) returned unexpected diff (-want +got):\n%s", req, cmp.Diff(want, got, protocmp.Transform()))
 	}
 }
+
+func TestGetStorageSharesBlobContents(t *testing.T) {
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	contents := []byte("openapi: 3.0.0\ninfo:\n  title: Shared\n")
+	size := int64(len(contents))
+	parent := "projects/my-project/locations/global/apis/a"
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: parent + "/versions/v1/specs/s", MimeType: "application/x.openapi", Contents: contents},
+		&rpc.ApiSpec{Name: parent + "/versions/v2/specs/s", MimeType: "application/x.openapi", Contents: contents},
+		&rpc.Artifact{Name: parent + "/artifacts/copy", MimeType: "text/plain", Contents: contents},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	check := func(desc string, logical, physical, stored int64) {
+		t.Helper()
+		resp, err := server.GetStorage(ctx, &emptypb.Empty{})
+		if err != nil {
+			t.Fatalf("GetStorage() returned error: %s", err)
+		}
+		if resp.GetLogicalSizeBytes() != logical || resp.GetPhysicalSizeBytes() != physical {
+			t.Errorf("GetStorage() %s returned sizes %d (logical) and %d (physical), want %d and %d",
+				desc, resp.GetLogicalSizeBytes(), resp.GetPhysicalSizeBytes(), logical, physical)
+		}
+		for _, c := range resp.GetCollections() {
+			if c.GetName() == "blob_contents" && c.GetCount() != stored {
+				t.Errorf("GetStorage() %s returned %d blob contents, want %d", desc, c.GetCount(), stored)
+			}
+		}
+	}
+	check("after uploading identical contents", 3*size, size, 1)
+
+	// Identical contents are read from the shared copy.
+	got, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: parent + "/versions/v2/specs/s"})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	}
+	if !bytes.Equal(got.GetData(), contents) {
+		t.Errorf("GetApiSpecContents() returned %q, want %q", got.GetData(), contents)
+	}
+
+	// Replacing the contents of an artifact releases its reference to the shared copy.
+	other := []byte("other")
+	if _, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: parent + "/artifacts/copy", MimeType: "text/plain", Contents: other},
+	}); err != nil {
+		t.Fatalf("ReplaceArtifact() returned error: %s", err)
+	}
+	check("after replacing an artifact", 2*size+int64(len(other)), size+int64(len(other)), 2)
+
+	// A new spec revision with different contents adds a copy that is removed with the revision.
+	updated, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: parent + "/versions/v1/specs/s", Contents: []byte("changed")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	check("after adding a revision", 2*size+int64(len(other))+7, size+int64(len(other))+7, 3)
+	if _, err := server.DeleteApiSpecRevision(ctx, &rpc.DeleteApiSpecRevisionRequest{
+		Name: parent + "/versions/v1/specs/s@" + updated.GetRevisionId(),
+	}); err != nil {
+		t.Fatalf("DeleteApiSpecRevision() returned error: %s", err)
+	}
+	check("after deleting a revision", 2*size+int64(len(other)), size+int64(len(other)), 2)
+
+	// Contents are deleted with their last reference.
+	if _, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: parent + "/versions/v1", Force: true}); err != nil {
+		t.Fatalf("DeleteApiVersion() returned error: %s", err)
+	}
+	check("after deleting a version", size+int64(len(other)), size+int64(len(other)), 2)
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: parent, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	check("after deleting the API", 0, 0, 0)
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/authz"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// recordChange records a change in the audit log and notifies subscribers of it.
+// Like notify, it must be called in the transaction that makes the change.
+// The mask lists the fields that an update changed, before is the resource as
+// it was read in the transaction before the change, or nil if it didn't exist,
+// and after is the resource after the change, or nil if it was deleted.
+func (s *RegistryServer) recordChange(ctx context.Context, db *storage.Client, change rpc.Notification_Change, resource string, mask *fieldmaskpb.FieldMask, before, after proto.Message) error {
+	entry := models.NewAuditEntry(change, resource, mask)
+	entry.Principal = authz.Identify(ctx)
+	if method, ok := grpc.Method(ctx); ok {
+		entry.Method = path.Base(method)
+	}
+	var err error
+	if entry.BeforeHash, err = auditHash(before); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if entry.AfterHash, err = auditHash(after); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := db.CreateAuditEntry(ctx, entry); err != nil {
+		return err
+	}
+	return s.notify(ctx, db, change, resource)
+}
+
+// storedResource returns a resource as its Get method returns it, or nil if
+// it doesn't exist. Changes call it in their transactions to read the
+// resources that they change for the audit log.
+func storedResource(ctx context.Context, db *storage.Client, name string) (proto.Message, error) {
+	m, err := readResource(ctx, db, name)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return m, err
+}
+
+func readResource(ctx context.Context, db *storage.Client, name string) (proto.Message, error) {
+	if n, err := names.ParseProject(name); err == nil {
+		project, err := db.GetProject(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return project.Message(), nil
+	}
+	if n, err := names.ParseApi(name); err == nil {
+		api, err := db.GetApi(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return api.Message()
+	}
+	if n, err := names.ParseVersion(name); err == nil {
+		version, err := db.GetVersion(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return version.Message()
+	}
+	if n, err := names.ParseSpec(name); err == nil {
+		spec, err := db.GetSpec(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return spec.BasicMessage(n.String())
+	}
+	if n, err := names.ParseSpecRevision(name); err == nil {
+		revision, err := db.GetSpecRevision(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return revision.BasicMessage(n.String())
+	}
+	if n, err := names.ParseDeployment(name); err == nil {
+		deployment, err := db.GetDeployment(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return deployment.BasicMessage(n.String())
+	}
+	if n, err := names.ParseDeploymentRevision(name); err == nil {
+		revision, err := db.GetDeploymentRevision(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return revision.BasicMessage(n.String())
+	}
+	if n, err := names.ParseArtifact(name); err == nil {
+		artifact, err := db.GetArtifact(ctx, n, false)
+		if err != nil {
+			return nil, err
+		}
+		return artifact.Message()
+	}
+	return nil, status.Errorf(codes.Internal, "unknown resource %q", name)
+}
+
+// auditHash returns the SHA-256 hash of the deterministic serialization of a
+// resource, or "" if the resource is nil.
+func auditHash(m proto.Message) (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
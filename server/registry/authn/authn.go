@@ -0,0 +1,235 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authn authenticates callers of the registry with JSON Web Tokens
+// (JWTs) that are sent as bearer tokens, such as OpenID Connect ID tokens.
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/server/registry/authz"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// servicePrefix is the prefix of the methods that require authentication.
+// Methods of other services, such as server reflection, don't.
+const servicePrefix = "/google.cloud.apigeeregistry.v1."
+
+// leeway is the clock skew that is tolerated when checking token times.
+const leeway = time.Minute
+
+// Issuer describes an issuer of tokens.
+type Issuer struct {
+	// Issuer is the value of the "iss" claim of the tokens.
+	Issuer string
+	// Audiences that are accepted. Tokens must have one of them in their "aud" claims.
+	// If empty, tokens with any audience are accepted.
+	Audiences []string
+	// JWKS is the path or the http(s) URL of the JSON Web Key Set that verifies the tokens.
+	JWKS string
+	// PrincipalClaim is the claim that identifies callers. If empty, "sub" is used.
+	PrincipalClaim string
+}
+
+// Config configures an Authenticator.
+type Config struct {
+	// Issuers of accepted tokens.
+	Issuers []Issuer
+	// AllowUnauthenticated allows calls without tokens. Calls with invalid tokens are always rejected.
+	AllowUnauthenticated bool
+}
+
+type issuer struct {
+	Issuer
+	keys *keySet
+}
+
+// Authenticator validates tokens.
+type Authenticator struct {
+	issuers              map[string]*issuer
+	allowUnauthenticated bool
+	now                  func() time.Time
+}
+
+// NewAuthenticator returns an Authenticator for a configuration.
+func NewAuthenticator(config Config) (*Authenticator, error) {
+	if len(config.Issuers) == 0 {
+		return nil, errors.New("at least one issuer is required")
+	}
+	a := &Authenticator{
+		issuers:              make(map[string]*issuer, len(config.Issuers)),
+		allowUnauthenticated: config.AllowUnauthenticated,
+		now:                  time.Now,
+	}
+	for _, i := range config.Issuers {
+		if i.Issuer == "" {
+			return nil, errors.New("invalid issuer: issuer is required")
+		}
+		if i.JWKS == "" {
+			return nil, fmt.Errorf("invalid issuer %q: jwks is required", i.Issuer)
+		}
+		if _, ok := a.issuers[i.Issuer]; ok {
+			return nil, fmt.Errorf("invalid issuer %q: issuers must be unique", i.Issuer)
+		}
+		if i.PrincipalClaim == "" {
+			i.PrincipalClaim = "sub"
+		}
+		a.issuers[i.Issuer] = &issuer{Issuer: i, keys: newKeySet(i.JWKS)}
+	}
+	return a, nil
+}
+
+// Authenticate returns the principal identified by a token.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (string, error) {
+	tok, err := jwt.ParseSigned(token)
+	if err != nil {
+		return "", fmt.Errorf("malformed token: %s", err)
+	}
+	var unverified jwt.Claims
+	if err := tok.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return "", fmt.Errorf("malformed token claims: %s", err)
+	}
+	iss, ok := a.issuers[unverified.Issuer]
+	if !ok {
+		return "", fmt.Errorf("untrusted issuer %q", unverified.Issuer)
+	}
+
+	kid := ""
+	if len(tok.Headers) > 0 {
+		kid = tok.Headers[0].KeyID
+	}
+	keys, err := iss.keys.lookup(ctx, kid)
+	if err != nil {
+		return "", err
+	}
+	var (
+		claims jwt.Claims
+		extra  map[string]interface{}
+	)
+	verified := false
+	for _, key := range keys {
+		if err := tok.Claims(key.Key, &claims, &extra); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return "", errors.New("invalid token signature")
+	}
+
+	if claims.Expiry == nil {
+		return "", errors.New("token has no expiration time")
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Issuer: iss.Issuer.Issuer, Time: a.now()}, leeway); err != nil {
+		return "", fmt.Errorf("invalid token: %s", err)
+	}
+	if len(iss.Audiences) > 0 && !containsAny(claims.Audience, iss.Audiences) {
+		return "", fmt.Errorf("invalid token: audience %v is not accepted", []string(claims.Audience))
+	}
+
+	principal, ok := extra[iss.PrincipalClaim].(string)
+	if !ok || principal == "" {
+		return "", fmt.Errorf("invalid token: claim %q is required", iss.PrincipalClaim)
+	}
+	return principal, nil
+}
+
+func containsAny(audience jwt.Audience, accepted []string) bool {
+	for _, a := range accepted {
+		if audience.Contains(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate returns a context that identifies the caller of a call.
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if token == "" {
+		if a.allowUnauthenticated {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "a bearer token is required")
+	}
+	principal, err := a.Authenticate(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return authz.NewContext(ctx, principal), nil
+}
+
+// bearerToken returns the token of the authorization header of a call, or "" if the call has none.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", errors.New("authorization header must contain a bearer token")
+	}
+	return token, nil
+}
+
+// UnaryInterceptor returns a gRPC server interceptor that authenticates calls.
+// Principals are added to the contexts of calls, where authz.FromContext returns them.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns a gRPC server interceptor that authenticates streaming calls.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(srv, ss)
+		}
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
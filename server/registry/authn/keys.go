@@ -0,0 +1,153 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+)
+
+const (
+	// keysMaxAge is the time after which key sets are loaded again.
+	keysMaxAge = time.Hour
+	// keysMinAge is the time before which key sets aren't loaded again,
+	// even if they don't contain the key of a token.
+	keysMinAge = time.Minute
+)
+
+// keySet is a JSON Web Key Set that is loaded from a file or a URL.
+// It is loaded again when it gets old or doesn't contain a key
+// that is used to sign a token, so rotated keys are used.
+type keySet struct {
+	source string
+	client *http.Client
+
+	mu     sync.Mutex
+	keys   *jose.JSONWebKeySet
+	loaded time.Time // The time of the last attempt to load the keys.
+	err    error     // The error of the last attempt, if it failed.
+	// loading is closed when the current attempt ends, or nil if keys aren't being loaded.
+	loading chan struct{}
+}
+
+func newKeySet(source string) *keySet {
+	return &keySet{
+		source: source,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// lookup returns the keys with an ID, or all keys if the ID is empty.
+// Keys are loaded by one caller at a time and without holding the lock,
+// so other lookups wait for the load or use the keys that were loaded before.
+func (s *keySet) lookup(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	s.mu.Lock()
+	if s.stale(kid) {
+		if s.loading == nil {
+			done := make(chan struct{})
+			s.loading = done
+			s.mu.Unlock()
+			keys, err := s.load(ctx)
+			s.mu.Lock()
+			// If loading fails, the keys that were loaded before are used until the next attempt.
+			if err == nil {
+				s.keys = keys
+			}
+			s.err = err
+			// Attempts that are canceled by their callers don't delay the next attempt.
+			if ctx.Err() == nil {
+				s.loaded = time.Now()
+			}
+			s.loading = nil
+			close(done)
+		} else if s.keys == nil {
+			loading := s.loading
+			s.mu.Unlock()
+			select {
+			case <-loading:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			s.mu.Lock()
+		}
+	}
+	defer s.mu.Unlock()
+	// Until keys are loaded, failed attempts are only repeated after keysMinAge.
+	if s.keys == nil {
+		return nil, s.err
+	}
+	return s.find(kid), nil
+}
+
+// stale returns true if the keys should be loaded again to look up a key.
+func (s *keySet) stale(kid string) bool {
+	age := time.Since(s.loaded)
+	return s.loaded.IsZero() || age > keysMaxAge || (age > keysMinAge && len(s.find(kid)) == 0)
+}
+
+func (s *keySet) find(kid string) []jose.JSONWebKey {
+	if s.keys == nil {
+		return nil
+	}
+	if kid == "" {
+		return s.keys.Keys
+	}
+	return s.keys.Key(kid)
+}
+
+func (s *keySet) load(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if strings.HasPrefix(s.source, "https://") || strings.HasPrefix(s.source, "http://") {
+		b, err = s.fetch(ctx)
+	} else {
+		b, err = os.ReadFile(s.source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keys from %s: %s", s.source, err)
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(b, keys); err != nil {
+		return nil, fmt.Errorf("failed to parse keys from %s: %s", s.source, err)
+	}
+	return keys, nil
+}
+
+func (s *keySet) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authz authorizes calls to the registry with roles that are
+// granted to callers for projects and, optionally, for single APIs.
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Role is a set of permissions. Each role includes the permissions of the roles below it.
+type Role int
+
+const (
+	// None grants no permissions.
+	None Role = iota
+	// Viewer can get and list resources.
+	Viewer
+	// Editor can also create, update and delete resources below projects.
+	Editor
+	// Admin can also create, update and delete projects and administer the server.
+	Admin
+)
+
+// ParseRole returns the role with a name.
+func ParseRole(name string) (Role, error) {
+	switch name {
+	case "viewer":
+		return Viewer, nil
+	case "editor":
+		return Editor, nil
+	case "admin":
+		return Admin, nil
+	default:
+		return None, fmt.Errorf("invalid role %q: must be one of [viewer, editor, admin]", name)
+	}
+}
+
+func (r Role) String() string {
+	switch r {
+	case Viewer:
+		return "viewer"
+	case Editor:
+		return "editor"
+	case Admin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+// AnyCaller is the principal of bindings that apply to all callers,
+// including callers without an identity.
+const AnyCaller = "*"
+
+// Binding grants a role to a caller.
+type Binding struct {
+	// Principal is the identity of the caller, or AnyCaller.
+	Principal string
+	// Role is the role that is granted.
+	Role Role
+	// Project is the ID of the project that the role applies to.
+	// If empty or "*", the role applies to all projects.
+	Project string
+	// API is the ID of the API that the role is limited to.
+	// If empty, the role applies to all APIs of the project.
+	API string
+}
+
+func (b Binding) allProjects() bool {
+	return b.Project == "" || b.Project == "*"
+}
+
+// Policy is a set of bindings.
+type Policy struct {
+	bindings []Binding
+}
+
+// NewPolicy returns a policy with the provided bindings.
+func NewPolicy(bindings []Binding) (*Policy, error) {
+	for _, b := range bindings {
+		if b.Principal == "" {
+			return nil, fmt.Errorf("invalid binding: principal is required")
+		}
+		if b.Role <= None || b.Role > Admin {
+			return nil, fmt.Errorf("invalid binding for %q: role is required", b.Principal)
+		}
+		if b.API != "" && b.allProjects() {
+			return nil, fmt.Errorf("invalid binding for %q: api %q requires a project", b.Principal, b.API)
+		}
+	}
+	return &Policy{bindings: bindings}, nil
+}
+
+// resource identifies the project and API of a resource or collection.
+// Resources that don't belong to a project, such as the server itself, have no project.
+type resource struct {
+	name    string
+	project string
+	api     string
+}
+
+// parseResource returns the resource of a resource or collection name.
+func parseResource(name string) resource {
+	r := resource{name: name}
+	parts := strings.Split(name, "/")
+	if len(parts) >= 2 && parts[0] == "projects" {
+		r.project = parts[1]
+	}
+	if len(parts) >= 6 && parts[4] == "apis" {
+		r.api = parts[5]
+	}
+	return r
+}
+
+// wildcard returns true if the resource is a collection that spans projects or APIs.
+func (r resource) wildcard() bool {
+	return r.project == "" || r.project == "-" || r.api == "-"
+}
+
+// role returns the highest role that a caller has for a resource.
+func (p *Policy) role(caller string, r resource) Role {
+	role := None
+	for _, b := range p.bindings {
+		if b.Principal != AnyCaller && b.Principal != caller {
+			continue
+		}
+		if !b.allProjects() && b.Project != r.project {
+			continue
+		}
+		if b.API != "" && b.API != r.api {
+			continue
+		}
+		if b.Role > role {
+			role = b.Role
+		}
+	}
+	return role
+}
+
+// visible returns true if a caller can see a resource. Callers can see
+// projects that contain APIs they can see, even if they can't see the rest.
+func (p *Policy) visible(caller string, r resource) bool {
+	if p.role(caller, r) >= Viewer {
+		return true
+	}
+	return r.name == "projects/"+r.project && p.boundInProject(caller, r.project)
+}
+
+// boundInProject returns true if a caller has a binding for a project or for any of its APIs.
+func (p *Policy) boundInProject(caller, project string) bool {
+	if project == "" {
+		return false
+	}
+	for _, b := range p.bindings {
+		if (b.Principal == AnyCaller || b.Principal == caller) && b.Project == project {
+			return true
+		}
+	}
+	return false
+}
+
+// callerKey is an unexported type used to attach callers as context values.
+type callerKey struct{}
+
+// NewContext returns a new context that identifies a caller.
+// Interceptors that authenticate callers use it to pass their identities to the authorization interceptors.
+func NewContext(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// FromContext returns the caller that is identified by a context.
+func FromContext(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+	return caller, ok
+}
@@ -0,0 +1,505 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func testPolicy(t *testing.T) *Policy {
+	t.Helper()
+	p, err := NewPolicy([]Binding{
+		{Principal: "admin@example.com", Role: Admin},
+		{Principal: "editor@example.com", Role: Editor, Project: "my-project"},
+		{Principal: "api-editor@example.com", Role: Editor, Project: "my-project", API: "petstore"},
+		{Principal: AnyCaller, Role: Viewer, Project: "public"},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy() returned error: %s", err)
+	}
+	return p
+}
+
+func TestNewPolicy(t *testing.T) {
+	tests := []struct {
+		desc    string
+		binding Binding
+	}{
+		{
+			desc:    "missing principal",
+			binding: Binding{Role: Viewer},
+		},
+		{
+			desc:    "missing role",
+			binding: Binding{Principal: "user@example.com"},
+		},
+		{
+			desc:    "api without project",
+			binding: Binding{Principal: "user@example.com", Role: Viewer, API: "petstore"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := NewPolicy([]Binding{test.binding}); err == nil {
+				t.Errorf("NewPolicy(%+v) succeeded, expected error", test.binding)
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	for _, want := range []Role{Viewer, Editor, Admin} {
+		got, err := ParseRole(want.String())
+		if err != nil {
+			t.Errorf("ParseRole(%q) returned error: %s", want, err)
+		}
+		if got != want {
+			t.Errorf("ParseRole(%q) returned %s", want, got)
+		}
+	}
+	if _, err := ParseRole("owner"); err == nil {
+		t.Errorf("ParseRole(%q) succeeded, expected error", "owner")
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		desc   string
+		caller string
+		method string
+		req    interface{}
+		want   codes.Code
+	}{
+		{
+			desc:   "status is public",
+			method: "Admin/GetStatus",
+			req:    &emptypb.Empty{},
+			want:   codes.OK,
+		},
+		{
+			desc:   "admin gets storage",
+			caller: "admin@example.com",
+			method: "Admin/GetStorage",
+			req:    &emptypb.Empty{},
+			want:   codes.OK,
+		},
+		{
+			desc:   "project editor gets storage",
+			caller: "editor@example.com",
+			method: "Admin/GetStorage",
+			req:    &emptypb.Empty{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "admin lists audit entries",
+			caller: "admin@example.com",
+			method: "Admin/ListAuditEntries",
+			req:    &rpc.ListAuditEntriesRequest{},
+			want:   codes.OK,
+		},
+		{
+			desc:   "project editor lists audit entries",
+			caller: "editor@example.com",
+			method: "Admin/ListAuditEntries",
+			req:    &rpc.ListAuditEntriesRequest{},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "admin deletes project",
+			caller: "admin@example.com",
+			method: "Admin/DeleteProject",
+			req:    &rpc.DeleteProjectRequest{Name: "projects/my-project"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "editor deletes project",
+			caller: "editor@example.com",
+			method: "Admin/DeleteProject",
+			req:    &rpc.DeleteProjectRequest{Name: "projects/my-project"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "unidentified caller deletes project",
+			method: "Admin/DeleteProject",
+			req:    &rpc.DeleteProjectRequest{Name: "projects/my-project"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "editor gets project quota",
+			caller: "editor@example.com",
+			method: "Admin/GetProjectQuota",
+			req:    &rpc.GetProjectQuotaRequest{Name: "projects/my-project"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "editor gets quota of other project",
+			caller: "editor@example.com",
+			method: "Admin/GetProjectQuota",
+			req:    &rpc.GetProjectQuotaRequest{Name: "projects/other-project"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "admin creates project",
+			caller: "admin@example.com",
+			method: "Admin/CreateProject",
+			req:    &rpc.CreateProjectRequest{ProjectId: "new-project"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "editor exports project",
+			caller: "editor@example.com",
+			method: "Admin/ExportProject",
+			req:    &rpc.ExportProjectRequest{Name: "projects/my-project"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "admin imports project",
+			caller: "admin@example.com",
+			method: "Admin/ImportProject",
+			req:    &rpc.ImportProjectRequest{ProjectId: "new-project"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "editor creates api",
+			caller: "editor@example.com",
+			method: "Registry/CreateApi",
+			req:    &rpc.CreateApiRequest{Parent: "projects/my-project/locations/global", ApiId: "other"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "editor creates api in other project",
+			caller: "editor@example.com",
+			method: "Registry/CreateApi",
+			req:    &rpc.CreateApiRequest{Parent: "projects/other-project/locations/global", ApiId: "other"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "api editor creates its api",
+			caller: "api-editor@example.com",
+			method: "Registry/CreateApi",
+			req:    &rpc.CreateApiRequest{Parent: "projects/my-project/locations/global", ApiId: "petstore"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "api editor creates other api",
+			caller: "api-editor@example.com",
+			method: "Registry/CreateApi",
+			req:    &rpc.CreateApiRequest{Parent: "projects/my-project/locations/global", ApiId: "other"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "api editor updates spec of its api",
+			caller: "api-editor@example.com",
+			method: "Registry/UpdateApiSpec",
+			req: &rpc.UpdateApiSpecRequest{ApiSpec: &rpc.ApiSpec{
+				Name: "projects/my-project/locations/global/apis/petstore/versions/v1/specs/openapi",
+			}},
+			want: codes.OK,
+		},
+		{
+			desc:   "api editor updates spec of other api",
+			caller: "api-editor@example.com",
+			method: "Registry/UpdateApiSpec",
+			req: &rpc.UpdateApiSpecRequest{ApiSpec: &rpc.ApiSpec{
+				Name: "projects/my-project/locations/global/apis/other/versions/v1/specs/openapi",
+			}},
+			want: codes.PermissionDenied,
+		},
+		{
+			desc:   "api editor creates project artifact",
+			caller: "api-editor@example.com",
+			method: "Registry/CreateArtifact",
+			req:    &rpc.CreateArtifactRequest{Parent: "projects/my-project/locations/global", ArtifactId: "a"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "api editor batch updates its api",
+			caller: "api-editor@example.com",
+			method: "Registry/BatchUpdateApis",
+			req: &rpc.BatchUpdateApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Requests: []*rpc.UpdateApiRequest{
+					{Api: &rpc.Api{Name: "projects/my-project/locations/global/apis/petstore"}},
+				},
+			},
+			want: codes.OK,
+		},
+		{
+			desc:   "api editor batch updates other api",
+			caller: "api-editor@example.com",
+			method: "Registry/BatchUpdateApis",
+			req: &rpc.BatchUpdateApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Requests: []*rpc.UpdateApiRequest{
+					{Api: &rpc.Api{Name: "projects/my-project/locations/global/apis/petstore"}},
+					{Api: &rpc.Api{Name: "projects/my-project/locations/global/apis/other"}},
+				},
+			},
+			want: codes.PermissionDenied,
+		},
+		{
+			desc:   "any caller gets public api",
+			method: "Registry/GetApi",
+			req:    &rpc.GetApiRequest{Name: "projects/public/locations/global/apis/petstore"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "any caller deletes public api",
+			method: "Registry/DeleteApi",
+			req:    &rpc.DeleteApiRequest{Name: "projects/public/locations/global/apis/petstore"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "api editor lists apis",
+			caller: "api-editor@example.com",
+			method: "Registry/ListApis",
+			req:    &rpc.ListApisRequest{Parent: "projects/my-project/locations/global"},
+			want:   codes.OK,
+		},
+		{
+			desc:   "api editor lists project artifacts",
+			caller: "api-editor@example.com",
+			method: "Registry/ListArtifacts",
+			req:    &rpc.ListArtifactsRequest{Parent: "projects/my-project/locations/global"},
+			want:   codes.PermissionDenied,
+		},
+		{
+			desc:   "editor lists apis of other project",
+			caller: "editor@example.com",
+			method: "Registry/ListApis",
+			req:    &rpc.ListApisRequest{Parent: "projects/other-project/locations/global"},
+			want:   codes.PermissionDenied,
+		},
+	}
+	interceptor := UnaryInterceptor(testPolicy(t))
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			if test.caller != "" {
+				ctx = NewContext(ctx, test.caller)
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: servicePrefix + test.method}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &emptypb.Empty{}, nil
+			}
+			_, err := interceptor(ctx, test.req, info, handler)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("%s returned %s, expected %s: %v", test.method, got, test.want, err)
+			}
+		})
+	}
+}
+
+func TestUnaryInterceptorOtherServices(t *testing.T) {
+	interceptor := UnaryInterceptor(testPolicy(t))
+	info := &grpc.UnaryServerInfo{FullMethod: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	if _, err := interceptor(context.Background(), &emptypb.Empty{}, info, handler); err != nil {
+		t.Errorf("interceptor returned error for other service: %s", err)
+	}
+}
+
+func TestListFiltering(t *testing.T) {
+	tests := []struct {
+		desc   string
+		caller string
+		method string
+		req    interface{}
+		resp   interface{}
+		want   interface{}
+	}{
+		{
+			desc:   "admin lists projects",
+			caller: "admin@example.com",
+			method: "Admin/ListProjects",
+			req:    &rpc.ListProjectsRequest{},
+			resp: &rpc.ListProjectsResponse{Projects: []*rpc.Project{
+				{Name: "projects/my-project"},
+				{Name: "projects/other-project"},
+				{Name: "projects/public"},
+			}},
+			want: &rpc.ListProjectsResponse{Projects: []*rpc.Project{
+				{Name: "projects/my-project"},
+				{Name: "projects/other-project"},
+				{Name: "projects/public"},
+			}},
+		},
+		{
+			desc:   "api editor lists projects",
+			caller: "api-editor@example.com",
+			method: "Admin/ListProjects",
+			req:    &rpc.ListProjectsRequest{},
+			resp: &rpc.ListProjectsResponse{Projects: []*rpc.Project{
+				{Name: "projects/my-project"},
+				{Name: "projects/other-project"},
+				{Name: "projects/public"},
+			}},
+			want: &rpc.ListProjectsResponse{Projects: []*rpc.Project{
+				{Name: "projects/my-project"},
+				{Name: "projects/public"},
+			}},
+		},
+		{
+			desc:   "api editor lists apis",
+			caller: "api-editor@example.com",
+			method: "Registry/ListApis",
+			req:    &rpc.ListApisRequest{Parent: "projects/my-project/locations/global"},
+			resp: &rpc.ListApisResponse{Apis: []*rpc.Api{
+				{Name: "projects/my-project/locations/global/apis/other"},
+				{Name: "projects/my-project/locations/global/apis/petstore"},
+			}, NextPageToken: "next"},
+			want: &rpc.ListApisResponse{Apis: []*rpc.Api{
+				{Name: "projects/my-project/locations/global/apis/petstore"},
+			}, NextPageToken: "next"},
+		},
+		{
+			desc:   "api editor lists artifacts in all projects",
+			caller: "api-editor@example.com",
+			method: "Registry/ListArtifacts",
+			req:    &rpc.ListArtifactsRequest{Parent: "projects/-/locations/global"},
+			resp: &rpc.ListArtifactsResponse{Artifacts: []*rpc.Artifact{
+				{Name: "projects/my-project/locations/global/artifacts/a"},
+				{Name: "projects/my-project/locations/global/apis/petstore/artifacts/a"},
+			}},
+			want: &rpc.ListArtifactsResponse{Artifacts: []*rpc.Artifact{
+				{Name: "projects/my-project/locations/global/apis/petstore/artifacts/a"},
+			}},
+		},
+		{
+			desc:   "editor lists specs in all projects",
+			caller: "editor@example.com",
+			method: "Registry/ListApiSpecs",
+			req:    &rpc.ListApiSpecsRequest{Parent: "projects/-/locations/global/apis/-/versions/-"},
+			resp: &rpc.ListApiSpecsResponse{ApiSpecs: []*rpc.ApiSpec{
+				{Name: "projects/my-project/locations/global/apis/a/versions/v1/specs/s"},
+				{Name: "projects/other-project/locations/global/apis/a/versions/v1/specs/s"},
+			}},
+			want: &rpc.ListApiSpecsResponse{ApiSpecs: []*rpc.ApiSpec{
+				{Name: "projects/my-project/locations/global/apis/a/versions/v1/specs/s"},
+			}},
+		},
+		{
+			desc:   "any caller searches public project",
+			method: "Registry/SearchResources",
+			req:    &rpc.SearchResourcesRequest{Parent: "projects/public/locations/global"},
+			resp: &rpc.SearchResourcesResponse{Results: []*rpc.SearchResourcesResponse_Result{
+				{Name: "projects/public/locations/global/apis/a"},
+			}},
+			want: &rpc.SearchResourcesResponse{Results: []*rpc.SearchResourcesResponse_Result{
+				{Name: "projects/public/locations/global/apis/a"},
+			}},
+		},
+	}
+	interceptor := UnaryInterceptor(testPolicy(t))
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			if test.caller != "" {
+				ctx = NewContext(ctx, test.caller)
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: servicePrefix + test.method}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return test.resp, nil
+			}
+			got, err := interceptor(ctx, test.req, info, handler)
+			if err != nil {
+				t.Fatalf("%s returned error: %s", test.method, err)
+			}
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("%s returned unexpected diff (-want +got):\n%s", test.method, diff)
+			}
+		})
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  *rpc.WatchResourcesRequest
+	sent []*rpc.Notification
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) RecvMsg(m interface{}) error {
+	*m.(*rpc.WatchResourcesRequest) = rpc.WatchResourcesRequest{Parent: s.req.Parent}
+	return nil
+}
+
+func (s *testStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m.(*rpc.Notification))
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	interceptor := StreamInterceptor(testPolicy(t))
+	info := &grpc.StreamServerInfo{FullMethod: servicePrefix + "Registry/WatchResources", IsServerStream: true}
+	notifications := []*rpc.Notification{
+		{Resource: "projects/my-project/locations/global/apis/petstore"},
+		{Resource: "projects/my-project/locations/global/apis/other"},
+	}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		req := &rpc.WatchResourcesRequest{}
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if err := ss.SendMsg(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("api editor watches project", func(t *testing.T) {
+		ss := &testStream{
+			ctx: NewContext(context.Background(), "api-editor@example.com"),
+			req: &rpc.WatchResourcesRequest{Parent: "projects/my-project/locations/global"},
+		}
+		if err := interceptor(nil, ss, info, handler); err != nil {
+			t.Fatalf("WatchResources returned error: %s", err)
+		}
+		if diff := cmp.Diff(notifications[:1], ss.sent, protocmp.Transform()); diff != "" {
+			t.Errorf("WatchResources sent unexpected diff (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unidentified caller watches project", func(t *testing.T) {
+		ss := &testStream{
+			ctx: context.Background(),
+			req: &rpc.WatchResourcesRequest{Parent: "projects/my-project/locations/global"},
+		}
+		err := interceptor(nil, ss, info, handler)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("WatchResources returned %v, expected %s", err, codes.PermissionDenied)
+		}
+		if len(ss.sent) > 0 {
+			t.Errorf("WatchResources sent %d notifications, expected none", len(ss.sent))
+		}
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/apigee/registry/pkg/tlsconfig"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// servicePrefix is the prefix of the methods that are authorized.
+// Methods of other services, such as server reflection, are not.
+const servicePrefix = "/google.cloud.apigeeregistry.v1."
+
+// UnaryInterceptor returns a gRPC server interceptor that authorizes calls with a policy.
+// Responses of List and Search calls only include the resources that callers can see,
+// so pages can be smaller than the requested page size.
+func UnaryInterceptor(p *Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+		caller := Identify(ctx)
+		method := path.Base(info.FullMethod)
+		if err := p.authorize(caller, method, req); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if m, ok := resp.(proto.Message); err == nil && ok && collectionMethod(method) {
+			p.filter(caller, m.ProtoReflect())
+		}
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns a gRPC server interceptor that authorizes streaming calls with a policy.
+// Calls are authorized when their first request is received. Notifications sent by
+// WatchResources calls are only sent if callers can see their resources.
+func StreamInterceptor(p *Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			policy:       p,
+			caller:       Identify(ss.Context()),
+			method:       path.Base(info.FullMethod),
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	policy     *Policy
+	caller     string
+	method     string
+	authorized bool
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.authorized {
+		if err := s.policy.authorize(s.caller, s.method, m); err != nil {
+			return err
+		}
+		s.authorized = true
+	}
+	return nil
+}
+
+func (s *authorizedStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok && collectionMethod(s.method) {
+		if name := stringField(msg.ProtoReflect(), "resource"); name != "" && !s.policy.visible(s.caller, parseResource(name)) {
+			return nil
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// Identify returns the identity of the caller of a call. Callers are identified by interceptors that
+// authenticate them or by their TLS client certificates. Callers that aren't identified have empty identities.
+func Identify(ctx context.Context) string {
+	if caller, ok := FromContext(ctx); ok {
+		return caller
+	}
+	return tlsconfig.PeerIdentity(ctx)
+}
+
+// requiredRole returns the role that callers need to call a method.
+func requiredRole(method string) Role {
+	switch method {
+	case "GetStatus":
+		return None
+	case "GetStorage", "MigrateDatabase", "ReplayNotifications", "ListAuditEntries",
+		"CreateProject", "UpdateProject", "DeleteProject", "ExportProject", "ImportProject",
+		"CreateInstance", "DeleteInstance":
+		return Admin
+	}
+	for _, prefix := range []string{"Get", "BatchGet", "List", "Search", "Watch", "Stream"} {
+		if strings.HasPrefix(method, prefix) {
+			return Viewer
+		}
+	}
+	return Editor
+}
+
+// collectionMethod returns true if a method returns the resources of a collection.
+// Collections are filtered instead of requiring a role for the whole collection,
+// except for the collections of administration methods, such as the audit log.
+func collectionMethod(method string) bool {
+	if requiredRole(method) == Admin {
+		return false
+	}
+	return strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Search") || strings.HasPrefix(method, "Watch")
+}
+
+// authorize returns an error if a caller can't call a method with a request.
+func (p *Policy) authorize(caller, method string, req interface{}) error {
+	role := requiredRole(method)
+	if role == None {
+		return nil
+	}
+	m, ok := req.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s role", method, role)
+	}
+	if collectionMethod(method) {
+		r := parseResource(stringField(m.ProtoReflect(), "parent"))
+		if r.wildcard() || p.visible(caller, r) {
+			return nil
+		}
+		// Callers can list and watch the APIs of projects that contain APIs they can see.
+		if (method == "ListApis" || strings.HasPrefix(method, "Watch")) && p.boundInProject(caller, r.project) {
+			return nil
+		}
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s role for %q", method, role, r.name)
+	}
+	for _, r := range resources(m.ProtoReflect()) {
+		if p.role(caller, r) < role {
+			if r.project == "" {
+				return status.Errorf(codes.PermissionDenied, "%s requires the %s role for all projects", method, role)
+			}
+			return status.Errorf(codes.PermissionDenied, "%s requires the %s role for %q", method, role, r.name)
+		}
+	}
+	return nil
+}
+
+// resources returns the resources that a request reads or writes.
+func resources(m protoreflect.Message) []resource {
+	fields := m.Descriptor().Fields()
+
+	// Batch requests are authorized for each of their resources.
+	if f := fields.ByName("requests"); f != nil && f.IsList() && f.Message() != nil && m.Get(f).List().Len() > 0 {
+		var rs []resource
+		list := m.Get(f).List()
+		for i := 0; i < list.Len(); i++ {
+			rs = append(rs, resources(list.Get(i).Message())...)
+		}
+		return rs
+	}
+	if f := fields.ByName("names"); f != nil && f.IsList() && f.Kind() == protoreflect.StringKind && m.Get(f).List().Len() > 0 {
+		var rs []resource
+		list := m.Get(f).List()
+		for i := 0; i < list.Len(); i++ {
+			rs = append(rs, parseResource(list.Get(i).String()))
+		}
+		return rs
+	}
+
+	if name := stringField(m, "name"); name != "" {
+		return []resource{parseResource(name)}
+	}
+
+	// Create requests name the parents and IDs of new resources.
+	if parent := stringField(m, "parent"); parent != "" {
+		r := parseResource(parent)
+		if id := stringField(m, "api_id"); id != "" && r.api == "" {
+			r.api = id
+			r.name = parent + "/apis/" + id
+		}
+		return []resource{r}
+	}
+	if id := stringField(m, "project_id"); id != "" {
+		return []resource{parseResource("projects/" + id)}
+	}
+
+	// Update and upload requests contain the resources that they write.
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.Kind() != protoreflect.MessageKind || f.IsList() || f.IsMap() || !m.Has(f) {
+			continue
+		}
+		if name := stringField(m.Get(f).Message(), "name"); name != "" {
+			return []resource{parseResource(name)}
+		}
+	}
+
+	// Requests without resources act on the whole server.
+	return []resource{{}}
+}
+
+// filter removes the resources that a caller can't see from the lists in a response.
+func (p *Policy) filter(caller string, m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if !f.IsList() || f.Message() == nil || f.Message().Fields().ByName("name") == nil || !m.Has(f) {
+			continue
+		}
+		list := m.Mutable(f).List()
+		n := 0
+		for j := 0; j < list.Len(); j++ {
+			v := list.Get(j)
+			if p.visible(caller, parseResource(stringField(v.Message(), "name"))) {
+				list.Set(n, v)
+				n++
+			}
+		}
+		list.Truncate(n)
+	}
+}
+
+// stringField returns the value of a string field of a message, or "" if the message has no such field.
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	f := m.Descriptor().Fields().ByName(name)
+	if f == nil || f.Kind() != protoreflect.StringKind || f.IsList() {
+		return ""
+	}
+	return m.Get(f).String()
+}
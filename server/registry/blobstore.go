@@ -0,0 +1,69 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/server/registry/internal/storage/blobstore"
+)
+
+// BlobStoreConfig configures an external store for the contents of spec
+// revisions and artifacts. The database keeps only their hashes, sizes and
+// locations. Contents that are already in the database can be moved to the
+// store with MigrateDatabase using the "blobs" kind.
+type BlobStoreConfig struct {
+	// Type of the store: "filesystem" or "s3".
+	// If empty or "database", contents are stored in the database.
+	Type string
+	// Path of the directory of a filesystem store.
+	Path string
+	// Endpoint of an S3-compatible service, such as "http://localhost:9000".
+	// If empty, the AWS endpoint of the region is used.
+	Endpoint string
+	// Region of an S3 bucket (default "us-east-1").
+	Region string
+	// Bucket of an S3 store. It must already exist.
+	Bucket string
+	// Prefix of the names of objects in an S3 bucket.
+	Prefix string
+	// Credentials used to sign S3 requests. If empty, requests are unsigned.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// newBlobStore returns the configured store, or nil if contents are stored in the database.
+func newBlobStore(config BlobStoreConfig) (blobstore.Store, error) {
+	switch config.Type {
+	case "", "database":
+		return nil, nil
+	case "filesystem":
+		if config.Path == "" {
+			return nil, fmt.Errorf("filesystem blob store requires a path")
+		}
+		return blobstore.NewFilesystem(config.Path)
+	case "s3":
+		return blobstore.NewS3(blobstore.S3Config{
+			Endpoint:        config.Endpoint,
+			Region:          config.Region,
+			Bucket:          config.Bucket,
+			Prefix:          config.Prefix,
+			AccessKeyID:     config.AccessKeyID,
+			SecretAccessKey: config.SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob store type %q", config.Type)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const blobStoreSpec = "projects/my-project/locations/global/apis/a/versions/v1/specs/s"
+
+// blobStoreTestServer returns a server with a database and blob store in a directory.
+func blobStoreTestServer(t *testing.T, dir string, config BlobStoreConfig) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  filepath.Join(dir, "registry.db"),
+		BlobStore: config,
+	})
+	if err != nil {
+		t.Fatalf("Setup: Failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// storedFiles returns the names of the files in a filesystem blob store.
+func storedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Failed to list stored files: %s", err)
+	}
+	return files
+}
+
+func checkSpecContents(t *testing.T, server *RegistryServer, want []byte) {
+	t.Helper()
+	got, err := server.GetApiSpecContents(context.Background(), &rpc.GetApiSpecContentsRequest{Name: blobStoreSpec})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	}
+	if !bytes.Equal(got.GetData(), want) {
+		t.Errorf("GetApiSpecContents() returned %q, want %q", got.GetData(), want)
+	}
+}
+
+func TestFilesystemBlobStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blobs := filepath.Join(dir, "blobs")
+	server := blobStoreTestServer(t, dir, BlobStoreConfig{Type: "filesystem", Path: blobs})
+
+	contents := []byte("openapi: 3.0.0")
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: blobStoreSpec, MimeType: "application/x.openapi", Contents: contents},
+		&rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/copy", MimeType: "text/plain", Contents: contents},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if files := storedFiles(t, blobs); len(files) != 1 {
+		t.Errorf("Stored files are %v, want one copy of the contents", files)
+	}
+	checkSpecContents(t, server, contents)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: "projects/my-project/locations/global/apis/a", Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if files := storedFiles(t, blobs); len(files) != 1 {
+		t.Errorf("Stored files are %v while the artifact uses the contents, want one", files)
+	}
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: "projects/my-project/locations/global/artifacts/copy"}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	if files := storedFiles(t, blobs); len(files) != 0 {
+		t.Errorf("Stored files are %v after the contents were deleted, want none", files)
+	}
+}
+
+func TestBlobStoreTransactions(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blobs := filepath.Join(dir, "blobs")
+	server := blobStoreTestServer(t, dir, BlobStoreConfig{Type: "filesystem", Path: blobs})
+	if err := seeder.SeedRegistry(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	name, err := names.ParseArtifact("projects/my-project/locations/global/artifacts/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := []byte("contents")
+
+	// Contents that were stored by a transaction that is rolled back are deleted.
+	err = server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		artifact, err := models.NewArtifact(name, &rpc.Artifact{MimeType: "text/plain"})
+		if err != nil {
+			return err
+		}
+		if err := db.CreateArtifact(ctx, artifact); err != nil {
+			return err
+		}
+		if err := db.SaveArtifactContents(ctx, artifact, contents); err != nil {
+			return err
+		}
+		if files := storedFiles(t, blobs); len(files) != 1 {
+			t.Errorf("Stored files are %v in the transaction, want one", files)
+		}
+		return errors.New("rollback")
+	})
+	if err == nil {
+		t.Fatal("runInTransaction() succeeded, expected an error")
+	}
+	if files := storedFiles(t, blobs); len(files) != 0 {
+		t.Errorf("Stored files are %v after the transaction was rolled back, want none", files)
+	}
+
+	// Contents can be stored again after they are deleted.
+	for i := 0; i < 2; i++ {
+		if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+			Parent:     name.Parent(),
+			ArtifactId: name.ArtifactID(),
+			Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: contents},
+		}); err != nil {
+			t.Fatalf("CreateArtifact() returned error: %s", err)
+		}
+		got, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name.String()})
+		if err != nil {
+			t.Fatalf("GetArtifactContents() returned error: %s", err)
+		}
+		if !bytes.Equal(got.GetData(), contents) {
+			t.Errorf("GetArtifactContents() returned %q, want %q", got.GetData(), contents)
+		}
+		if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: name.String()}); err != nil {
+			t.Fatalf("DeleteArtifact() returned error: %s", err)
+		}
+		if files := storedFiles(t, blobs); len(files) != 0 {
+			t.Errorf("Stored files are %v after the artifact was deleted, want none", files)
+		}
+	}
+}
+
+func TestMigrateDatabaseBlobs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blobs := filepath.Join(dir, "blobs")
+
+	// Contents are stored in the database when no blob store is configured.
+	server, err := New(Config{Database: "sqlite3", DBConfig: filepath.Join(dir, "registry.db")})
+	if err != nil {
+		t.Fatalf("Setup: Failed to create server: %s", err)
+	}
+	contents := []byte("openapi: 3.0.0")
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: blobStoreSpec, MimeType: "application/x.openapi", Contents: contents},
+	); err != nil {
+		server.Close()
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{Kind: "blobs"}); err == nil {
+		t.Errorf("MigrateDatabase(kind=blobs) without a blob store succeeded, expected an error")
+	}
+	server.Close()
+
+	// The contents are moved when a blob store is configured.
+	server = blobStoreTestServer(t, dir, BlobStoreConfig{Type: "filesystem", Path: blobs})
+	checkSpecContents(t, server, contents)
+	op, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{Kind: "blobs"})
+	if err != nil {
+		t.Fatalf("MigrateDatabase(kind=blobs) returned error: %s", err)
+	}
+	response := new(rpc.MigrateDatabaseResponse)
+	if err := anypb.UnmarshalTo(op.GetResponse(), response, proto.UnmarshalOptions{}); err != nil {
+		t.Fatalf("MigrateDatabase(kind=blobs) returned unexpected response: %s", err)
+	}
+	if want := "Moved 1 blob contents to the blob store"; response.GetMessage() != want {
+		t.Errorf("MigrateDatabase(kind=blobs) returned message %q, want %q", response.GetMessage(), want)
+	}
+	if files := storedFiles(t, blobs); len(files) != 1 {
+		t.Errorf("Stored files are %v after migration, want one", files)
+	}
+	checkSpecContents(t, server, contents)
+}
+
+func TestNewBlobStoreErrors(t *testing.T) {
+	for _, config := range []BlobStoreConfig{
+		{Type: "unknown"},
+		{Type: "filesystem"},
+		{Type: "s3"},
+	} {
+		if _, err := newBlobStore(config); err == nil {
+			t.Errorf("newBlobStore(%+v) succeeded, expected an error", config)
+		}
+	}
+}
@@ -16,7 +16,9 @@ package registry
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/apigee/registry/rpc"
@@ -207,3 +209,41 @@ func TestConcurrentApiDeploymentUpdates(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+// BenchmarkParallelWrites measures the throughput of concurrent writes to one project and
+// to many projects. Writes that lock the same tables of a project wait for each other,
+// while writes to different projects only contend for the database itself.
+func BenchmarkParallelWrites(b *testing.B) {
+	if adminServiceUnavailable() {
+		b.Skip(testRequiresAdminService)
+	}
+	for _, projects := range []int{1, concurrency} {
+		b.Run(fmt.Sprintf("projects=%d", projects), func(b *testing.B) {
+			ctx := context.Background()
+			server := defaultTestServer(b)
+			for i := 0; i < projects; i++ {
+				if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: fmt.Sprintf("projects/p%d", i)}); err != nil {
+					b.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+				}
+			}
+			var count int64
+			b.SetParallelism(concurrency)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := atomic.AddInt64(&count, 1)
+					req := &rpc.UpdateApiRequest{
+						Api: &rpc.Api{
+							Name:        fmt.Sprintf("projects/p%d/locations/global/apis/a%d", i%int64(projects), i),
+							DisplayName: "sample",
+						},
+						AllowMissing: true,
+					}
+					if _, err := server.UpdateApi(ctx, req); !isRetryable(status.Code(err)) {
+						b.Errorf("UpdateApi(%+v), wanted a retryable status code, got %q", req, status.Code(err))
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+const (
+	// dispatchBatchSize is the maximum number of events read from the outbox at once.
+	dispatchBatchSize = 100
+	// dispatchInterval is the time between checks of the outbox when the dispatcher
+	// has not been woken. Periodic checks pick up events written by other server
+	// instances and retry deliveries that failed.
+	dispatchInterval = time.Second
+	// dispatchLease is how long a dispatcher's claim of events lasts. If the
+	// events haven't been dispatched by then, another dispatcher can claim them.
+	dispatchLease = 5 * time.Minute
+	// watchLookback is the number of sequence numbers below the latest event
+	// sent to watchers that are read again, so that events of transactions
+	// that commit out of sequence order are still sent.
+	watchLookback = 100
+	// eventRetention is how long dispatched events are kept in the outbox,
+	// which is how far back ReplayNotifications can go.
+	eventRetention = 24 * time.Hour
+	// eventCleanupInterval is the time between deletions of expired events.
+	eventCleanupInterval = time.Hour
+)
+
+// dispatcher delivers notifications from the outbox to watchers and sinks.
+// Delivery to sinks is at-least-once: an event is marked as dispatched only
+// after all sinks have accepted it, so sinks may see an event more than once.
+// Dispatchers of servers that share a database claim events before delivering
+// them, so each event is normally delivered to sinks by only one server.
+// Every server sends all events to its own watchers.
+type dispatcher struct {
+	id     string
+	wakeup chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+
+	// watchCursor is the highest sequence number of the events that have
+	// been sent to watchers or skipped. watched holds
+	// the sequence numbers of the events above watchCursor-watchLookback
+	// that have been sent, and events at or below watchStart are never sent.
+	// They are only used by the dispatching goroutine.
+	watchCursor int64
+	watchStart  int64
+	watched     map[int64]bool
+	lastCleanup time.Time
+
+	// failure is the error of the latest delivery if it failed, and
+	// failingSince is the time of the first of the failed deliveries since
+	// the last successful one.
+	mu           sync.Mutex
+	failure      error
+	failingSince time.Time
+}
+
+func newDispatcher() *dispatcher {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return &dispatcher{
+		id:      hex.EncodeToString(id),
+		wakeup:  make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		watched: make(map[int64]bool),
+	}
+}
+
+// wake requests a prompt check of the outbox. It never blocks.
+func (d *dispatcher) wake() {
+	if d == nil {
+		return
+	}
+	select {
+	case d.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// startDispatcher starts delivering notifications in the background.
+func (s *RegistryServer) startDispatcher() {
+	s.dispatcher = newDispatcher()
+	ctx := context.Background()
+	if db, err := s.getStorageClient(ctx); err == nil {
+		s.dispatchToWatchers(ctx, db)
+	}
+	go func() {
+		defer close(s.dispatcher.done)
+		ticker := time.NewTicker(dispatchInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.dispatcher.stop:
+				// Deliver anything that was committed before shutdown.
+				s.dispatch(ctx)
+				return
+			case <-s.dispatcher.wakeup:
+			case <-ticker.C:
+			}
+			s.dispatch(ctx)
+		}
+	}()
+}
+
+// stopDispatcher stops the dispatcher after a final delivery attempt.
+func (s *RegistryServer) stopDispatcher() {
+	if s.dispatcher == nil {
+		return
+	}
+	close(s.dispatcher.stop)
+	<-s.dispatcher.done
+}
+
+// dispatch delivers new events to watchers and pending events to sinks,
+// and deletes expired events.
+func (s *RegistryServer) dispatch(ctx context.Context) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return
+	}
+	s.dispatchToWatchers(ctx, db)
+	if s.hasSinks() {
+		s.dispatchToSinks(ctx, db)
+	}
+	s.deleteExpiredEvents(ctx, db)
+}
+
+// dispatchToWatchers sends the events that were stored since the last call
+// to watchers. While there are no watchers, it skips to the latest event so
+// that watchers don't receive events that were stored before they started.
+func (s *RegistryServer) dispatchToWatchers(ctx context.Context, db *storage.Client) {
+	logger := log.FromContext(ctx)
+	d := s.dispatcher
+	if !s.watchers.active() {
+		latest, err := db.LatestEventSequence(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Failed to read notifications.")
+			return
+		}
+		d.watchCursor, d.watchStart = latest, latest
+		if len(d.watched) > 0 {
+			d.watched = make(map[int64]bool)
+		}
+		return
+	}
+	for {
+		limit := watchLookback + dispatchBatchSize
+		events, err := db.ListEventsAfter(ctx, d.watchCursor-watchLookback, limit)
+		if err != nil {
+			logger.WithError(err).Error("Failed to read notifications.")
+			return
+		}
+		cursor := d.watchCursor
+		for _, event := range events {
+			if event.Sequence <= d.watchStart || d.watched[event.Sequence] {
+				continue
+			}
+			s.watchers.publish(event.Message())
+			d.watched[event.Sequence] = true
+			if event.Sequence > cursor {
+				cursor = event.Sequence
+			}
+		}
+		advanced := cursor > d.watchCursor
+		d.watchCursor = cursor
+		for seq := range d.watched {
+			if seq <= cursor-watchLookback {
+				delete(d.watched, seq)
+			}
+		}
+		if !advanced || len(events) < limit {
+			return
+		}
+	}
+}
+
+// dispatchToSinks delivers pending events to sinks until the outbox is
+// empty, a delivery fails or another dispatcher is delivering them.
+func (s *RegistryServer) dispatchToSinks(ctx context.Context, db *storage.Client) {
+	logger := log.FromContext(ctx)
+	d := s.dispatcher
+	for {
+		now := time.Now()
+		events, err := db.ClaimPendingEvents(ctx, d.id, now, now.Add(dispatchLease), dispatchBatchSize)
+		if err != nil {
+			logger.WithError(err).Error("Failed to read notifications.")
+			return
+		}
+		if len(events) == 0 {
+			d.recordDelivery(nil)
+			return
+		}
+
+		delivered := make([]int64, 0, len(events))
+		var failed bool
+		for _, event := range events {
+			// Stop at the first failure so that events are delivered in order.
+			err := s.publish(ctx, event.Message())
+			d.recordDelivery(err)
+			if err != nil {
+				failed = true
+				break
+			}
+			delivered = append(delivered, event.Sequence)
+		}
+
+		if err := db.MarkEventsDispatched(ctx, delivered); err != nil {
+			logger.WithError(err).Error("Failed to mark notifications as dispatched.")
+			return
+		}
+		if failed {
+			// Retries, by this or another dispatcher, needn't wait for the claims to expire.
+			if err := db.ReleaseEvents(ctx, d.id); err != nil {
+				logger.WithError(err).Error("Failed to release notifications.")
+			}
+			return
+		}
+		if len(events) < dispatchBatchSize {
+			return
+		}
+	}
+}
+
+// deleteExpiredEvents deletes dispatched events that are older than the
+// retention period, at most once per cleanup interval.
+func (s *RegistryServer) deleteExpiredEvents(ctx context.Context, db *storage.Client) {
+	d := s.dispatcher
+	if time.Since(d.lastCleanup) < eventCleanupInterval {
+		return
+	}
+	d.lastCleanup = time.Now()
+	if _, err := db.DeleteDispatchedEvents(ctx, d.lastCleanup.Add(-eventRetention)); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to delete expired notifications.")
+	}
+}
+
+// recordDelivery records the result of a delivery attempt.
+func (d *dispatcher) recordDelivery(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err == nil {
+		d.failure = nil
+		d.failingSince = time.Time{}
+		return
+	}
+	if d.failure == nil {
+		d.failingSince = time.Now()
+	}
+	d.failure = err
+}
+
+// failing returns the error of the latest delivery if deliveries have been
+// failing for at least the specified duration.
+func (d *dispatcher) failing(period time.Duration) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failure == nil || time.Since(d.failingSince) < period {
+		return nil
+	}
+	return d.failure
+}
@@ -0,0 +1,245 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakySink fails the first failures deliveries and then records notifications.
+type flakySink struct {
+	recordingSink
+	failures int
+}
+
+func (s *flakySink) Publish(ctx context.Context, n *rpc.Notification) error {
+	s.mu.Lock()
+	if s.failures > 0 {
+		s.failures--
+		s.mu.Unlock()
+		return errors.New("unavailable")
+	}
+	s.mu.Unlock()
+	return s.recordingSink.Publish(ctx, n)
+}
+
+// sequences returns the sequence numbers of the notifications received by a sink.
+func (s *recordingSink) sequences() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seqs := make([]int64, len(s.got))
+	for i, n := range s.got {
+		seqs[i] = n.GetSequence()
+	}
+	return seqs
+}
+
+// waitForSequences waits until a sink has received the expected notifications.
+func waitForSequences(t *testing.T, sink *recordingSink, want []int64) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for len(sink.sequences()) < len(want) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if diff := cmp.Diff(want, sink.sequences()); diff != "" {
+		t.Errorf("Sink received unexpected notifications (-want +got):\n%s", diff)
+	}
+}
+
+func serverWithSinks(t *testing.T, sinks ...NotificationSink) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Sinks:    sinks,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createProjects(t *testing.T, server *RegistryServer, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		if _, err := server.CreateProject(context.Background(), &rpc.CreateProjectRequest{
+			ProjectId: id,
+			Project:   &rpc.Project{},
+		}); err != nil {
+			t.Fatalf("Setup: CreateProject(%q) error = %v", id, err)
+		}
+	}
+}
+
+func TestOutboxIsTransactional(t *testing.T) {
+	sink := &recordingSink{}
+	server := serverWithSinks(t, sink)
+	createProjects(t, server, "a")
+
+	// A failed change must not produce a notification.
+	_, err := server.CreateProject(context.Background(), &rpc.CreateProjectRequest{
+		ProjectId: "a",
+		Project:   &rpc.Project{},
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("CreateProject() returned status code %s, want %s", status.Code(err), codes.AlreadyExists)
+	}
+	createProjects(t, server, "b")
+
+	waitForSequences(t, sink, []int64{1, 2})
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	sink := &flakySink{failures: 2}
+	server := serverWithSinks(t, sink)
+	createProjects(t, server, "a", "b", "c")
+
+	// Failed deliveries are retried in order.
+	waitForSequences(t, &sink.recordingSink, []int64{1, 2, 3})
+}
+
+func TestOutboxWithoutSinksOrWatchers(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithSinks(t)
+	createProjects(t, server, "a")
+	latest, err := server.storageClient.LatestEventSequence(ctx)
+	if err != nil {
+		t.Fatalf("LatestEventSequence() returned error: %s", err)
+	}
+	if latest != 1 {
+		t.Errorf("Server without sinks or watchers stored %d notifications, expected 1", latest)
+	}
+
+	_, err = server.ReplayNotifications(ctx, &rpc.ReplayNotificationsRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReplayNotifications() returned status code %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestWatchersOfOtherServers(t *testing.T) {
+	path := fmt.Sprintf("%s/registry.db", t.TempDir())
+	var servers []*RegistryServer
+	for i := 0; i < 2; i++ {
+		server, err := New(Config{Database: "sqlite3", DBConfig: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(server.Close)
+		servers = append(servers, server)
+	}
+
+	// Changes made through one server are sent to watchers of the other.
+	stream, _, cancel := startWatch(t, servers[1], &rpc.WatchResourcesRequest{Parent: "projects/-"})
+	defer cancel()
+	createProjects(t, servers[0], "a")
+	select {
+	case n := <-stream.events:
+		if got, want := n.GetChange().String()+" "+n.GetResource(), "CREATED projects/a"; got != want {
+			t.Errorf("Watcher received %q, want %q", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a notification of a change made through another server")
+	}
+}
+
+func TestDispatchersShareOutbox(t *testing.T) {
+	path := fmt.Sprintf("%s/registry.db", t.TempDir())
+	var sinks []*recordingSink
+	var servers []*RegistryServer
+	for i := 0; i < 2; i++ {
+		sink := &recordingSink{}
+		server, err := New(Config{Database: "sqlite3", DBConfig: path, Sinks: []NotificationSink{sink}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(server.Close)
+		sinks = append(sinks, sink)
+		servers = append(servers, server)
+	}
+	for i, id := range []string{"a", "b", "c", "d", "e", "f"} {
+		createProjects(t, servers[i%2], id)
+	}
+
+	// Each notification is delivered by only one of the servers.
+	received := func() []int64 {
+		seqs := append(sinks[0].sequences(), sinks[1].sequences()...)
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		return seqs
+	}
+	for deadline := time.Now().Add(10 * time.Second); len(received()) < 6 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(2 * dispatchInterval)
+	if diff := cmp.Diff([]int64{1, 2, 3, 4, 5, 6}, received()); diff != "" {
+		t.Errorf("Sinks received unexpected notifications (-want +got):\n%s", diff)
+	}
+}
+
+func TestDispatcherDeletesExpiredEvents(t *testing.T) {
+	ctx := context.Background()
+	path := fmt.Sprintf("%s/registry.db", t.TempDir())
+	setup, err := New(Config{Database: "sqlite3", DBConfig: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired := time.Now().Add(-2 * eventRetention)
+	for _, e := range []*models.Event{
+		{Resource: "expired", ChangeTime: expired, Dispatched: true},
+		{Resource: "pending", ChangeTime: expired},
+		{Resource: "recent", ChangeTime: time.Now(), Dispatched: true},
+	} {
+		if err := setup.storageClient.CreateEvent(ctx, e); err != nil {
+			t.Fatalf("Setup: CreateEvent() returned error: %s", err)
+		}
+	}
+	setup.Close()
+
+	// Without sinks, pending events stay pending.
+	server, err := New(Config{Database: "sqlite3", DBConfig: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	resources := func() []string {
+		events, err := server.storageClient.ListEventsAfter(ctx, 0, 10)
+		if err != nil {
+			t.Fatalf("ListEventsAfter() returned error: %s", err)
+		}
+		names := make([]string, len(events))
+		for i, e := range events {
+			names[i] = e.Resource
+		}
+		return names
+	}
+	want := []string{"pending", "recent"}
+	for deadline := time.Now().Add(10 * time.Second); len(resources()) > len(want) && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if diff := cmp.Diff(want, resources()); diff != "" {
+		t.Errorf("Outbox has unexpected events (-want +got):\n%s", diff)
+	}
+}
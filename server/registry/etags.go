@@ -0,0 +1,152 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// etagged is implemented by all resource messages that carry an etag.
+type etagged interface {
+	GetName() string
+	GetEtag() string
+}
+
+// checkEtag returns an ABORTED error if an etag provided by a client doesn't
+// match the current etag of a resource, following AIP-154.
+// An empty etag matches any resource.
+func checkEtag(etag string, current etagged) error {
+	if etag != "" && etag != current.GetEtag() {
+		return status.Errorf(codes.Aborted, "etag %q does not match the current etag of %q", etag, current.GetName())
+	}
+	return nil
+}
+
+// checkApiEtag verifies that etag matches the current etag of the named API.
+func checkApiEtag(ctx context.Context, db *storage.Client, name names.Api, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	api, err := db.GetApi(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := api.Message()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkVersionEtag verifies that etag matches the current etag of the named version.
+func checkVersionEtag(ctx context.Context, db *storage.Client, name names.Version, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	version, err := db.GetVersion(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := version.Message()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkSpecEtag verifies that etag matches the current etag of the named spec.
+func checkSpecEtag(ctx context.Context, db *storage.Client, name names.Spec, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	spec, err := db.GetSpec(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := spec.BasicMessage(name.String())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkSpecRevisionEtag verifies that etag matches the current etag of the named spec revision.
+func checkSpecRevisionEtag(ctx context.Context, db *storage.Client, name names.SpecRevision, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	revision, err := db.GetSpecRevision(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := revision.BasicMessage(name.String())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkDeploymentEtag verifies that etag matches the current etag of the named deployment.
+func checkDeploymentEtag(ctx context.Context, db *storage.Client, name names.Deployment, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	deployment, err := db.GetDeployment(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := deployment.BasicMessage(name.String())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkDeploymentRevisionEtag verifies that etag matches the current etag of the named deployment revision.
+func checkDeploymentRevisionEtag(ctx context.Context, db *storage.Client, name names.DeploymentRevision, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	revision, err := db.GetDeploymentRevision(ctx, name)
+	if err != nil {
+		return err
+	}
+	current, err := revision.BasicMessage(name.String())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
+
+// checkArtifactEtag verifies that etag matches the current etag of the named artifact.
+func checkArtifactEtag(ctx context.Context, db *storage.Client, name names.Artifact, etag string) error {
+	if etag == "" {
+		return nil
+	}
+	artifact, err := db.GetArtifact(ctx, name, true)
+	if err != nil {
+		return err
+	}
+	current, err := artifact.Message()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return checkEtag(etag, current)
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestEtags(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	const (
+		api        = "projects/my-project/locations/global/apis/a"
+		version    = api + "/versions/v"
+		spec       = version + "/specs/s"
+		deployment = api + "/deployments/d"
+		artifact   = api + "/artifacts/x"
+	)
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: spec},
+		&rpc.ApiDeployment{Name: deployment},
+		&rpc.Artifact{Name: artifact},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	labels := &fieldmaskpb.FieldMask{Paths: []string{"labels"}}
+	changed := map[string]string{"changed": "true"}
+	// Resources are listed children first so that they can be deleted in order.
+	tests := []struct {
+		desc   string
+		get    func() (etagged, error)
+		update func(etag string) (etagged, error)
+		delete func(etag string) error
+	}{
+		{
+			desc: "artifact",
+			get: func() (etagged, error) {
+				return server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: artifact})
+			},
+			update: func(etag string) (etagged, error) {
+				return server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+					Artifact: &rpc.Artifact{Name: artifact, Labels: changed, Etag: etag},
+				})
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: artifact, Etag: etag})
+				return err
+			},
+		},
+		{
+			desc: "deployment",
+			get: func() (etagged, error) {
+				return server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: deployment})
+			},
+			update: func(etag string) (etagged, error) {
+				return server.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+					ApiDeployment: &rpc.ApiDeployment{Name: deployment, Labels: changed, Etag: etag},
+					UpdateMask:    labels,
+				})
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiDeployment(ctx, &rpc.DeleteApiDeploymentRequest{Name: deployment, Etag: etag})
+				return err
+			},
+		},
+		{
+			desc: "spec",
+			get: func() (etagged, error) {
+				return server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec})
+			},
+			update: func(etag string) (etagged, error) {
+				return server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+					ApiSpec:    &rpc.ApiSpec{Name: spec, Labels: changed, Etag: etag},
+					UpdateMask: labels,
+				})
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: spec, Etag: etag})
+				return err
+			},
+		},
+		{
+			desc: "version",
+			get: func() (etagged, error) {
+				return server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: version})
+			},
+			update: func(etag string) (etagged, error) {
+				return server.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+					ApiVersion: &rpc.ApiVersion{Name: version, Labels: changed, Etag: etag},
+					UpdateMask: labels,
+				})
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: version, Etag: etag})
+				return err
+			},
+		},
+		{
+			desc: "api",
+			get: func() (etagged, error) {
+				return server.GetApi(ctx, &rpc.GetApiRequest{Name: api})
+			},
+			update: func(etag string) (etagged, error) {
+				return server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+					Api:        &rpc.Api{Name: api, Labels: changed, Etag: etag},
+					UpdateMask: labels,
+				})
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api, Etag: etag})
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			original, err := test.get()
+			if err != nil {
+				t.Fatalf("Get returned error: %s", err)
+			}
+			if original.GetEtag() == "" {
+				t.Fatalf("Get returned %+v without an etag", original)
+			}
+			if again, err := test.get(); err != nil || again.GetEtag() != original.GetEtag() {
+				t.Fatalf("Get returned etag %q, want unchanged etag %q: %v", again.GetEtag(), original.GetEtag(), err)
+			}
+
+			if _, err := test.update("wrong"); status.Code(err) != codes.Aborted {
+				t.Errorf("Update with a wrong etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+			}
+			updated, err := test.update(original.GetEtag())
+			if err != nil {
+				t.Fatalf("Update with the current etag returned error: %s", err)
+			}
+			if updated.GetEtag() == original.GetEtag() {
+				t.Errorf("Update returned unchanged etag %q", updated.GetEtag())
+			}
+			if current, err := test.get(); err != nil || current.GetEtag() != updated.GetEtag() {
+				t.Errorf("Get returned etag %q, want etag %q from update: %v", current.GetEtag(), updated.GetEtag(), err)
+			}
+			if _, err := test.update(original.GetEtag()); status.Code(err) != codes.Aborted {
+				t.Errorf("Update with a stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+			}
+
+			if err := test.delete(original.GetEtag()); status.Code(err) != codes.Aborted {
+				t.Errorf("Delete with a stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+			}
+			if err := test.delete(updated.GetEtag()); err != nil {
+				t.Fatalf("Delete with the current etag returned error: %s", err)
+			}
+			if err := test.delete(updated.GetEtag()); status.Code(err) != codes.NotFound {
+				t.Errorf("Delete of a deleted resource returned status code %q, want %q: %v", status.Code(err), codes.NotFound, err)
+			}
+		})
+	}
+}
+
+func TestUpdateApiEtagAllowMissing(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// A resource can't match an etag if it doesn't exist, so it isn't created.
+	req := &rpc.UpdateApiRequest{
+		Api:          &rpc.Api{Name: "projects/my-project/locations/global/apis/a", Etag: "some-etag"},
+		AllowMissing: true,
+	}
+	if _, err := server.UpdateApi(ctx, req); status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateApi(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.NotFound, err)
+	}
+}
+
+func TestDeleteApiSpecRevisionEtag(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedSpecs(ctx, server, &rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/s"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	first, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/s"})
+	if err != nil {
+		t.Fatalf("Setup: GetApiSpec returned error: %s", err)
+	}
+	second, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: first.GetName(), Contents: []byte("new contents")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("Setup: UpdateApiSpec returned error: %s", err)
+	}
+
+	name := first.GetName() + "@" + first.GetRevisionId()
+	req := &rpc.DeleteApiSpecRevisionRequest{Name: name, Etag: second.GetEtag()}
+	if _, err := server.DeleteApiSpecRevision(ctx, req); status.Code(err) != codes.Aborted {
+		t.Errorf("DeleteApiSpecRevision(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.Aborted, err)
+	}
+	req.Etag = first.GetEtag()
+	if _, err := server.DeleteApiSpecRevision(ctx, req); err != nil {
+		t.Errorf("DeleteApiSpecRevision(%+v) returned error: %s", req, err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// gatewayTokenKey is the metadata key of gatewayToken.
+const gatewayTokenKey = "x-registry-gateway"
+
+// gatewayToken marks the calls of the gateways of this process, whose
+// x-forwarded-for metadata holds the addresses of their callers. It is random,
+// so other callers can't mark their calls.
+var gatewayToken = func() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}()
+
+// GatewayHandler returns an HTTP handler that serves the REST/JSON mapping of the
+// Registry and Admin services, as described by the google.api.http annotations
+// of their methods, by calling them over conn. Responses of methods that return
+// google.api.HttpBody, such as GetApiSpecContents and GetArtifactContents, are
+// written as their contents with their content types.
+func GatewayHandler(conn grpc.ClientConnInterface) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		// Like the Envoy transcoder, omit unpopulated fields from responses.
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		}),
+		runtime.WithMetadata(func(context.Context, *http.Request) metadata.MD {
+			return metadata.Pairs(gatewayTokenKey, gatewayToken)
+		}),
+	)
+	ctx := context.Background()
+	if err := rpc.RegisterRegistryHandlerClient(ctx, mux, rpc.NewRegistryClient(conn)); err != nil {
+		return nil, err
+	}
+	if err := rpc.RegisterAdminHandlerClient(ctx, mux, rpc.NewAdminClient(conn)); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
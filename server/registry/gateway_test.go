@@ -0,0 +1,250 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// gatewayTestServer returns the URL of a gateway of a new registry server.
+func gatewayTestServer(t *testing.T) string {
+	t.Helper()
+	server := serverWithSinks(t)
+	l, s, err := server.ServeGRPC(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("Setup: ServeGRPC() returned error: %s", err)
+	}
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Setup: Dial() returned error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	handler, err := GatewayHandler(conn)
+	if err != nil {
+		t.Fatalf("GatewayHandler() returned error: %s", err)
+	}
+	gateway := httptest.NewServer(handler)
+	t.Cleanup(gateway.Close)
+	return gateway.URL
+}
+
+func TestGateway(t *testing.T) {
+	url := gatewayTestServer(t)
+	project := url + "/v1/projects/my-project/locations/global"
+	contents := base64.StdEncoding.EncodeToString([]byte("openapi: 3.0.0"))
+
+	tests := []struct {
+		desc        string
+		method      string
+		path        string
+		body        string
+		wantCode    int
+		wantType    string
+		wantContent string
+	}{
+		{
+			desc:        "create project",
+			method:      http.MethodPost,
+			path:        url + "/v1/projects?project_id=my-project",
+			body:        `{}`,
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"name":"projects/my-project"`,
+		},
+		{
+			desc:        "create api",
+			method:      http.MethodPost,
+			path:        project + "/apis?api_id=petstore",
+			body:        `{"displayName":"Petstore"}`,
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"displayName":"Petstore"`,
+		},
+		{
+			desc:        "update api with implied mask",
+			method:      http.MethodPatch,
+			path:        project + "/apis/petstore",
+			body:        `{"description":"Pets"}`,
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"description":"Pets"`,
+		},
+		{
+			desc:        "get updated api",
+			method:      http.MethodGet,
+			path:        project + "/apis/petstore",
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"displayName":"Petstore"`,
+		},
+		{
+			desc:     "create version",
+			method:   http.MethodPost,
+			path:     project + "/apis/petstore/versions?api_version_id=v1",
+			body:     `{}`,
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+		},
+		{
+			desc:        "create spec",
+			method:      http.MethodPost,
+			path:        project + "/apis/petstore/versions/v1/specs?api_spec_id=openapi",
+			body:        fmt.Sprintf(`{"mimeType":"application/x.openapi;version=3","contents":%q}`, contents),
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"sizeBytes":14`,
+		},
+		{
+			desc:        "get spec contents",
+			method:      http.MethodGet,
+			path:        project + "/apis/petstore/versions/v1/specs/openapi:getContents",
+			wantCode:    http.StatusOK,
+			wantType:    "application/x.openapi;version=3",
+			wantContent: "openapi: 3.0.0",
+		},
+		{
+			desc:     "create artifact",
+			method:   http.MethodPost,
+			path:     project + "/apis/petstore/artifacts?artifact_id=notes",
+			body:     fmt.Sprintf(`{"mimeType":"text/plain","contents":%q}`, base64.StdEncoding.EncodeToString([]byte("hello"))),
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+		},
+		{
+			desc:        "get artifact contents",
+			method:      http.MethodGet,
+			path:        project + "/apis/petstore/artifacts/notes:getContents",
+			wantCode:    http.StatusOK,
+			wantType:    "text/plain",
+			wantContent: "hello",
+		},
+		{
+			desc:        "list apis with filter",
+			method:      http.MethodGet,
+			path:        project + `/apis?filter=api_id=="petstore"`,
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			wantContent: `"name":"projects/my-project/locations/global/apis/petstore"`,
+		},
+		{
+			desc:        "get missing api",
+			method:      http.MethodGet,
+			path:        project + "/apis/missing",
+			wantCode:    http.StatusNotFound,
+			wantType:    "application/json",
+			wantContent: `"code":5`,
+		},
+		{
+			desc:     "create invalid api",
+			method:   http.MethodPost,
+			path:     project + "/apis?api_id=Invalid!",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+			wantType: "application/json",
+		},
+		{
+			desc:     "delete api",
+			method:   http.MethodDelete,
+			path:     project + "/apis/petstore?force=true",
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+		},
+	}
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, test.path, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatalf("%s: NewRequest() returned error: %s", test.desc, err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %s %s returned error: %s", test.desc, test.method, test.path, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("%s: failed to read response: %s", test.desc, err)
+		}
+		if resp.StatusCode != test.wantCode {
+			t.Fatalf("%s: returned status %d (%s), want %d", test.desc, resp.StatusCode, body, test.wantCode)
+		}
+		if got := resp.Header.Get("Content-Type"); got != test.wantType {
+			t.Errorf("%s: returned content type %q, want %q", test.desc, got, test.wantType)
+		}
+		if !strings.Contains(string(body), test.wantContent) {
+			t.Errorf("%s: returned %s, want it to contain %s", test.desc, body, test.wantContent)
+		}
+	}
+}
+
+func TestGatewayForwardsCallerAddresses(t *testing.T) {
+	var callers []string
+	record := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		callers = append(callers, rateLimitedCaller(ctx))
+		return handler(ctx, req)
+	}
+	server := serverWithSinks(t)
+	l, s, err := server.ServeGRPC(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}, grpc.UnaryInterceptor(record))
+	if err != nil {
+		t.Fatalf("Setup: ServeGRPC() returned error: %s", err)
+	}
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Setup: Dial() returned error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	handler, err := GatewayHandler(conn)
+	if err != nil {
+		t.Fatalf("GatewayHandler() returned error: %s", err)
+	}
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.RemoteAddr = "10.0.0.7:1234"
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(gateway.Close)
+
+	resp, err := http.Get(gateway.URL + "/v1/projects")
+	if err != nil {
+		t.Fatalf("GET /v1/projects returned error: %s", err)
+	}
+	resp.Body.Close()
+	if len(callers) != 1 || callers[0] != "10.0.0.7" {
+		t.Errorf("Gateway calls were limited as %q, want %q", callers, []string{"10.0.0.7"})
+	}
+
+	// Direct calls from the loopback address can't forward addresses.
+	callers = nil
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-forwarded-for", "10.0.0.8")
+	if _, err := rpc.NewAdminClient(conn).ListProjects(ctx, &rpc.ListProjectsRequest{}); err != nil {
+		t.Fatalf("ListProjects() returned error: %s", err)
+	}
+	if len(callers) != 1 || callers[0] != "127.0.0.1" {
+		t.Errorf("Direct calls were limited as %q, want %q", callers, []string{"127.0.0.1"})
+	}
+}
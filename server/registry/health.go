@@ -0,0 +1,231 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// healthCheckInterval is the time between updates of the status of the gRPC health service.
+	healthCheckInterval = 5 * time.Second
+	// healthCheckTimeout limits the time that each round of health checks can take.
+	healthCheckTimeout = 3 * time.Second
+	// notificationFailurePeriod is how long notification deliveries can fail
+	// before the server is reported as not ready. Short outages of sinks are
+	// covered by retries.
+	notificationFailurePeriod = time.Minute
+)
+
+// Names of the readiness checks.
+const (
+	databaseCheck      = "database"
+	schemaCheck        = "schema"
+	notificationsCheck = "notifications"
+	shutdownCheck      = "shutdown"
+)
+
+// healthCheck is the result of a readiness check.
+type healthCheck struct {
+	name string
+	err  error
+}
+
+// healthChecker keeps the status of the gRPC health service up to date.
+type healthChecker struct {
+	server   *health.Server
+	draining atomic.Bool
+	// migrated is set when the database schema has been found to be up to date.
+	// Schemas aren't expected to go back, so it isn't checked again.
+	migrated atomic.Bool
+	// serving is the latest status, which is only used by the checker's goroutine.
+	serving bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// startHealthChecker starts updating the status of the gRPC health service in the background.
+func (s *RegistryServer) startHealthChecker() {
+	s.health = &healthChecker{
+		server:  health.NewServer(),
+		serving: true,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	s.updateHealth(context.Background())
+	go func() {
+		defer close(s.health.done)
+		ctx := context.Background()
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.health.stop:
+				return
+			case <-ticker.C:
+			}
+			s.updateHealth(ctx)
+		}
+	}()
+}
+
+// stopHealthChecker stops updating the status of the gRPC health service.
+func (s *RegistryServer) stopHealthChecker() {
+	if s.health == nil {
+		return
+	}
+	close(s.health.stop)
+	<-s.health.done
+	s.health.server.Shutdown()
+}
+
+// Drain reports the server as not ready, so that load balancers stop sending
+// it calls, while it continues to serve them. It is called before graceful shutdown.
+func (s *RegistryServer) Drain() {
+	s.health.draining.Store(true)
+	// Statuses are NOT_SERVING from now on.
+	s.health.server.Shutdown()
+}
+
+// updateHealth sets the status of the gRPC health service to the result of the readiness checks.
+// Changes of the status are logged.
+func (s *RegistryServer) updateHealth(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	var failed []healthCheck
+	for _, c := range s.checkHealth(ctx, nil) {
+		if c.err != nil {
+			failed = append(failed, c)
+		}
+	}
+	serving := healthpb.HealthCheckResponse_SERVING
+	if len(failed) > 0 {
+		serving = healthpb.HealthCheckResponse_NOT_SERVING
+		if s.health.serving {
+			for _, c := range failed {
+				logger.WithError(c.err).Warnf("Health check %s failed.", c.name)
+			}
+		}
+	} else if !s.health.serving {
+		logger.Info("Health checks succeeded.")
+	}
+	s.health.serving = len(failed) == 0
+	for _, service := range []string{"", rpc.Registry_ServiceDesc.ServiceName, rpc.Admin_ServiceDesc.ServiceName} {
+		s.health.server.SetServingStatus(service, serving)
+	}
+}
+
+// checkHealth runs the readiness checks of the server, except for the excluded ones.
+func (s *RegistryServer) checkHealth(ctx context.Context, excluded map[string]bool) []healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	checks := []struct {
+		name  string
+		check func(context.Context) error
+	}{
+		{databaseCheck, s.checkDatabase},
+		{schemaCheck, s.checkSchema},
+		{notificationsCheck, func(context.Context) error { return s.dispatcher.failing(notificationFailurePeriod) }},
+		{shutdownCheck, func(context.Context) error {
+			if s.health.draining.Load() {
+				return errors.New("server is shutting down")
+			}
+			return nil
+		}},
+	}
+	results := make([]healthCheck, 0, len(checks))
+	for _, c := range checks {
+		if excluded[c.name] {
+			continue
+		}
+		results = append(results, healthCheck{name: c.name, err: c.check(ctx)})
+	}
+	return results
+}
+
+func (s *RegistryServer) checkDatabase(ctx context.Context) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+	return db.Ping(ctx)
+}
+
+func (s *RegistryServer) checkSchema(ctx context.Context) error {
+	if s.health.migrated.Load() {
+		return nil
+	}
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+	if err := db.CheckSchema(ctx); err != nil {
+		return err
+	}
+	s.health.migrated.Store(true)
+	return nil
+}
+
+// HealthHandler returns an HTTP handler of liveness and readiness probes.
+// "/healthz" succeeds while the server is running. "/readyz" succeeds when
+// the readiness checks succeed; the "exclude" query parameter skips checks
+// and the "verbose" query parameter lists the result of each check.
+func (s *RegistryServer) HealthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		excluded := make(map[string]bool)
+		for _, name := range r.URL.Query()["exclude"] {
+			excluded[name] = true
+		}
+		_, verbose := r.URL.Query()["verbose"]
+
+		var report strings.Builder
+		ready := true
+		for _, c := range s.checkHealth(r.Context(), excluded) {
+			if c.err != nil {
+				ready = false
+				fmt.Fprintf(&report, "[-]%s failed: %s\n", c.name, status.Convert(c.err).Message())
+			} else if verbose {
+				fmt.Fprintf(&report, "[+]%s ok\n", c.name)
+			}
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, report.String())
+			fmt.Fprintln(w, "readyz check failed")
+			return
+		}
+		fmt.Fprint(w, report.String())
+		fmt.Fprintln(w, "ok")
+	})
+	return mux
+}
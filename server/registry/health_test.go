@@ -0,0 +1,159 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// probe returns the status code and body of a request to a health handler.
+func probe(t *testing.T, server *RegistryServer, target string) (int, string) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	server.HealthHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w.Code, w.Body.String()
+}
+
+func TestHealthProbes(t *testing.T) {
+	server := serverWithSinks(t)
+
+	if code, body := probe(t, server, "/healthz"); code != http.StatusOK || body != "ok\n" {
+		t.Errorf("/healthz returned %d %q, want %d %q", code, body, http.StatusOK, "ok\n")
+	}
+	if code, body := probe(t, server, "/readyz"); code != http.StatusOK || body != "ok\n" {
+		t.Errorf("/readyz returned %d %q, want %d %q", code, body, http.StatusOK, "ok\n")
+	}
+	want := "[+]database ok\n[+]schema ok\n[+]shutdown ok\nok\n"
+	if code, body := probe(t, server, "/readyz?verbose&exclude=notifications"); code != http.StatusOK || body != want {
+		t.Errorf("/readyz?verbose&exclude=notifications returned %d %q, want %d %q", code, body, http.StatusOK, want)
+	}
+
+	// Draining servers are alive but not ready.
+	server.Drain()
+	if code, _ := probe(t, server, "/healthz"); code != http.StatusOK {
+		t.Errorf("/healthz returned %d after Drain(), want %d", code, http.StatusOK)
+	}
+	code, body := probe(t, server, "/readyz")
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]shutdown failed") {
+		t.Errorf("/readyz returned %d %q after Drain(), want %d and a failed shutdown check", code, body, http.StatusServiceUnavailable)
+	}
+	if code, _ := probe(t, server, "/readyz?exclude=shutdown"); code != http.StatusOK {
+		t.Errorf("/readyz?exclude=shutdown returned %d after Drain(), want %d", code, http.StatusOK)
+	}
+}
+
+func TestHealthSchema(t *testing.T) {
+	ctx := context.Background()
+	path := fmt.Sprintf("%s/registry.db", t.TempDir())
+	server, err := New(Config{Database: "sqlite3", DBConfig: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	// Remove a column, as if the database was created by an older server.
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("ALTER TABLE apis DROP COLUMN description"); err != nil {
+		t.Fatalf("Setup: failed to drop column: %s", err)
+	}
+	db.Close()
+
+	server, err = New(Config{Database: "sqlite3", DBConfig: path, NoMigrate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	code, body := probe(t, server, "/readyz")
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]schema failed") || !strings.Contains(body, "apis.description") {
+		t.Errorf("/readyz returned %d %q before migration, want %d and a failed schema check", code, body, http.StatusServiceUnavailable)
+	}
+
+	if _, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{}); err != nil {
+		t.Fatalf("MigrateDatabase() returned error: %s", err)
+	}
+	if code, body := probe(t, server, "/readyz"); code != http.StatusOK {
+		t.Errorf("/readyz returned %d %q after migration, want %d", code, body, http.StatusOK)
+	}
+}
+
+func TestHealthService(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithSinks(t)
+	l, s, err := server.ServeGRPC(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("Setup: ServeGRPC() returned error: %s", err)
+	}
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Setup: Dial() returned error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	client := healthpb.NewHealthClient(conn)
+
+	services := []string{"", "google.cloud.apigeeregistry.v1.Registry", "google.cloud.apigeeregistry.v1.Admin"}
+	check := func(want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		for _, service := range services {
+			resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				t.Fatalf("Check(%q) returned error: %s", service, err)
+			}
+			if resp.GetStatus() != want {
+				t.Errorf("Check(%q) returned %s, want %s", service, resp.GetStatus(), want)
+			}
+		}
+	}
+	check(healthpb.HealthCheckResponse_SERVING)
+	server.Drain()
+	check(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func TestDispatcherFailures(t *testing.T) {
+	d := newDispatcher()
+	if err := d.failing(0); err != nil {
+		t.Errorf("failing(0) returned %v before deliveries, want nil", err)
+	}
+	failure := errors.New("sink is down")
+	d.recordDelivery(failure)
+	d.recordDelivery(failure)
+	if err := d.failing(0); err != failure {
+		t.Errorf("failing(0) returned %v after failed deliveries, want %v", err, failure)
+	}
+	if err := d.failing(time.Hour); err != nil {
+		t.Errorf("failing(time.Hour) returned %v after recent failures, want nil", err)
+	}
+	d.recordDelivery(nil)
+	if err := d.failing(0); err != nil {
+		t.Errorf("failing(0) returned %v after a successful delivery, want nil", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+)
+
+// ProjectResources holds the rows of all resources of a project.
+type ProjectResources struct {
+	Apis                   []models.Api
+	Versions               []models.Version
+	Specs                  []models.Spec       // All revisions, oldest first.
+	Deployments            []models.Deployment // All revisions, oldest first.
+	Artifacts              []models.Artifact
+	SpecRevisionTags       []models.SpecRevisionTag
+	DeploymentRevisionTags []models.DeploymentRevisionTag
+}
+
+// ListProjectResources returns all resources of a project, including all
+// spec and deployment revisions and their tags. Resources of soft-deleted
+// APIs are not included.
+func (c *Client) ListProjectResources(ctx context.Context, project names.Project) (*ProjectResources, error) {
+	r := new(ProjectResources)
+	for _, q := range []struct {
+		dest  interface{}
+		order string
+	}{
+		{&r.Apis, "key"},
+		{&r.Versions, "key"},
+		{&r.Specs, "api_id, version_id, spec_id, revision_create_time"},
+		{&r.Deployments, "api_id, deployment_id, revision_create_time"},
+		{&r.Artifacts, "key"},
+		{&r.SpecRevisionTags, "key"},
+		{&r.DeploymentRevisionTags, "key"},
+	} {
+		op := c.db.WithContext(ctx).
+			Where("project_id = ?", project.ProjectID).
+			Order(q.order)
+		if err := op.Find(q.dest).Error; err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list resources of %s", project))
+		}
+	}
+
+	// Tags aren't marked when their APIs are soft-deleted, so only the tags
+	// of listed revisions are kept.
+	specs := make(map[string]bool, len(r.Specs))
+	for _, s := range r.Specs {
+		specs[s.Key] = true
+	}
+	specTags := r.SpecRevisionTags[:0]
+	for _, t := range r.SpecRevisionTags {
+		if specs[t.ParentSpecKey] {
+			specTags = append(specTags, t)
+		}
+	}
+	r.SpecRevisionTags = specTags
+
+	deployments := make(map[string]bool, len(r.Deployments))
+	for _, d := range r.Deployments {
+		deployments[d.Key] = true
+	}
+	deploymentTags := r.DeploymentRevisionTags[:0]
+	for _, t := range r.DeploymentRevisionTags {
+		if deployments[t.ParentDeploymentKey] {
+			deploymentTags = append(deploymentTags, t)
+		}
+	}
+	r.DeploymentRevisionTags = deploymentTags
+
+	return r, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditOrder is the order of audit entries, which can't be changed by callers.
+const auditOrder = "sequence"
+
+var auditEntryFields = map[string]filtering.FieldType{
+	"sequence":    filtering.Int,
+	"create_time": filtering.Timestamp,
+	"principal":   filtering.String,
+	"method":      filtering.String,
+	"resource":    filtering.String,
+	"project_id":  filtering.String,
+	"change":      filtering.String,
+	"before_hash": filtering.String,
+	"after_hash":  filtering.String,
+}
+
+// CreateAuditEntry adds an entry to the audit log and assigns its sequence number.
+func (c *Client) CreateAuditEntry(ctx context.Context, v *models.AuditEntry) error {
+	return c.create(ctx, v)
+}
+
+// AuditEntryList contains a page of audit entries.
+type AuditEntryList struct {
+	AuditEntries []models.AuditEntry
+	Token        string
+}
+
+// ListAuditEntries returns audit entries in the order that they were recorded.
+func (c *Client) ListAuditEntries(ctx context.Context, opts PageOptions) (AuditEntryList, error) {
+	token, err := c.decodeToken(ctx, opts.Token)
+	if err != nil {
+		return AuditEntryList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return AuditEntryList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, auditEntryFields)
+	if err != nil {
+		return AuditEntryList{}, err
+	}
+
+	response := AuditEntryList{
+		AuditEntries: make([]models.AuditEntry, 0, opts.Size),
+	}
+
+	op, filter := c.pushDownFilter(c.db.WithContext(ctx).Clauses(orderBy(auditOrder)), filter, "audit_entries")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
+
+	for {
+		var page []models.AuditEntry
+		q, err := afterPosition(op, auditOrder, token.Position, qualifier("audit_entries"))
+		if err != nil {
+			return AuditEntryList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
+			return AuditEntryList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
+		} else if len(page) == 0 {
+			break
+		}
+
+		for _, v := range page {
+			match, err := filter.Matches(auditEntryMap(v))
+			if err != nil {
+				return AuditEntryList{}, err
+			} else if !match {
+				continue
+			}
+
+			if len(response.AuditEntries) == int(opts.Size) {
+				last := &response.AuditEntries[len(response.AuditEntries)-1]
+				if token.Position, err = c.positionOf(ctx, last, auditOrder); err != nil {
+					return AuditEntryList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
+				if err != nil {
+					return AuditEntryList{}, status.Error(codes.Internal, err.Error())
+				}
+				return response, nil
+			}
+
+			response.AuditEntries = append(response.AuditEntries, v)
+		}
+		if len(page) < lim {
+			break
+		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], auditOrder); err != nil {
+			return AuditEntryList{}, err
+		}
+	}
+
+	return response, nil
+}
+
+func auditEntryMap(e models.AuditEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"sequence":    e.Sequence,
+		"create_time": e.CreateTime,
+		"principal":   e.Principal,
+		"method":      e.Method,
+		"resource":    e.Resource,
+		"project_id":  e.ProjectID,
+		"change":      e.Change,
+		"before_hash": e.BeforeHash,
+		"after_hash":  e.AfterHash,
+	}
+}
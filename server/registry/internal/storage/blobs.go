@@ -0,0 +1,340 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/server/registry/internal/storage/blobstore"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Blob contents are stored once in the blob_contents table for each distinct value.
+// Each row of the blobs table holds a reference to its contents, and the contents are
+// deleted when their last reference is released.
+//
+// If a blob store is configured, new contents are kept in the store and their rows hold
+// only their hash, size and location. Stored contents are deleted after the transaction
+// that released them commits, so that they remain available if it is rolled back, and
+// contents that were stored by a transaction that is rolled back are deleted if unused.
+//
+// Contents are shared by all projects. Transactions that acquire or release the same
+// contents are serialized by the lock of their row, which is held from when the row is
+// inserted or updated until the transaction ends.
+
+// storedContents identifies contents in the blob store.
+type storedContents struct {
+	hash     string
+	location string
+}
+
+// blobChanges collects the contents that a transaction releases from and adds to the
+// blob store.
+type blobChanges struct {
+	released []storedContents
+	stored   []storedContents
+}
+
+// UseBlobStore configures the client to keep new blob contents in an external store.
+// Contents that are already in the database stay there until they are moved with
+// MoveBlobContents.
+func (c *Client) UseBlobStore(s blobstore.Store) {
+	c.blobs = s
+}
+
+// saveBlob inserts or replaces a blob and its reference to its contents.
+func (c *Client) saveBlob(ctx context.Context, v *models.Blob) error {
+	v.ContentsHash = models.ContentsHash(v.Contents)
+
+	old := new(models.Blob)
+	if err := c.db.WithContext(ctx).Take(old, keyIs(v.Key)).Error; err == nil {
+		if old.ContentsHash == v.ContentsHash {
+			return c.save(ctx, v)
+		}
+		if err := c.releaseContents(ctx, map[string]int64{old.ContentsHash: 1}); err != nil {
+			return err
+		}
+	} else if err != gorm.ErrRecordNotFound {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", v.Key))
+	}
+
+	if err := c.acquireContents(ctx, v.Contents); err != nil {
+		return err
+	}
+	return c.save(ctx, v)
+}
+
+// acquireContents stores contents or adds a reference to existing copies of them.
+// With a blob store, new contents are stored after their row is inserted, so other
+// transactions that acquire or release them wait until they are stored.
+func (c *Client) acquireContents(ctx context.Context, contents []byte) error {
+	v := models.NewBlobContents(contents)
+	if c.blobs != nil {
+		v.Contents = nil
+	}
+	err := c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "hash"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"ref_count": gorm.Expr("blob_contents.ref_count + 1"),
+		}),
+	}).Create(v).Error
+	if err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "save contents %s", v.Hash))
+	}
+	if c.blobs == nil {
+		return nil
+	}
+
+	// Only new rows have a single reference.
+	var refs int64
+	op := c.db.WithContext(ctx).Model(&models.BlobContents{}).Select("ref_count").Where("hash = ?", v.Hash)
+	if err := op.Scan(&refs).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "get contents %s", v.Hash))
+	}
+	if refs != 1 {
+		return nil
+	}
+	location, err := c.blobs.Put(ctx, v.Hash, contents)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to store contents %s: %s", v.Hash, err)
+	}
+	if c.changes != nil {
+		c.changes.stored = append(c.changes.stored, storedContents{hash: v.Hash, location: location})
+	}
+	op = c.db.WithContext(ctx).Model(&models.BlobContents{}).Where("hash = ?", v.Hash)
+	if err := op.Update("location", location).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "save contents %s", v.Hash))
+	}
+	return nil
+}
+
+// releaseContents removes references to contents and deletes contents that are no longer used.
+// References are counted by the hashes of their contents.
+func (c *Client) releaseContents(ctx context.Context, refs map[string]int64) error {
+	for hash, count := range refs {
+		op := c.db.WithContext(ctx).Model(&models.BlobContents{}).Where("hash = ?", hash)
+		if err := op.Update("ref_count", gorm.Expr("ref_count - ?", count)).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "release contents %s", hash))
+		}
+		var unused []models.BlobContents
+		op = c.db.WithContext(ctx).Select("hash, location").Where("hash = ?", hash).Where("ref_count <= 0")
+		if err := op.Find(&unused).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "release contents %s", hash))
+		}
+		if len(unused) == 0 {
+			continue
+		}
+		if err := c.db.WithContext(ctx).Delete(&unused).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete contents %s", hash))
+		}
+		for _, v := range unused {
+			if v.Location == "" {
+				continue
+			}
+			stored := storedContents{hash: v.Hash, location: v.Location}
+			if c.changes != nil {
+				c.changes.released = append(c.changes.released, stored)
+			} else {
+				c.deleteStoredContents(ctx, []storedContents{stored})
+			}
+		}
+	}
+	return nil
+}
+
+// deleteStoredContents deletes externally stored contents that are no longer used.
+// Each is deleted in a transaction that inserts a placeholder row for its contents,
+// which waits for transactions that are acquiring the same contents and keeps others
+// from acquiring them until it ends. Contents that already have a row are in use and
+// are kept. Failures leave unused contents in the store and are only logged.
+func (c *Client) deleteStoredContents(ctx context.Context, contents []storedContents) {
+	if c.blobs == nil {
+		return
+	}
+	for _, stored := range contents {
+		err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			placeholder := &models.BlobContents{Hash: stored.hash, Location: stored.location, CreateTime: time.Now()}
+			op := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(placeholder)
+			if op.Error != nil || op.RowsAffected == 0 {
+				return op.Error
+			}
+			if err := c.blobs.Delete(ctx, stored.location); err != nil {
+				return err
+			}
+			return tx.Delete(placeholder).Error
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warnf("Failed to delete stored contents %s.", stored.location)
+		}
+	}
+}
+
+// releaseBlobContents releases the contents of the blobs that a query is about to delete.
+// Queries that delete other models are ignored, so it can be called for each model that
+// a delete operation removes.
+func (c *Client) releaseBlobContents(ctx context.Context, op *gorm.DB, model interface{}) error {
+	if _, ok := model.(models.Blob); !ok {
+		return nil
+	}
+	var rows []struct {
+		ContentsHash string
+		Count        int64
+	}
+	err := op.Session(&gorm.Session{}).Model(&models.Blob{}).
+		Select("contents_hash, count(*) as count").
+		Group("contents_hash").
+		Scan(&rows).Error
+	if err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "count blob references"))
+	}
+	refs := make(map[string]int64)
+	for _, r := range rows {
+		refs[r.ContentsHash] = r.Count
+	}
+	return c.releaseContents(ctx, refs)
+}
+
+// loadBlobContents reads the stored contents of a blob.
+func (c *Client) loadBlobContents(ctx context.Context, v *models.Blob) error {
+	contents := new(models.BlobContents)
+	if err := c.db.WithContext(ctx).Take(contents, "hash = ?", v.ContentsHash).Error; err == gorm.ErrRecordNotFound {
+		// Blobs that were stored without contents have no reference.
+		v.Contents = nil
+		return nil
+	} else if err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "get contents of %s", v.Key))
+	}
+	if contents.Location == "" {
+		v.Contents = contents.Contents
+		return nil
+	}
+	if c.blobs == nil {
+		return status.Errorf(codes.FailedPrecondition, "contents of %s are at %s, but no blob store is configured", v.Key, contents.Location)
+	}
+	data, err := c.blobs.Get(ctx, contents.Location)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return status.Errorf(codes.DataLoss, "contents of %s are missing: %s", v.Key, err)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to read contents of %s: %s", v.Key, err)
+	}
+	v.Contents = data
+	return nil
+}
+
+// BlobSizes returns the total size of all blob contents as they are used by spec revisions
+// and artifacts (logical) and as they are stored once for each distinct value (physical).
+func (c *Client) BlobSizes(ctx context.Context) (logical, physical int64, err error) {
+	var sizes struct {
+		Logical  int64
+		Physical int64
+	}
+	err = c.db.WithContext(ctx).Model(&models.BlobContents{}).
+		Select("coalesce(sum(size_in_bytes * ref_count), 0) as logical, coalesce(sum(size_in_bytes), 0) as physical").
+		Scan(&sizes).Error
+	if err != nil {
+		return 0, 0, grpcErrorForDBError(ctx, errors.Wrap(err, "blob sizes"))
+	}
+	return sizes.Logical, sizes.Physical, nil
+}
+
+// migrateBlobContents moves contents that earlier versions stored in the blobs table into
+// shared blob contents and then drops the column that held them.
+func (c *Client) migrateBlobContents(ctx context.Context) error {
+	if !c.db.Migrator().HasColumn(&models.Blob{}, "contents") {
+		return nil
+	}
+	for {
+		var rows []struct {
+			Key      string
+			Contents []byte
+		}
+		err := c.db.WithContext(ctx).Model(&models.Blob{}).
+			Select("key", "contents").
+			Where("contents_hash IS NULL OR contents_hash = ''").
+			Limit(100).
+			Scan(&rows).Error
+		if err != nil {
+			return errors.Wrap(err, "read blob contents")
+		}
+		if len(rows) == 0 {
+			return c.db.WithContext(ctx).Migrator().DropColumn(&models.Blob{}, "contents")
+		}
+		for _, r := range rows {
+			if err := c.acquireContents(ctx, r.Contents); err != nil {
+				return err
+			}
+			err := c.db.WithContext(ctx).Model(&models.Blob{}).
+				Where(keyIs(r.Key)).
+				Updates(map[string]interface{}{
+					"contents_hash": models.ContentsHash(r.Contents),
+					"contents":      nil,
+				}).Error
+			if err != nil {
+				return errors.Wrapf(err, "migrate blob %s", r.Key)
+			}
+		}
+	}
+}
+
+// MoveBlobContents moves contents that are stored in the database to the configured blob
+// store and returns the number of contents that were moved.
+func (c *Client) MoveBlobContents(ctx context.Context) (int64, error) {
+	if c.blobs == nil {
+		return 0, status.Error(codes.FailedPrecondition, "no blob store is configured")
+	}
+	var moved int64
+	for {
+		var rows []models.BlobContents
+		err := c.db.WithContext(ctx).
+			Where("location IS NULL OR location = ''").
+			Order("hash").
+			Limit(100).
+			Find(&rows).Error
+		if err != nil {
+			return moved, grpcErrorForDBError(ctx, errors.Wrap(err, "read blob contents"))
+		}
+		if len(rows) == 0 {
+			return moved, nil
+		}
+		for _, v := range rows {
+			location, err := c.blobs.Put(ctx, v.Hash, v.Contents)
+			if err != nil {
+				return moved, status.Errorf(codes.Unavailable, "failed to store contents %s: %s", v.Hash, err)
+			}
+			op := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+				Where("hash = ?", v.Hash).
+				Updates(map[string]interface{}{
+					"location": location,
+					"contents": nil,
+				})
+			if err := op.Error; err != nil {
+				return moved, grpcErrorForDBError(ctx, errors.Wrapf(err, "move contents %s", v.Hash))
+			}
+			if op.RowsAffected == 0 {
+				// The contents were released while they were moved.
+				c.deleteStoredContents(ctx, []storedContents{{hash: v.Hash, location: location}})
+				continue
+			}
+			moved++
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blobstore stores the contents of spec revisions and artifacts
+// outside of the registry database.
+package blobstore
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when no contents are stored at a location.
+var ErrNotFound = errors.New("blob not found")
+
+// Store holds blob contents outside of the database.
+// Contents are identified by their hash, so storing the same key twice
+// stores the same contents and returns the same location.
+type Store interface {
+	// Put stores contents under a key and returns their location.
+	Put(ctx context.Context, key string, contents []byte) (string, error)
+	// Get returns the contents stored at a location.
+	Get(ctx context.Context, location string) ([]byte, error)
+	// Delete removes the contents stored at a location.
+	// Deleting contents that don't exist is not an error.
+	Delete(ctx context.Context, location string) error
+}
+
+// objectName returns the name of the stored object for a key.
+// Objects are spread across directories named by the first characters of their keys.
+func objectName(key string) string {
+	if len(key) < 2 {
+		return key
+	}
+	return key[:2] + "/" + key
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Filesystem stores blob contents as files in a local directory.
+// Locations have the form "file://DIRECTORY/OBJECT".
+type Filesystem struct {
+	dir string
+}
+
+// NewFilesystem returns a store that keeps contents in a directory,
+// which is created if it doesn't exist.
+func NewFilesystem(dir string) (*Filesystem, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Filesystem{dir: dir}, nil
+}
+
+func (s *Filesystem) Put(ctx context.Context, key string, contents []byte) (string, error) {
+	name := objectName(key)
+	path := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file and rename it so that readers never see partial contents.
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+	return s.location(name), nil
+}
+
+func (s *Filesystem) Get(ctx context.Context, location string) ([]byte, error) {
+	path, err := s.path(location)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", location, ErrNotFound)
+	}
+	return contents, err
+}
+
+func (s *Filesystem) Delete(ctx context.Context, location string) error {
+	path, err := s.path(location)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *Filesystem) location(name string) string {
+	return "file://" + filepath.ToSlash(s.dir) + "/" + name
+}
+
+// path returns the file of a location, which must be in the store's directory.
+func (s *Filesystem) path(location string) (string, error) {
+	prefix := s.location("")
+	name := strings.TrimPrefix(location, prefix)
+	if name == location || name == "" || strings.Contains(name, "..") {
+		return "", fmt.Errorf("location %q is not in %s", location, prefix)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(name)), nil
+}
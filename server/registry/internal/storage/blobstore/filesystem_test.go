@@ -0,0 +1,74 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testStore checks the behavior that all stores share.
+func testStore(t *testing.T, s Store, prefix string) {
+	t.Helper()
+	ctx := context.Background()
+	contents := []byte("hello")
+
+	location, err := s.Put(ctx, "abcdef", contents)
+	if err != nil {
+		t.Fatalf("Put() returned error: %s", err)
+	}
+	if !strings.HasPrefix(location, prefix) || !strings.HasSuffix(location, "/ab/abcdef") {
+		t.Errorf("Put() returned location %q, want %q followed by the object name", location, prefix)
+	}
+	if again, err := s.Put(ctx, "abcdef", contents); err != nil || again != location {
+		t.Errorf("Put() of the same key returned (%q, %v), want (%q, nil)", again, err, location)
+	}
+
+	got, err := s.Get(ctx, location)
+	if err != nil {
+		t.Fatalf("Get(%q) returned error: %s", location, err)
+	}
+	if !bytes.Equal(got, contents) {
+		t.Errorf("Get(%q) returned %q, want %q", location, got, contents)
+	}
+
+	if err := s.Delete(ctx, location); err != nil {
+		t.Fatalf("Delete(%q) returned error: %s", location, err)
+	}
+	if _, err := s.Get(ctx, location); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(%q) after Delete() returned error %v, want %v", location, err, ErrNotFound)
+	}
+	if err := s.Delete(ctx, location); err != nil {
+		t.Errorf("Delete(%q) of missing contents returned error: %s", location, err)
+	}
+
+	for _, location := range []string{"", "other://x/ab/abcdef", prefix + "../ab/abcdef"} {
+		if _, err := s.Get(ctx, location); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) returned error %v, want an invalid location error", location, err)
+		}
+	}
+}
+
+func TestFilesystem(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFilesystem(dir + "/blobs")
+	if err != nil {
+		t.Fatalf("NewFilesystem() returned error: %s", err)
+	}
+	testStore(t, s, "file://"+dir+"/blobs/")
+}
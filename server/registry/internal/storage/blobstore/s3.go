@@ -0,0 +1,243 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures a store in a bucket of an S3-compatible service.
+type S3Config struct {
+	// Endpoint is the URL of the service, such as "http://localhost:9000" for a local MinIO.
+	// If unset, the AWS endpoint of the region is used.
+	Endpoint string
+	// Region of the bucket. If unset, "us-east-1" is used.
+	Region string
+	// Bucket that holds the contents. It must already exist.
+	Bucket string
+	// Prefix is prepended to the names of stored objects, such as "registry/".
+	Prefix string
+	// AccessKeyID and SecretAccessKey are the credentials used to sign requests.
+	// If unset, requests are not signed.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3 stores blob contents as objects in an S3-compatible bucket.
+// Requests use path-style URLs, which are supported by AWS and by MinIO.
+// Locations have the form "s3://BUCKET/OBJECT".
+type S3 struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3 returns a store that keeps contents in an S3-compatible bucket.
+func NewS3(config S3Config) (*S3, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint %q: %s", config.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q: must be an http or https URL", config.Endpoint)
+	}
+	return &S3{config: config, endpoint: endpoint, client: http.DefaultClient}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, contents []byte) (string, error) {
+	name := s.config.Prefix + objectName(key)
+	resp, err := s.do(ctx, http.MethodPut, name, contents)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", s.responseError(resp, name)
+	}
+	return s.location(name), nil
+}
+
+func (s *S3) Get(ctx context.Context, location string) ([]byte, error) {
+	name, err := s.object(location)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", location, ErrNotFound)
+	default:
+		return nil, s.responseError(resp, name)
+	}
+}
+
+func (s *S3) Delete(ctx context.Context, location string) error {
+	name, err := s.object(location)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(ctx, http.MethodDelete, name, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s.responseError(resp, name)
+	}
+}
+
+func (s *S3) location(name string) string {
+	return "s3://" + s.config.Bucket + "/" + name
+}
+
+// object returns the object name of a location, which must be in the store's bucket.
+func (s *S3) object(location string) (string, error) {
+	prefix := s.location(s.config.Prefix)
+	if !strings.HasPrefix(location, prefix) || location == prefix || strings.Contains(location, "..") {
+		return "", fmt.Errorf("location %q is not in %s", location, prefix)
+	}
+	return strings.TrimPrefix(location, s.location("")), nil
+}
+
+func (s *S3) do(ctx context.Context, method, name string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket + "/" + name
+	u.RawPath = strings.TrimSuffix(s.endpoint.EscapedPath(), "/") + "/" + uriEncode(s.config.Bucket, false) + "/" + uriEncode(name, false)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if s.config.AccessKeyID != "" {
+		sum := sha256.Sum256(body)
+		signV4(req, s.config.AccessKeyID, s.config.SecretAccessKey, s.config.Region, hex.EncodeToString(sum[:]), time.Now())
+	}
+	return s.client.Do(req)
+}
+
+func (s *S3) responseError(resp *http.Response, name string) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 %s %s/%s: %s: %s", resp.Request.Method, s.config.Bucket, name, resp.Status, bytes.TrimSpace(msg))
+}
+
+// signV4 adds an AWS Signature Version 4 authorization header to a request for the S3 service.
+// All headers of the request are signed.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html.
+func signV4(req *http.Request, accessKeyID, secretAccessKey, region, payloadHash string, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	if req.Host != "" {
+		headers["host"] = req.Host
+	}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var canonicalHeaders strings.Builder
+	for _, k := range keys {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(keys, ";")
+
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for k, vs := range query {
+		for _, v := range vs {
+			params = append(params, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	sort.Strings(params)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		strings.Join(params, "&"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + region + "/s3/aws4_request"
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, v := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, v)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// uriEncode encodes a string as AWS signatures require: every byte except unreserved
+// characters is percent-encoded, and slashes are kept unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
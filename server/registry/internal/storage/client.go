@@ -17,10 +17,16 @@ package storage
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	_ "github.com/GoogleCloudPlatform/cloudsql-proxy/proxy/dialers/postgres"
+	"github.com/apigee/registry/pkg/tracing"
+	"github.com/apigee/registry/server/registry/internal/storage/blobstore"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/driver/postgres"
@@ -40,51 +46,64 @@ var entities = []interface{}{
 	&models.DeploymentRevisionTag{},
 	&models.Artifact{},
 	&models.Blob{},
+	&models.BlobContents{},
+	&models.Event{},
+	&models.AuditEntry{},
+	&models.Setting{},
+	&models.SearchDocument{},
 }
 
 // Client represents a connection to a storage provider.
 type Client struct {
-	db *gorm.DB
+	db    *gorm.DB
+	cache *clientCache
+	// blobs holds blob contents outside of the database, if set.
+	blobs blobstore.Store
+	// changes collects the externally stored contents that are released
+	// and stored in a transaction. Released contents are deleted after it
+	// commits, and stored contents are deleted if it is rolled back.
+	changes *blobChanges
+}
+
+// clientCache holds values that are read from the database once and
+// shared by a client and the clients of its transactions.
+type clientCache struct {
+	mu           sync.Mutex
+	pageTokenKey []byte
 }
 
 // NewClient creates a new database session using the provided driver and data source name.
-// Driver must be one of [ sqlite3, postgres, cloudsqlpostgres ]. DSN format varies per database driver.
+// Driver must be one of [ sqlite3, postgres, cloudsqlpostgres, mysql ]. DSN format varies per database driver.
 //
 // PostgreSQL DSN Reference: See "Connection Strings" at https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
 // SQLite DSN Reference: See "URI filename examples" at https://www.sqlite.org/c3ref/open.html
+// MySQL DSN Reference: See "DSN (Data Source Name)" at https://github.com/go-sql-driver/mysql#dsn-data-source-name
 func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 	switch driver {
 	case "sqlite3":
-		db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
-			Logger:      NewGormLogger(ctx),
-			PrepareStmt: true,
-		})
+		db, err := gorm.Open(sqlite.Open(sqliteDSN(dsn)), gormConfig(ctx))
 		if err != nil {
 			c := &Client{db: db}
 			c.close()
 			return nil, grpcErrorForDBError(ctx, err)
 		}
-		// Sets to 1 to disallow multiple connections on SQLite.
-		// Any automatically opened connections, such a connection pool
-		// or SetConnMaxLifetime() will not apply post-connect
-		// PRAGMA commands (eg. "foreign_keys = ON").
-		if err := applyConnectionLimits(db, 1); err != nil {
+		// Connections are configured by the settings that sqliteDSN adds,
+		// so file databases can be used by several connections at once.
+		n := sqliteMaxConnections
+		if sqliteInMemory(dsn) {
+			n = 1
+		}
+		if err := applyConnectionLimits(db, n); err != nil {
 			c := &Client{db: db}
 			c.close()
 			return nil, grpcErrorForDBError(ctx, err)
 		}
-		if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
-			return nil, grpcErrorForDBError(ctx, err)
-		}
-		return &Client{db: db}, nil
+		return &Client{db: db, cache: &clientCache{}}, nil
 	case "postgres", "cloudsqlpostgres":
 		db, err := gorm.Open(postgres.New(postgres.Config{
 			DriverName: driver,
 			DSN:        dsn,
-		}), &gorm.Config{
-			Logger:      NewGormLogger(ctx),
-			PrepareStmt: true,
-		})
+		}), gormConfig(ctx))
 		if err != nil {
 			c := &Client{db: db}
 			c.close()
@@ -95,12 +114,41 @@ func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 			c.close()
 			return nil, grpcErrorForDBError(ctx, err)
 		}
-		return &Client{db: db}, nil
+		return &Client{db: db, cache: &clientCache{}}, nil
+	case "mysql":
+		db, err := openMySQL(ctx, dsn)
+		if err != nil {
+			if db != nil {
+				c := &Client{db: db}
+				c.close()
+			}
+			return nil, grpcErrorForDBError(ctx, err)
+		}
+		if err := applyConnectionLimits(db, 10); err != nil {
+			c := &Client{db: db}
+			c.close()
+			return nil, grpcErrorForDBError(ctx, err)
+		}
+		// MySQL servers close connections that have been idle for longer than wait_timeout,
+		// so connections are replaced before that can happen.
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.SetConnMaxIdleTime(5 * time.Minute)
+		}
+		return &Client{db: db, cache: &clientCache{}}, nil
 	default:
 		return nil, fmt.Errorf("unsupported database %s", driver)
 	}
 }
 
+// gormConfig returns the configuration of database sessions.
+func gormConfig(ctx context.Context) *gorm.Config {
+	return &gorm.Config{
+		Logger:      NewGormLogger(ctx),
+		PrepareStmt: true,
+		Plugins:     map[string]gorm.Plugin{tracingPluginName: tracingPlugin{}},
+	}
+}
+
 // Applies limits to concurrent connections.
 func applyConnectionLimits(db *gorm.DB, n int) error {
 	sqlDB, err := db.DB()
@@ -138,7 +186,7 @@ func (c *Client) EnsureTables(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+	return c.ensureSearchIndex(ctx)
 }
 
 func (c *Client) Migrate(ctx context.Context) error {
@@ -154,6 +202,57 @@ func (c *Client) Migrate(ctx context.Context) error {
 		return grpcErrorForDBError(ctx, err)
 	}
 
+	if err := c.migrateBlobContents(ctx); err != nil {
+		return grpcErrorForDBError(ctx, err)
+	}
+
+	if err := c.ensureSearchIndex(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Ping checks that the database can be reached.
+func (c *Client) Ping(ctx context.Context) error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
+// CheckSchema returns an error that names a missing table, column or index
+// if the database has not been migrated to the schema of this server.
+func (c *Client) CheckSchema(ctx context.Context) error {
+	op := c.db.WithContext(ctx)
+	m := op.Migrator()
+	for _, entity := range entities {
+		stmt := &gorm.Statement{DB: op}
+		if err := stmt.Parse(entity); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if !m.HasTable(entity) {
+			return status.Errorf(codes.FailedPrecondition, "table %s is missing", stmt.Schema.Table)
+		}
+		// Column types aren't used because they can be read with cached statements
+		// that don't see schema changes.
+		for _, name := range stmt.Schema.DBNames {
+			if !m.HasColumn(entity, name) {
+				return status.Errorf(codes.FailedPrecondition, "column %s.%s is missing", stmt.Schema.Table, name)
+			}
+		}
+	}
+	exists, _, err := c.searchIndex(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return status.Error(codes.FailedPrecondition, "search index is missing")
+	}
 	return nil
 }
 
@@ -210,6 +309,11 @@ func (c *Client) ensureForeignKeys(ctx context.Context) (err error) {
 }
 
 func (c *Client) migrateArtifactsToRevisions(ctx context.Context) (err error) {
+	// MySQL support was added after artifacts of specs became revisioned,
+	// so MySQL databases have no artifacts to migrate.
+	if c.db.Name() == "mysql" {
+		return nil
+	}
 	return c.db.Exec(`
 	UPDATE artifacts
 	SET revision_id = specs.revision_id
@@ -243,8 +347,15 @@ func (c *Client) TableNames(ctx context.Context) ([]string, error) {
 		if err := c.db.WithContext(ctx).Table("information_schema.tables").Where("table_schema = ?", "public").Order("table_name").Pluck("table_name", &tableNames).Error; err != nil {
 			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "tables"))
 		}
+	case "mysql":
+		// Tables that hold FULLTEXT indexes are listed by some MySQL-compatible servers.
+		if err := c.db.WithContext(ctx).Table("information_schema.tables").Where("table_schema = DATABASE() AND table_name NOT LIKE ?", "search_documents_%").Pluck("table_name", &tableNames).Error; err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "tables"))
+		}
+		// Table names are sorted here because information_schema collations ignore case.
+		sort.Strings(tableNames)
 	case "sqlite":
-		if err := c.db.WithContext(ctx).Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%'").Order("name").Pluck("name", &tableNames).Error; err != nil {
+		if err := c.db.WithContext(ctx).Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT LIKE ?", searchTable+"%").Order("name").Pluck("name", &tableNames).Error; err != nil {
 			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "tables"))
 		}
 	default:
@@ -259,9 +370,21 @@ func (c *Client) RowCount(ctx context.Context, tableName string) (int64, error)
 	return count, grpcErrorForDBError(ctx, errors.Wrapf(err, "count %s", tableName))
 }
 
-func (c *Client) Transaction(ctx context.Context, fn func(context.Context, *Client) error) error {
-	err := c.db.Transaction(func(tx *gorm.DB) error {
-		return fn(ctx, &Client{db: tx})
+func (c *Client) Transaction(ctx context.Context, fn func(context.Context, *Client) error) (err error) {
+	// Like database operations, transactions are only traced within traced calls.
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		var span trace.Span
+		ctx, span = tracing.Tracer().Start(ctx, "storage.Transaction")
+		defer func() { tracing.End(span, err) }()
+	}
+	changes := new(blobChanges)
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, &Client{db: tx, cache: c.cache, blobs: c.blobs, changes: changes})
 	})
-	return grpcErrorForDBError(ctx, err)
+	if err != nil {
+		c.deleteStoredContents(ctx, changes.stored)
+		return grpcErrorForDBError(ctx, err)
+	}
+	c.deleteStoredContents(ctx, changes.released)
+	return nil
 }
@@ -19,6 +19,8 @@ import (
 
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (c *Client) CreateProject(ctx context.Context, v *models.Project) error {
@@ -28,27 +30,57 @@ func (c *Client) CreateProject(ctx context.Context, v *models.Project) error {
 
 func (c *Client) CreateApi(ctx context.Context, v *models.Api) error {
 	v.Key = v.Name()
-	return c.create(ctx, v)
+	// Deleted APIs keep their names until they are purged.
+	if deleted, err := c.apiIsDeleted(ctx, v.ProjectID, v.ApiID); err != nil {
+		return err
+	} else if deleted {
+		return status.Errorf(codes.AlreadyExists, "%q was deleted and can be restored with UndeleteApi", v.Key)
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForApi(v))
 }
 
 func (c *Client) CreateVersion(ctx context.Context, v *models.Version) error {
 	v.Key = v.Name()
-	return c.create(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForVersion(v))
 }
 
 func (c *Client) CreateArtifact(ctx context.Context, v *models.Artifact) error {
 	v.Key = v.Name()
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
 	return c.create(ctx, v)
 }
 
 func (c *Client) CreateDeploymentRevision(ctx context.Context, v *models.Deployment) error {
 	v.Key = v.RevisionName()
-	return c.create(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForDeployment(v))
 }
 
 func (c *Client) CreateSpecRevision(ctx context.Context, v *models.Spec) error {
 	v.Key = v.RevisionName()
-	return c.create(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexSpecMetadata(ctx, v)
 }
 
 func (c *Client) create(ctx context.Context, v interface{}) error {
@@ -52,7 +52,10 @@ func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade
 	}
 	counts := make([]int64, len(tables))
 	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID)
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -66,12 +69,13 @@ func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade
 		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode", name)
 	}
 
-	// Tags aren't API resources, so they do not block non-cascading deletes.
+	// Tags and search documents aren't API resources, so they do not block non-cascading deletes.
 	for _, model := range []interface{}{
 		models.DeploymentRevisionTag{},
 		models.SpecRevisionTag{},
+		models.SearchDocument{},
 	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID)
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID)
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -81,6 +85,14 @@ func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade
 }
 
 func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) error {
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+	return c.deleteApi(ctx, name, cascade)
+}
+
+// deleteApi permanently deletes an API, including an API that was soft-deleted.
+func (c *Client) deleteApi(ctx context.Context, name names.Api, cascade bool) error {
 	tables := []interface{}{
 		models.Api{},
 		models.Blob{},
@@ -89,8 +101,11 @@ func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) er
 
 	counts := make([]int64, len(tables))
 	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -107,8 +122,9 @@ func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) er
 	for _, model := range []interface{}{
 		models.DeploymentRevisionTag{},
 		models.SpecRevisionTag{},
+		models.SearchDocument{},
 	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID)
 		if err := op.Delete(model).Error; err != nil {
 			return err
@@ -119,6 +135,10 @@ func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) er
 }
 
 func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade bool) error {
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+
 	tables := []interface{}{
 		models.Version{},
 		models.Blob{},
@@ -126,9 +146,12 @@ func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade
 	}
 	counts := make([]int64, len(tables))
 	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -144,8 +167,9 @@ func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade
 
 	for _, model := range []interface{}{
 		models.SpecRevisionTag{},
+		models.SearchDocument{},
 	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID)
 		if err := op.Delete(model).Error; err != nil {
@@ -157,15 +181,23 @@ func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade
 }
 
 func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool) error {
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+
 	for _, model := range []interface{}{
 		models.Spec{},
 		models.SpecRevisionTag{},
 		models.Blob{},
+		models.SearchDocument{},
 	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID).
 			Where("spec_id = ?", name.SpecID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -180,10 +212,13 @@ func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool)
 	}
 	counts := make([]int64, len(tables))
 	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID).
 			Where("spec_id = ?", name.SpecID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -198,7 +233,11 @@ func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool)
 }
 
 func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision) error {
-	name, err := c.unwrapSpecRevisionTag(ctx, name)
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+
+	name, err := c.resolveSpecRevision(ctx, name)
 	if err != nil {
 		return err
 	}
@@ -207,7 +246,7 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		models.Spec{},
 		models.SpecRevisionTag{},
 	} {
-		op := c.db.WithContext(ctx).
+		op := c.db.WithContext(ctx).Unscoped().
 			Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID).
@@ -221,8 +260,17 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		}
 	}
 
+	// the revision's contents are stored in a blob that has the revision's name
+	blob := c.db.WithContext(ctx).Where(keyIs(name.String()))
+	if err := c.releaseBlobContents(ctx, blob, models.Blob{}); err != nil {
+		return err
+	}
+	if err := blob.Delete(models.Blob{}).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
+	}
+
 	// if we deleted the last revision, return an error to cancel the transaction
-	op := c.db.WithContext(ctx).
+	op := c.db.WithContext(ctx).Unscoped().
 		Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
 		Where("version_id = ?", name.VersionID).
@@ -235,15 +283,20 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
 	}
 
-	return nil
+	return c.reindexSpec(ctx, v)
 }
 
 func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, cascade bool) error {
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+
 	for _, model := range []interface{}{
 		models.Deployment{},
 		models.DeploymentRevisionTag{},
+		models.SearchDocument{},
 	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("deployment_id = ?", name.DeploymentID)
 		if err := op.Delete(model).Error; err != nil {
@@ -260,9 +313,12 @@ func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, ca
 	}
 	counts := make([]int64, len(tables))
 	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
+		op := c.db.WithContext(ctx).Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("deployment_id = ?", name.DeploymentID)
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return err
 		}
@@ -278,7 +334,11 @@ func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, ca
 }
 
 func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.DeploymentRevision) error {
-	name, err := c.unwrapDeploymentRevisionTag(ctx, name)
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID, name.ApiID, name); err != nil {
+		return err
+	}
+
+	name, err := c.resolveDeploymentRevision(ctx, name)
 	if err != nil {
 		return err
 	}
@@ -287,7 +347,7 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 		models.Deployment{},
 		models.DeploymentRevisionTag{},
 	} {
-		op := c.db.WithContext(ctx).
+		op := c.db.WithContext(ctx).Unscoped().
 			Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("deployment_id = ?", name.DeploymentID).
@@ -301,7 +361,7 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 	}
 
 	// if we deleted the last revision, return an error to cancel the transaction
-	op := c.db.WithContext(ctx).
+	op := c.db.WithContext(ctx).Unscoped().
 		Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
 		Where("deployment_id = ?", name.DeploymentID).
@@ -313,21 +373,28 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
 	}
 
-	return nil
+	return c.indexDocument(ctx, models.NewSearchDocumentForDeployment(v))
 }
 
 func (c *Client) DeleteArtifact(ctx context.Context, name names.Artifact) error {
+	if err := c.checkApiNotDeleted(ctx, name.ProjectID(), name.ApiID(), name); err != nil {
+		return err
+	}
+
 	for _, model := range []interface{}{
 		models.Artifact{},
 		models.Blob{},
 	} {
-		op := c.db.WithContext(ctx).
+		op := c.db.WithContext(ctx).Unscoped().
 			Where("project_id = ?", name.ProjectID()).
 			Where("api_id = ?", name.ApiID()).
 			Where("version_id = ?", name.VersionID()).
 			Where("spec_id = ?", name.SpecID()).
 			Where("deployment_id = ?", name.DeploymentID()).
 			Where("artifact_id = ?", name.ArtifactID())
+		if err := c.releaseBlobContents(ctx, op, model); err != nil {
+			return err
+		}
 		if err := op.Delete(model).Error; err != nil {
 			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
 		}
@@ -20,6 +20,7 @@ import (
 	"os"
 
 	"github.com/apigee/registry/pkg/log"
+	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -42,10 +43,30 @@ func AlreadyExists(err error) bool {
 		if v.Code.Name() == "unique_violation" {
 			return true
 		}
+	case *mysql.MySQLError:
+		if v.Number == mysqlDuplicateEntry {
+			return true
+		}
 	}
 	return false
 }
 
+// MySQL server error numbers.
+// See https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html.
+const (
+	mysqlTooManyConnections = 1040
+	mysqlAccessDenied       = 1045
+	mysqlBadDatabase        = 1049
+	mysqlDuplicateEntry     = 1062
+	mysqlPacketTooLarge     = 1153
+	mysqlLockWaitTimeout    = 1205
+	mysqlDeadlock           = 1213
+	mysqlNoReferencedRow    = 1216
+	mysqlQueryInterrupted   = 1317
+	mysqlNoReferencedRow2   = 1452
+	mysqlQueryTimeout       = 3024
+)
+
 // grpcErrorForDBError converts recognized database error codes to grpc error codes.
 func grpcErrorForDBError(ctx context.Context, err error) error {
 	// unwrap err, if wrapped
@@ -80,6 +101,26 @@ func grpcErrorForDBError(ctx context.Context, err error) error {
 			return status.Error(codes.NotFound, cause.Error())
 		}
 		log.Infof(ctx, "Unhandled %T %+v code=%s name=%s", v, v, v.Code, v.Code.Name())
+	case *mysql.MySQLError:
+		switch v.Number {
+		case mysqlDuplicateEntry:
+			return status.Error(codes.AlreadyExists, cause.Error())
+		case mysqlTooManyConnections:
+			return status.Error(codes.Unavailable, cause.Error())
+		case mysqlAccessDenied, mysqlBadDatabase:
+			return status.Error(codes.FailedPrecondition, cause.Error())
+		case mysqlLockWaitTimeout, mysqlDeadlock:
+			return status.Error(codes.Aborted, cause.Error())
+		case mysqlQueryInterrupted:
+			return status.Error(codes.Canceled, cause.Error())
+		case mysqlQueryTimeout:
+			return status.Error(codes.DeadlineExceeded, cause.Error())
+		case mysqlNoReferencedRow, mysqlNoReferencedRow2:
+			return status.Error(codes.NotFound, cause.Error())
+		case mysqlPacketTooLarge:
+			return status.Error(codes.ResourceExhausted, cause.Error())
+		}
+		log.Infof(ctx, "Unhandled %T %+v number=%d", v, v, v.Number)
 	case *net.OpError:
 		if v.Op == "dial" {
 			// The database is overloaded.
@@ -117,6 +158,12 @@ func grpcErrorForDBError(ctx context.Context, err error) error {
 		if cause.Error() == "sql: statement is closed" {
 			return status.Error(codes.Unavailable, cause.Error())
 		}
+		if cause == mysql.ErrInvalidConn {
+			return status.Error(codes.Unavailable, cause.Error())
+		}
+		if cause == mysql.ErrPktTooLarge {
+			return status.Error(codes.ResourceExhausted, cause.Error())
+		}
 		if cause.Error() == "context canceled" {
 			return status.Error(codes.Canceled, cause.Error())
 		}
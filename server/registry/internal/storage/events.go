@@ -0,0 +1,150 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+)
+
+// CreateEvent adds an event to the outbox and assigns its sequence number.
+func (c *Client) CreateEvent(ctx context.Context, v *models.Event) error {
+	return c.create(ctx, v)
+}
+
+// ClaimPendingEvents claims up to limit of the oldest events that have not
+// been dispatched for the dispatcher named owner until the specified time,
+// and returns them in sequence order. To keep events in order, it claims
+// nothing while another dispatcher holds an unexpired claim of any of them.
+func (c *Client) ClaimPendingEvents(ctx context.Context, owner string, now, until time.Time, limit int) ([]*models.Event, error) {
+	var pending []*models.Event
+	op := c.db.WithContext(ctx).
+		Where("dispatched = ?", false).
+		Order("sequence").
+		Limit(limit)
+	if err := op.Find(&pending).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "list pending events"))
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+	sequences := make([]int64, 0, len(pending))
+	for _, e := range pending {
+		if e.ClaimedBy != owner && e.ClaimedUntil != nil && e.ClaimedUntil.After(now) {
+			return nil, nil
+		}
+		sequences = append(sequences, e.Sequence)
+	}
+
+	// The conditions are checked again as rows are updated, so events
+	// that another dispatcher claims concurrently are not claimed twice.
+	op = c.db.WithContext(ctx).Model(&models.Event{}).
+		Where("sequence IN ?", sequences).
+		Where("dispatched = ?", false).
+		Where("claimed_by = ? OR claimed_until IS NULL OR claimed_until < ?", owner, now).
+		Updates(map[string]interface{}{"claimed_by": owner, "claimed_until": until})
+	if err := op.Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "claim pending events"))
+	}
+
+	var claimed []*models.Event
+	op = c.db.WithContext(ctx).
+		Where("sequence IN ?", sequences).
+		Where("dispatched = ?", false).
+		Where("claimed_by = ?", owner).
+		Order("sequence")
+	if err := op.Find(&claimed).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "list claimed events"))
+	}
+	return claimed, nil
+}
+
+// ReleaseEvents removes the claims of a dispatcher from the events that it
+// has not dispatched so that they can be claimed again without waiting for
+// the claims to expire.
+func (c *Client) ReleaseEvents(ctx context.Context, owner string) error {
+	op := c.db.WithContext(ctx).Model(&models.Event{}).
+		Where("claimed_by = ?", owner).
+		Where("dispatched = ?", false).
+		Updates(map[string]interface{}{"claimed_by": "", "claimed_until": nil})
+	return grpcErrorForDBError(ctx, errors.Wrap(op.Error, "release events"))
+}
+
+// ListEventsAfter returns up to limit events with sequence numbers above
+// start, in sequence order, whether or not they have been dispatched.
+func (c *Client) ListEventsAfter(ctx context.Context, start int64, limit int) ([]*models.Event, error) {
+	var v []*models.Event
+	op := c.db.WithContext(ctx).
+		Where("sequence > ?", start).
+		Order("sequence").
+		Limit(limit)
+	if err := op.Find(&v).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "list events"))
+	}
+	return v, nil
+}
+
+// LatestEventSequence returns the highest sequence number in the outbox,
+// or zero if it is empty.
+func (c *Client) LatestEventSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	op := c.db.WithContext(ctx).Model(&models.Event{}).
+		Select("COALESCE(MAX(sequence), 0)")
+	if err := op.Scan(&sequence).Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "get latest event"))
+	}
+	return sequence, nil
+}
+
+// DeleteDispatchedEvents deletes the dispatched events of changes made
+// before the specified time and returns the number of deleted events.
+func (c *Client) DeleteDispatchedEvents(ctx context.Context, before time.Time) (int64, error) {
+	op := c.db.WithContext(ctx).
+		Where("dispatched = ?", true).
+		Where("change_time < ?", before).
+		Delete(&models.Event{})
+	if err := op.Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "delete dispatched events"))
+	}
+	return op.RowsAffected, nil
+}
+
+// MarkEventsDispatched records that the events with the specified sequence
+// numbers have been delivered.
+func (c *Client) MarkEventsDispatched(ctx context.Context, sequences []int64) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+	op := c.db.WithContext(ctx).Model(&models.Event{}).
+		Where("sequence IN ?", sequences).
+		Updates(map[string]interface{}{"dispatched": true, "claimed_by": "", "claimed_until": nil})
+	return grpcErrorForDBError(ctx, errors.Wrap(op.Error, "mark events dispatched"))
+}
+
+// ResetEvents marks all events with sequence numbers at or above start as
+// pending so that they will be dispatched again. It returns the number of
+// events that were reset.
+func (c *Client) ResetEvents(ctx context.Context, start int64) (int64, error) {
+	op := c.db.WithContext(ctx).Model(&models.Event{}).
+		Where("sequence >= ?", start).
+		Updates(map[string]interface{}{"dispatched": false, "claimed_by": "", "claimed_until": nil})
+	if err := op.Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "reset events"))
+	}
+	return op.RowsAffected, nil
+}
@@ -35,6 +35,13 @@ const (
 
 type Filter struct {
 	program cel.Program
+	expr    *exprpb.Expr
+	fields  map[string]FieldType
+}
+
+// Empty returns true if the filter matches every model.
+func (f *Filter) Empty() bool {
+	return f.program == nil
 }
 
 func (f *Filter) Matches(model map[string]interface{}) (bool, error) {
@@ -91,5 +98,10 @@ func NewFilter(filter string, fields map[string]FieldType) (Filter, error) {
 		return Filter{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return Filter{program: prg}, nil
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return Filter{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return Filter{program: prg, expr: checked.GetExpr(), fields: fields}, nil
 }
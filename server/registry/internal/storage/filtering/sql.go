@@ -0,0 +1,370 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// SQL dialects supported by Filter.SQL. These match the names of the gorm dialectors.
+const (
+	SQLite   = "sqlite"
+	Postgres = "postgres"
+	MySQL    = "mysql"
+)
+
+// Condition is a SQL condition that can be passed to gorm's Where.
+type Condition struct {
+	Query string
+	Args  []interface{}
+	// Exact is true if the condition selects exactly the rows that match the filter.
+	// Otherwise the condition selects a superset of them and the filter must still
+	// be evaluated against each row that the condition selects.
+	Exact bool
+}
+
+// SQL translates as much of the filter as possible into a condition for the given dialect.
+// Only fields that have an entry in columns can be translated; columns maps field names to
+// the names of the corresponding database columns. An empty Query means that no part of
+// the filter could be translated.
+//
+// The supported subset of CEL is comparisons between fields and literal values,
+// startsWith, endsWith and contains on string fields, presence tests on StringMap
+// fields and lookups that are conjoined with a presence test of the same key, and any
+// combination of these with &&, || and !.
+func (f *Filter) SQL(dialect string, columns map[string]string) Condition {
+	if f.expr == nil {
+		return Condition{Exact: true}
+	}
+	t := &translator{dialect: dialect, columns: columns, fields: f.fields, present: make(map[mapKey]bool)}
+	c := t.translate(f.expr)
+	if c == nil {
+		return Condition{}
+	}
+	return *c
+}
+
+type translator struct {
+	dialect string
+	columns map[string]string
+	fields  map[string]FieldType
+	// present holds the map entries (as column and key) that are known to exist
+	// because the expression being translated is conjoined with a presence test.
+	present map[mapKey]bool
+}
+
+type mapKey struct {
+	column, key string
+}
+
+var comparisons = map[string]string{
+	"_==_": "=",
+	"_!=_": "<>",
+	"_<_":  "<",
+	"_<=_": "<=",
+	"_>_":  ">",
+	"_>=_": ">=",
+}
+
+// reversed holds the operators to use when the operands of a comparison are swapped.
+var reversed = map[string]string{
+	"_==_": "_==_",
+	"_!=_": "_!=_",
+	"_<_":  "_>_",
+	"_<=_": "_>=_",
+	"_>_":  "_<_",
+	"_>=_": "_<=_",
+}
+
+// translate returns the condition for an expression, or nil if it can't be translated.
+func (t *translator) translate(e *exprpb.Expr) *Condition {
+	if k, ok := t.presenceTest(e); ok {
+		return t.mapEntry(k.column, k.key, nil)
+	}
+	if call := e.GetCallExpr(); call != nil {
+		return t.translateCall(call)
+	}
+	return nil
+}
+
+// translateGuarded translates an expression that is conjoined with guard. If guard
+// tests for the presence of a map entry, lookups of that entry can be translated.
+func (t *translator) translateGuarded(e, guard *exprpb.Expr) *Condition {
+	if k, ok := t.presenceTest(guard); ok && !t.present[k] {
+		t.present[k] = true
+		defer delete(t.present, k)
+	}
+	return t.translate(e)
+}
+
+// presenceTest recognizes has(labels.key) and "key" in labels.
+func (t *translator) presenceTest(e *exprpb.Expr) (mapKey, bool) {
+	switch x := e.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		if x.SelectExpr.GetTestOnly() {
+			if column, ok := t.column(x.SelectExpr.GetOperand(), StringMap); ok {
+				return mapKey{column, x.SelectExpr.GetField()}, true
+			}
+		}
+	case *exprpb.Expr_CallExpr:
+		if args := x.CallExpr.GetArgs(); x.CallExpr.GetFunction() == "@in" && len(args) == 2 {
+			key, ok := stringLiteral(args[0])
+			if !ok {
+				break
+			}
+			if column, ok := t.column(args[1], StringMap); ok {
+				return mapKey{column, key}, true
+			}
+		}
+	}
+	return mapKey{}, false
+}
+
+func (t *translator) translateCall(call *exprpb.Expr_Call) *Condition {
+	args := call.GetArgs()
+	switch fn := call.GetFunction(); fn {
+	case "_&&_":
+		l, r := t.translateGuarded(args[0], args[1]), t.translateGuarded(args[1], args[0])
+		switch {
+		case l == nil && r == nil:
+			return nil
+		case l == nil:
+			return &Condition{Query: r.Query, Args: r.Args}
+		case r == nil:
+			return &Condition{Query: l.Query, Args: l.Args}
+		}
+		return &Condition{
+			Query: fmt.Sprintf("(%s AND %s)", l.Query, r.Query),
+			Args:  append(append([]interface{}{}, l.Args...), r.Args...),
+			Exact: l.Exact && r.Exact,
+		}
+	case "_||_":
+		l, r := t.translate(args[0]), t.translate(args[1])
+		if l == nil || r == nil {
+			return nil
+		}
+		return &Condition{
+			Query: fmt.Sprintf("(%s OR %s)", l.Query, r.Query),
+			Args:  append(append([]interface{}{}, l.Args...), r.Args...),
+			Exact: l.Exact && r.Exact,
+		}
+	case "!_":
+		// Negating a superset doesn't give a superset of the negation.
+		c := t.translate(args[0])
+		if c == nil || !c.Exact {
+			return nil
+		}
+		return &Condition{Query: fmt.Sprintf("NOT (%s)", c.Query), Args: c.Args, Exact: true}
+	case "startsWith", "endsWith", "contains":
+		column, ok := t.column(call.GetTarget(), String)
+		if !ok || len(args) != 1 {
+			return nil
+		}
+		s, ok := stringLiteral(args[0])
+		if !ok || s == "" {
+			return nil
+		}
+		return t.substring(fn, column, s)
+	default:
+		if _, ok := comparisons[fn]; ok {
+			if c := t.comparison(fn, args[0], args[1]); c != nil {
+				return c
+			}
+			return t.comparison(reversed[fn], args[1], args[0])
+		}
+	}
+	return nil
+}
+
+// comparison translates a comparison with a field on the left and a literal on the right.
+func (t *translator) comparison(fn string, left, right *exprpb.Expr) *Condition {
+	// labels["key"] == "value" or labels.key == "value"
+	if key, mapExpr, ok := mapLookup(left); ok {
+		column, ok := t.column(mapExpr, StringMap)
+		value, isString := stringLiteral(right)
+		if !ok || !isString || fn != "_==_" {
+			return nil
+		}
+		// Looking up a missing key is an error, which must be reported by the filter
+		// rather than hidden by excluding the rows that don't have the key.
+		if !t.present[mapKey{column, key}] {
+			return nil
+		}
+		return t.mapEntry(column, key, &value)
+	}
+
+	name := left.GetIdentExpr().GetName()
+	column, ok := t.columns[name]
+	if !ok {
+		return nil
+	}
+	op := comparisons[fn]
+	switch t.fields[name] {
+	case String:
+		s, ok := stringLiteral(right)
+		if !ok {
+			return nil
+		}
+		// CEL orders strings by code point, which matches sqlite's default collation
+		// but not necessarily the collation of a postgres database.
+		if t.dialect == Postgres && op != "=" && op != "<>" {
+			column += ` COLLATE "C"`
+		}
+		// The default collations of MySQL also ignore case and accents in equality tests.
+		if t.dialect == MySQL {
+			column += " COLLATE utf8mb4_bin"
+		}
+		return &Condition{Query: fmt.Sprintf("%s %s ?", column, op), Args: []interface{}{s}, Exact: true}
+	case Int:
+		i, ok := right.GetConstExpr().GetConstantKind().(*exprpb.Constant_Int64Value)
+		if !ok {
+			return nil
+		}
+		return &Condition{Query: fmt.Sprintf("%s %s ?", column, op), Args: []interface{}{i.Int64Value}, Exact: true}
+	case Timestamp:
+		// Timestamps are stored as text by sqlite, where they can't be reliably compared.
+		if t.dialect != Postgres && t.dialect != MySQL {
+			return nil
+		}
+		ts, ok := timestampLiteral(right)
+		// Postgres and MySQL store microseconds and would round a more precise value.
+		if !ok || ts.Nanosecond()%1000 != 0 {
+			return nil
+		}
+		return &Condition{Query: fmt.Sprintf("%s %s ?", column, op), Args: []interface{}{ts}, Exact: true}
+	}
+	return nil
+}
+
+// substring translates the startsWith, endsWith and contains string functions.
+// Unlike LIKE, these functions are case-sensitive in all dialects.
+func (t *translator) substring(fn, column, s string) *Condition {
+	var query string
+	args := []interface{}{s}
+	switch t.dialect {
+	case SQLite:
+		switch fn {
+		case "startsWith":
+			query = fmt.Sprintf("instr(%s, ?) = 1", column)
+		case "endsWith":
+			query = fmt.Sprintf("substr(%s, -length(CAST(? AS text))) = ?", column)
+			args = append(args, s)
+		case "contains":
+			query = fmt.Sprintf("instr(%s, ?) > 0", column)
+		}
+	case Postgres:
+		switch fn {
+		case "startsWith":
+			query = fmt.Sprintf("strpos(%s, ?) = 1", column)
+		case "endsWith":
+			query = fmt.Sprintf("right(%s, length(CAST(? AS text))) = ?", column)
+			args = append(args, s)
+		case "contains":
+			query = fmt.Sprintf("strpos(%s, ?) > 0", column)
+		}
+	case MySQL:
+		// Binary strings are compared byte by byte rather than with the column's collation.
+		switch fn {
+		case "startsWith":
+			query = fmt.Sprintf("instr(CAST(%s AS BINARY), ?) = 1", column)
+		case "endsWith":
+			query = fmt.Sprintf("right(CAST(%s AS BINARY), length(?)) = ?", column)
+			args = append(args, s)
+		case "contains":
+			query = fmt.Sprintf("instr(CAST(%s AS BINARY), ?) > 0", column)
+		}
+	default:
+		return nil
+	}
+	return &Condition{Query: query, Args: args, Exact: true}
+}
+
+// mapEntry matches StringMap columns that contain an entry with the given key and, if
+// value is non-nil, the given value. Maps are stored as serialized rpc.Map messages,
+// so a match is made by searching for the serialized map entry. The search can
+// also match the same bytes inside other entries, so the condition is never exact.
+func (t *translator) mapEntry(column, key string, value *string) *Condition {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, key)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	if value != nil {
+		b = protowire.AppendString(b, *value)
+	}
+	switch t.dialect {
+	case SQLite:
+		return &Condition{Query: fmt.Sprintf("instr(%s, ?) > 0", column), Args: []interface{}{b}}
+	case Postgres:
+		return &Condition{Query: fmt.Sprintf("position(CAST(? AS bytea) in %s) > 0", column), Args: []interface{}{b}}
+	case MySQL:
+		return &Condition{Query: fmt.Sprintf("instr(%s, ?) > 0", column), Args: []interface{}{b}}
+	}
+	return nil
+}
+
+// column returns the column for an identifier that names a field of the given type.
+func (t *translator) column(e *exprpb.Expr, fieldType FieldType) (string, bool) {
+	name := e.GetIdentExpr().GetName()
+	if name == "" || t.fields[name] != fieldType {
+		return "", false
+	}
+	column, ok := t.columns[name]
+	return column, ok
+}
+
+// mapLookup recognizes map["key"] and map.key and returns the key and map expressions.
+func mapLookup(e *exprpb.Expr) (string, *exprpb.Expr, bool) {
+	switch x := e.ExprKind.(type) {
+	case *exprpb.Expr_SelectExpr:
+		if !x.SelectExpr.GetTestOnly() {
+			return x.SelectExpr.GetField(), x.SelectExpr.GetOperand(), true
+		}
+	case *exprpb.Expr_CallExpr:
+		if x.CallExpr.GetFunction() == "_[_]" {
+			if key, ok := stringLiteral(x.CallExpr.Args[1]); ok {
+				return key, x.CallExpr.Args[0], true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+func stringLiteral(e *exprpb.Expr) (string, bool) {
+	s, ok := e.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		return "", false
+	}
+	return s.StringValue, true
+}
+
+func timestampLiteral(e *exprpb.Expr) (time.Time, bool) {
+	call := e.GetCallExpr()
+	if call.GetFunction() != "timestamp" || call.GetTarget() != nil || len(call.GetArgs()) != 1 {
+		return time.Time{}, false
+	}
+	s, ok := stringLiteral(call.Args[0])
+	if !ok {
+		return time.Time{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilter_SQL(t *testing.T) {
+	fields := map[string]FieldType{
+		"name":   String,
+		"s":      String,
+		"i":      Int,
+		"t":      Timestamp,
+		"labels": StringMap,
+	}
+	columns := map[string]string{
+		"s":      "s_col",
+		"i":      "i_col",
+		"t":      "t_col",
+		"labels": "labels_col",
+	}
+	// Serialized map entries for labels {"k": "v"}.
+	key := []byte{0x0a, 1, 'k', 0x12}
+	entry := []byte{0x0a, 1, 'k', 0x12, 1, 'v'}
+
+	tests := []struct {
+		desc    string
+		dialect string
+		filter  string
+		want    Condition
+	}{
+		{
+			desc:   "empty",
+			filter: ``,
+			want:   Condition{Exact: true},
+		},
+		{
+			desc:   "string equality",
+			filter: `s == "x"`,
+			want:   Condition{Query: "s_col = ?", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:   "reversed operands",
+			filter: `3 < i`,
+			want:   Condition{Query: "i_col > ?", Args: []interface{}{int64(3)}, Exact: true},
+		},
+		{
+			desc:    "postgres string ordering",
+			dialect: Postgres,
+			filter:  `s >= "x"`,
+			want:    Condition{Query: `s_col COLLATE "C" >= ?`, Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "postgres timestamp",
+			dialect: Postgres,
+			filter:  `t < timestamp("2021-01-01T00:00:00Z")`,
+			want:    Condition{Query: "t_col < ?", Args: []interface{}{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}, Exact: true},
+		},
+		{
+			desc:   "sqlite timestamp",
+			filter: `t < timestamp("2021-01-01T00:00:00Z")`,
+			want:   Condition{},
+		},
+		{
+			desc:   "startsWith",
+			filter: `s.startsWith("x")`,
+			want:   Condition{Query: "instr(s_col, ?) = 1", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "postgres contains",
+			dialect: Postgres,
+			filter:  `s.contains("x")`,
+			want:    Condition{Query: "strpos(s_col, ?) > 0", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:   "endsWith",
+			filter: `s.endsWith("x")`,
+			want:   Condition{Query: "substr(s_col, -length(CAST(? AS text))) = ?", Args: []interface{}{"x", "x"}, Exact: true},
+		},
+		{
+			desc:   "label value",
+			filter: `has(labels.k) && labels["k"] == "v"`,
+			want:   Condition{Query: "(instr(labels_col, ?) > 0 AND instr(labels_col, ?) > 0)", Args: []interface{}{key, entry}},
+		},
+		{
+			desc:   "label field",
+			filter: `labels.k == "v" && "k" in labels`,
+			want:   Condition{Query: "(instr(labels_col, ?) > 0 AND instr(labels_col, ?) > 0)", Args: []interface{}{entry, key}},
+		},
+		{
+			desc:   "unguarded label lookup",
+			filter: `labels.k == "v"`,
+			want:   Condition{},
+		},
+		{
+			desc:   "label lookup guarded by another key",
+			filter: `has(labels.j) && labels.k == "v"`,
+			want:   Condition{Query: "instr(labels_col, ?) > 0", Args: []interface{}{[]byte{0x0a, 1, 'j', 0x12}}},
+		},
+		{
+			desc:    "label key",
+			dialect: Postgres,
+			filter:  `"k" in labels`,
+			want:    Condition{Query: "position(CAST(? AS bytea) in labels_col) > 0", Args: []interface{}{key}},
+		},
+		{
+			desc:    "mysql string equality",
+			dialect: MySQL,
+			filter:  `s == "x"`,
+			want:    Condition{Query: "s_col COLLATE utf8mb4_bin = ?", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "mysql timestamp",
+			dialect: MySQL,
+			filter:  `t >= timestamp("2021-01-01T00:00:00Z")`,
+			want:    Condition{Query: "t_col >= ?", Args: []interface{}{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}, Exact: true},
+		},
+		{
+			desc:    "mysql endsWith",
+			dialect: MySQL,
+			filter:  `s.endsWith("x")`,
+			want:    Condition{Query: "right(CAST(s_col AS BINARY), length(?)) = ?", Args: []interface{}{"x", "x"}, Exact: true},
+		},
+		{
+			desc:    "mysql label key",
+			dialect: MySQL,
+			filter:  `"k" in labels`,
+			want:    Condition{Query: "instr(labels_col, ?) > 0", Args: []interface{}{key}},
+		},
+		{
+			desc:   "has label",
+			filter: `has(labels.k)`,
+			want:   Condition{Query: "instr(labels_col, ?) > 0", Args: []interface{}{key}},
+		},
+		{
+			desc:   "and",
+			filter: `s == "x" && i != 1`,
+			want:   Condition{Query: "(s_col = ? AND i_col <> ?)", Args: []interface{}{"x", int64(1)}, Exact: true},
+		},
+		{
+			desc:   "partial and",
+			filter: `s == "x" && name.matches("y")`,
+			want:   Condition{Query: "s_col = ?", Args: []interface{}{"x"}},
+		},
+		{
+			desc:   "or",
+			filter: `s == "x" || has(labels.k)`,
+			want:   Condition{Query: "(s_col = ? OR instr(labels_col, ?) > 0)", Args: []interface{}{"x", key}},
+		},
+		{
+			desc:   "partial or",
+			filter: `s == "x" || name == "y"`,
+			want:   Condition{},
+		},
+		{
+			desc:   "not",
+			filter: `!(i <= 2)`,
+			want:   Condition{Query: "NOT (i_col <= ?)", Args: []interface{}{int64(2)}, Exact: true},
+		},
+		{
+			desc:   "not inexact",
+			filter: `!has(labels.k)`,
+			want:   Condition{},
+		},
+		{
+			desc:   "field without column",
+			filter: `name == "x"`,
+			want:   Condition{},
+		},
+		{
+			desc:   "field comparison",
+			filter: `s == name`,
+			want:   Condition{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			f, err := NewFilter(test.filter, fields)
+			if err != nil {
+				t.Fatalf("NewFilter(%q) returned error: %s", test.filter, err)
+			}
+			dialect := test.dialect
+			if dialect == "" {
+				dialect = SQLite
+			}
+			got := f.SQL(dialect, columns)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("SQL(%q) returned unexpected condition (-want +got):\n%s", test.filter, diff)
+			}
+		})
+	}
+}
@@ -27,9 +27,15 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+// keyIs selects the row with a key. The column name is quoted because
+// "key" is a reserved word in MySQL.
+func keyIs(key string) clause.Expression {
+	return clause.Eq{Column: clause.Column{Name: "key"}, Value: key}
+}
+
 func (c *Client) GetProject(ctx context.Context, name names.Project) (*models.Project, error) {
 	v := new(models.Project)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -40,7 +46,7 @@ func (c *Client) GetProject(ctx context.Context, name names.Project) (*models.Pr
 
 func (c *Client) GetApi(ctx context.Context, name names.Api) (*models.Api, error) {
 	v := new(models.Api)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -51,7 +57,7 @@ func (c *Client) GetApi(ctx context.Context, name names.Api) (*models.Api, error
 
 func (c *Client) GetVersion(ctx context.Context, name names.Version) (*models.Version, error) {
 	v := new(models.Version)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -67,7 +73,7 @@ func (c *Client) GetSpec(ctx context.Context, name names.Spec) (*models.Spec, er
 		Where("api_id = ?", name.ApiID).
 		Where("version_id = ?", name.VersionID).
 		Where("spec_id = ?", name.SpecID).
-		Order("revision_create_time desc")
+		Clauses(newestRevisionsFirst)
 
 	v := new(models.Spec)
 	if err := op.First(v).Error; err == gorm.ErrRecordNotFound {
@@ -80,7 +86,7 @@ func (c *Client) GetSpec(ctx context.Context, name names.Spec) (*models.Spec, er
 }
 
 func (c *Client) GetSpecRevision(ctx context.Context, name names.SpecRevision) (*models.Spec, error) {
-	name, err := c.unwrapSpecRevisionTag(ctx, name)
+	name, err := c.resolveSpecRevision(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +96,7 @@ func (c *Client) GetSpecRevision(ctx context.Context, name names.SpecRevision) (
 	}
 
 	v := new(models.Spec)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -100,18 +106,22 @@ func (c *Client) GetSpecRevision(ctx context.Context, name names.SpecRevision) (
 }
 
 func (c *Client) GetSpecRevisionContents(ctx context.Context, name names.SpecRevision) (*models.Blob, error) {
-	name, err := c.unwrapSpecRevisionTag(ctx, name)
+	name, err := c.resolveSpecRevision(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
 	v := new(models.Blob)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
 	}
 
+	if err := c.loadBlobContents(ctx, v); err != nil {
+		return nil, err
+	}
+
 	return v, nil
 }
 
@@ -121,7 +131,7 @@ func (c *Client) GetDeployment(ctx context.Context, name names.Deployment) (*mod
 		Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
 		Where("deployment_id = ?", name.DeploymentID).
-		Order("revision_create_time desc")
+		Clauses(newestRevisionsFirst)
 
 	v := new(models.Deployment)
 	if err := op.First(v).Error; err == gorm.ErrRecordNotFound {
@@ -134,7 +144,7 @@ func (c *Client) GetDeployment(ctx context.Context, name names.Deployment) (*mod
 }
 
 func (c *Client) GetDeploymentRevision(ctx context.Context, name names.DeploymentRevision) (*models.Deployment, error) {
-	name, err := c.unwrapDeploymentRevisionTag(ctx, name)
+	name, err := c.resolveDeploymentRevision(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +153,7 @@ func (c *Client) GetDeploymentRevision(ctx context.Context, name names.Deploymen
 		return c.GetDeployment(ctx, name.Deployment())
 	}
 	v := new(models.Deployment)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -161,7 +171,7 @@ func (c *Client) GetArtifact(ctx context.Context, name names.Artifact, forUpdate
 
 	// if fully specified, just grab via key
 	if !((name.SpecID() != "" || name.DeploymentID() != "") && name.RevisionID() == "") {
-		if err := op.Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+		if err := op.Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 			return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 		} else if err != nil {
 			return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -239,5 +249,9 @@ func (c *Client) GetArtifactContents(ctx context.Context, name names.Artifact) (
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
 	}
 
+	if err := c.loadBlobContents(ctx, v); err != nil {
+		return nil, err
+	}
+
 	return v, nil
 }
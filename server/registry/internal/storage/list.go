@@ -17,6 +17,7 @@ package storage
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/server/registry/internal/storage/filtering"
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var tableFieldsLookup = map[string]map[string]filtering.FieldType{
@@ -150,6 +152,138 @@ var revisionedArtifactFields = map[string]filtering.FieldType{
 	"revision_update_time": filtering.Timestamp,
 }
 
+// Columns that filters can be evaluated against in the database, keyed by field name.
+// Fields that are computed from other columns, such as "name", are evaluated in memory.
+var tableColumnsLookup = map[string]map[string]string{
+	"projects": {
+		"project_id":   "project_id",
+		"display_name": "display_name",
+		"description":  "description",
+		"create_time":  "create_time",
+		"update_time":  "update_time",
+	},
+	"apis": {
+		"project_id":             "project_id",
+		"api_id":                 "api_id",
+		"display_name":           "display_name",
+		"description":            "description",
+		"create_time":            "create_time",
+		"update_time":            "update_time",
+		"availability":           "availability",
+		"recommended_version":    "recommended_version",
+		"recommended_deployment": "recommended_deployment",
+		"labels":                 "labels",
+	},
+	"versions": {
+		"project_id":   "project_id",
+		"api_id":       "api_id",
+		"version_id":   "version_id",
+		"display_name": "display_name",
+		"description":  "description",
+		"create_time":  "create_time",
+		"update_time":  "update_time",
+		"state":        "state",
+		"labels":       "labels",
+		"primary_spec": "primary_spec",
+	},
+	"specs": {
+		"project_id":           "project_id",
+		"api_id":               "api_id",
+		"version_id":           "version_id",
+		"spec_id":              "spec_id",
+		"filename":             "file_name",
+		"description":          "description",
+		"create_time":          "create_time",
+		"revision_create_time": "revision_create_time",
+		"revision_update_time": "revision_update_time",
+		"mime_type":            "mime_type",
+		"size_bytes":           "size_in_bytes",
+		"source_uri":           "source_uri",
+		"labels":               "labels",
+	},
+	"deployments": {
+		"project_id":           "project_id",
+		"api_id":               "api_id",
+		"deployment_id":        "deployment_id",
+		"display_name":         "display_name",
+		"description":          "description",
+		"create_time":          "create_time",
+		"revision_create_time": "revision_create_time",
+		"revision_update_time": "revision_update_time",
+		"api_spec_revision":    "api_spec_revision",
+		"endpoint_uri":         "endpoint_uri",
+		"external_channel_uri": "external_channel_uri",
+		"intended_audience":    "intended_audience",
+		"access_guidance":      "access_guidance",
+		"labels":               "labels",
+	},
+	"artifacts": {
+		"project_id":    "project_id",
+		"api_id":        "api_id",
+		"version_id":    "version_id",
+		"spec_id":       "spec_id",
+		"artifact_id":   "artifact_id",
+		"deployment_id": "deployment_id",
+		"create_time":   "create_time",
+		"update_time":   "update_time",
+		"mime_type":     "mime_type",
+		"size_bytes":    "size_in_bytes",
+		"labels":        "labels",
+	},
+	"audit_entries": {
+		"sequence":    "sequence",
+		"create_time": "create_time",
+		"principal":   "principal",
+		"method":      "method",
+		"resource":    "resource",
+		"project_id":  "project_id",
+		"change":      "change",
+		"before_hash": "before_hash",
+		"after_hash":  "after_hash",
+	},
+}
+
+// pushDownFilter adds as much of a filter as possible to a query on the given table.
+// It returns the filter that must still be evaluated against each row that the query
+// returns, which is empty if the database evaluates the entire filter.
+func (c *Client) pushDownFilter(op *gorm.DB, filter filtering.Filter, table string) (*gorm.DB, filtering.Filter) {
+	if filter.Empty() {
+		return op, filter
+	}
+
+	// Qualify column names because some listings join tables with the same columns.
+	qualify := qualifier(table)
+	columns := make(map[string]string, len(tableColumnsLookup[table]))
+	for field, column := range tableColumnsLookup[table] {
+		columns[field] = qualify(column)
+	}
+
+	condition := filter.SQL(c.db.Dialector.Name(), columns)
+	if condition.Query != "" {
+		op = op.Where(condition.Query, condition.Args...)
+	}
+	if condition.Exact {
+		return op, filtering.Filter{}
+	}
+	return op, filter
+}
+
+// qualifier returns a function that qualifies column names with a table name.
+func qualifier(table string) func(string) string {
+	return func(column string) string {
+		return table + "." + column
+	}
+}
+
+// qualifyRevisionedArtifacts qualifies the columns of artifacts that are joined with
+// the latest revisions of their parents. The revision creation time comes from the parent.
+func qualifyRevisionedArtifacts(column string) string {
+	if column == "revision_create_time" {
+		return "latest." + column
+	}
+	return "artifacts." + column
+}
+
 // gormOrdering accepts a user-specified order_by string and returns a gorm-compatible equivalent.
 // For example, the user-specified string `name,description` returns `key,description`.
 // An error is returned if the string is invalid or refers to a field that isn't included in the `fields` map.
@@ -163,6 +297,7 @@ func gormOrdering(ordering, table string) (string, error) {
 	}
 
 	clauses := make([]string, 0)
+	hasKey := false
 	for _, v := range strings.Split(ordering, ",") {
 		v = strings.TrimSpace(v)
 
@@ -191,6 +326,7 @@ func gormOrdering(ordering, table string) (string, error) {
 		if clause == "" {
 			return ordering, status.Errorf(codes.InvalidArgument, "unknown field name %q in %q", v, table)
 		}
+		hasKey = hasKey || clause == "key"
 
 		if descending {
 			clause += " desc"
@@ -199,14 +335,32 @@ func gormOrdering(ordering, table string) (string, error) {
 		clauses = append(clauses, clause)
 	}
 
+	// Break ties with the primary key so that every listing has a total order.
+	// Page tokens record positions in this order.
+	if !hasKey {
+		clauses = append(clauses, "key")
+	}
+
 	return strings.Join(clauses, ","), nil
 }
 
+// orderBy converts an ordering returned by gormOrdering() into an ORDER BY clause.
+// Column names are quoted because "key" is a reserved word in MySQL.
+func orderBy(ordering string) clause.OrderBy {
+	columns := orderColumns(ordering)
+	by := clause.OrderBy{Columns: make([]clause.OrderByColumn, len(columns))}
+	for i, c := range columns {
+		by.Columns[i] = clause.OrderByColumn{Column: clause.Column{Name: c.Name}, Desc: c.Descending}
+	}
+	return by
+}
+
 // limit returns the database page size to use for a listing request.
-func limit(opts PageOptions) int {
+// The filter is the part of the request filter that is evaluated in memory.
+func limit(opts PageOptions, filter filtering.Filter) int {
 	// Without filters, read exactly enough rows to fill the page,
 	// plus an extra row to check if another page exists.
-	if opts.Filter == "" {
+	if filter.Empty() {
 		return int(opts.Size) + 1
 	}
 
@@ -221,7 +375,7 @@ type ProjectList struct {
 }
 
 func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ProjectList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -252,12 +406,17 @@ func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectLis
 		Projects: make([]models.Project, 0, opts.Size),
 	}
 
+	op, filter := c.pushDownFilter(c.db.WithContext(ctx).Clauses(orderBy(order)), filter, "projects")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
+
 	for {
 		var page []models.Project
-		op := c.db.WithContext(ctx).Order(order).Limit(limit(opts))
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("projects"))
 		if err != nil {
+			return ProjectList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return ProjectList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -268,24 +427,29 @@ func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectLis
 			if err != nil {
 				return ProjectList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Projects) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Projects[len(response.Projects)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return ProjectList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return ProjectList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Projects = append(response.Projects, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return ProjectList{}, err
+		}
 	}
 
 	return response, nil
@@ -309,7 +473,7 @@ type ApiList struct {
 }
 
 func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOptions) (ApiList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ApiList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -326,8 +490,10 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 		token.Order = opts.Order
 	}
 
-	op := c.db.WithContext(ctx).
-		Limit(limit(opts))
+	op := c.db.WithContext(ctx)
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
 
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
@@ -340,12 +506,15 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 	if err != nil {
 		return ApiList{}, err
 	}
+	op, filter = c.pushDownFilter(op, filter, "apis")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "apis"); err != nil {
+	order, err := gormOrdering(opts.Order, "apis")
+	if err != nil {
 		return ApiList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := ApiList{
 		Apis: make([]models.Api, 0, opts.Size),
@@ -353,9 +522,11 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 
 	for {
 		var page []models.Api
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("apis"))
 		if err != nil {
+			return ApiList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return ApiList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -371,24 +542,29 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 			if err != nil {
 				return ApiList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Apis) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Apis[len(response.Apis)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return ApiList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return ApiList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Apis = append(response.Apis, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return ApiList{}, err
+		}
 	}
 
 	return response, nil
@@ -421,7 +597,7 @@ type VersionList struct {
 }
 
 func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOptions) (VersionList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return VersionList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -453,19 +629,22 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		return VersionList{}, err
 	}
 
-	op := c.db.WithContext(ctx).Limit(limit(opts))
+	op := c.db.WithContext(ctx)
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
 	}
 	if parent.ApiID != "-" {
 		op = op.Where("api_id = ?", parent.ApiID)
 	}
+	op, filter = c.pushDownFilter(op, filter, "versions")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "versions"); err != nil {
+	order, err := gormOrdering(opts.Order, "versions")
+	if err != nil {
 		return VersionList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := VersionList{
 		Versions: make([]models.Version, 0, opts.Size),
@@ -473,9 +652,11 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 
 	for {
 		var page []models.Version
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("versions"))
 		if err != nil {
+			return VersionList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return VersionList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -491,24 +672,29 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 			if err != nil {
 				return VersionList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Versions) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Versions[len(response.Versions)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return VersionList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return VersionList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Versions = append(response.Versions, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return VersionList{}, err
+		}
 	}
 
 	return response, nil
@@ -542,7 +728,7 @@ type SpecList struct {
 }
 
 func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageOptions) (SpecList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -585,8 +771,7 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		AND specs.api_id = latest.api_id
 		AND specs.version_id = latest.version_id
 		AND specs.spec_id = latest.spec_id
-		AND specs.revision_id = latest.revision_id`, c.latestSpecRevisionsQuery(ctx)).
-		Limit(limit(opts))
+		AND specs.revision_id = latest.revision_id`, c.latestSpecRevisionsQuery(ctx))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("specs.project_id = ?", parent.ProjectID)
@@ -597,12 +782,15 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 	if parent.VersionID != "-" {
 		op = op.Where("specs.version_id = ?", parent.VersionID)
 	}
+	op, filter = c.pushDownFilter(op, filter, "specs")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "specs"); err != nil {
+	order, err := gormOrdering(opts.Order, "specs")
+	if err != nil {
 		return SpecList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := SpecList{
 		Specs: make([]models.Spec, 0, opts.Size),
@@ -610,9 +798,11 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 
 	for {
 		var page []models.Spec
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("specs"))
 		if err != nil {
+			return SpecList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return SpecList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -628,24 +818,29 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 			if err != nil {
 				return SpecList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Specs) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Specs[len(response.Specs)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return SpecList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return SpecList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Specs = append(response.Specs, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return SpecList{}, err
+		}
 	}
 
 	return response, nil
@@ -678,7 +873,7 @@ func specMap(spec models.Spec) (map[string]interface{}, error) {
 }
 
 func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevision, opts PageOptions) (SpecList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -691,9 +886,13 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 
 	// Check existence of the deepest fully specified resource in the parent name.
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.VersionID != "-" && parent.SpecID != "-" && parent.RevisionID != "-" {
-		if _, err := c.GetSpecRevision(ctx, parent); err != nil {
+		revision, err := c.GetSpecRevision(ctx, parent)
+		if err != nil {
 			return SpecList{}, err
 		}
+		if parent.RevisionID != "" { // select the revision that a tag or relative reference refers to
+			parent.RevisionID = revision.RevisionID
+		}
 	} else if parent.ProjectID != "-" && parent.ApiID != "-" && parent.VersionID != "-" && parent.SpecID != "-" {
 		if _, err := c.GetSpec(ctx, parent.Spec()); err != nil {
 			return SpecList{}, err
@@ -717,9 +916,7 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 		return SpecList{}, err
 	}
 
-	op := c.db.WithContext(ctx).
-		Offset(token.Offset).
-		Limit(int(opts.Size) + 1)
+	op := c.db.WithContext(ctx)
 
 	if id := parent.ProjectID; id != "-" {
 		op = op.Where("specs.project_id = ?", id)
@@ -736,12 +933,15 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 	if id := parent.RevisionID; id != "-" && id != "" { // select specific spec revision
 		op = op.Where("specs.revision_id = ?", id)
 	}
+	op, filter = c.pushDownFilter(op, filter, "specs")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "specs"); err != nil {
+	order, err := gormOrdering(opts.Order, "specs")
+	if err != nil {
 		return SpecList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := SpecList{
 		Specs: make([]models.Spec, 0, opts.Size),
@@ -749,9 +949,11 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 
 	for {
 		var page []models.Spec
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("specs"))
 		if err != nil {
+			return SpecList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return SpecList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -767,24 +969,29 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 			if err != nil {
 				return SpecList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Specs) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Specs[len(response.Specs)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return SpecList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return SpecList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Specs = append(response.Specs, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return SpecList{}, err
+		}
 	}
 
 	return response, nil
@@ -797,7 +1004,7 @@ type DeploymentList struct {
 }
 
 func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts PageOptions) (DeploymentList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -835,8 +1042,7 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		ON deployments.project_id = latest.project_id
 		AND deployments.api_id = latest.api_id
 		AND deployments.deployment_id = latest.deployment_id
-		AND deployments.revision_id = latest.revision_id`, c.latestDeploymentRevisionsQuery(ctx)).
-		Limit(limit(opts))
+		AND deployments.revision_id = latest.revision_id`, c.latestDeploymentRevisionsQuery(ctx))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("deployments.project_id = ?", parent.ProjectID)
@@ -844,12 +1050,15 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 	if parent.ApiID != "-" {
 		op = op.Where("deployments.api_id = ?", parent.ApiID)
 	}
+	op, filter = c.pushDownFilter(op, filter, "deployments")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "deployments"); err != nil {
+	order, err := gormOrdering(opts.Order, "deployments")
+	if err != nil {
 		return DeploymentList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := DeploymentList{
 		Deployments: make([]models.Deployment, 0, opts.Size),
@@ -857,9 +1066,11 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 
 	for {
 		var page []models.Deployment
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("deployments"))
 		if err != nil {
+			return DeploymentList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return DeploymentList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -875,24 +1086,29 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 			if err != nil {
 				return DeploymentList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Deployments) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Deployments[len(response.Deployments)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return DeploymentList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return DeploymentList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Deployments = append(response.Deployments, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return DeploymentList{}, err
+		}
 	}
 
 	return response, nil
@@ -925,7 +1141,7 @@ func deploymentMap(deployment models.Deployment) (map[string]interface{}, error)
 }
 
 func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.DeploymentRevision, opts PageOptions) (DeploymentList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -943,9 +1159,13 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 
 	// Check existence of the deepest fully specified resource in the parent name.
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.DeploymentID != "-" && parent.RevisionID != "-" {
-		if _, err := c.GetDeploymentRevision(ctx, parent); err != nil {
+		revision, err := c.GetDeploymentRevision(ctx, parent)
+		if err != nil {
 			return DeploymentList{}, err
 		}
+		if parent.RevisionID != "" { // select the revision that a tag or relative reference refers to
+			parent.RevisionID = revision.RevisionID
+		}
 	} else if parent.ProjectID != "-" && parent.ApiID != "-" && parent.DeploymentID != "-" {
 		if _, err := c.GetDeployment(ctx, parent.Deployment()); err != nil {
 			return DeploymentList{}, err
@@ -960,9 +1180,7 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 		}
 	}
 
-	op := c.db.WithContext(ctx).
-		Offset(token.Offset).
-		Limit(int(opts.Size) + 1)
+	op := c.db.WithContext(ctx)
 
 	if id := parent.ProjectID; id != "-" {
 		op = op.Where("deployments.project_id = ?", id)
@@ -976,12 +1194,15 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 	if id := parent.RevisionID; id != "-" && id != "" { // select specific spec revision
 		op = op.Where("deployments.revision_id = ?", id)
 	}
+	op, filter = c.pushDownFilter(op, filter, "deployments")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
-	if order, err := gormOrdering(opts.Order, "deployments"); err != nil {
+	order, err := gormOrdering(opts.Order, "deployments")
+	if err != nil {
 		return DeploymentList{}, err
-	} else {
-		op = op.Order(order)
 	}
+	op = op.Clauses(orderBy(order))
 
 	response := DeploymentList{
 		Deployments: make([]models.Deployment, 0, opts.Size),
@@ -989,9 +1210,11 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 
 	for {
 		var page []models.Deployment
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		q, err := afterPosition(op, order, token.Position, qualifier("deployments"))
 		if err != nil {
+			return DeploymentList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return DeploymentList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
@@ -1007,24 +1230,29 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 			if err != nil {
 				return DeploymentList{}, err
 			} else if !match {
-				token.Offset++
 				continue
 			}
 
 			if len(response.Deployments) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				last := &response.Deployments[len(response.Deployments)-1]
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return DeploymentList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return DeploymentList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
 			response.Deployments = append(response.Deployments, v)
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return DeploymentList{}, err
+		}
 	}
 
 	return response, nil
@@ -1041,7 +1269,7 @@ func (c *Client) ListSpecArtifacts(ctx context.Context, parent names.Spec, opts
 }
 
 func (c *Client) ListSpecRevisionArtifacts(ctx context.Context, parent names.SpecRevision, opts PageOptions) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -1101,7 +1329,7 @@ func (c *Client) ListSpecRevisionArtifacts(ctx context.Context, parent names.Spe
 	if id := parent.RevisionID; id != "-" && id != "" { // select specific spec revision
 		op = op.Where("artifacts.revision_id = ?", id)
 	}
-	orderTable := "artifacts"
+	orderTable, qualify := "artifacts", qualifier("artifacts")
 	if id := parent.RevisionID; id == "" { // select latest spec revision
 		op = op.Select("artifacts.*,revision_create_time").Table("artifacts").
 			Where(`artifacts.deployment_id = ''`).
@@ -1111,16 +1339,15 @@ func (c *Client) ListSpecRevisionArtifacts(ctx context.Context, parent names.Spe
 			AND artifacts.version_id = latest.version_id
 			AND artifacts.spec_id = latest.spec_id
 			AND artifacts.revision_id = latest.revision_id`, c.latestSpecRevisionsQuery(ctx))
-		orderTable = "revisioned_artifacts"
+		orderTable, qualify = "revisioned_artifacts", qualifyRevisionedArtifacts
 	}
 
-	if order, err := gormOrdering(opts.Order, orderTable); err != nil {
+	order, err := gormOrdering(opts.Order, orderTable)
+	if err != nil {
 		return ArtifactList{}, err
-	} else {
-		op = op.Order(order)
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
+	return c.listArtifacts(ctx, op.Clauses(orderBy(order)), order, qualify, opts, func(a *models.Artifact) bool {
 		return a.ProjectID != "" && a.ApiID != "" && a.VersionID != "" && a.SpecID != "" && a.RevisionID != ""
 	})
 }
@@ -1138,7 +1365,7 @@ func (c *Client) latestSpecRevisionsQuery(ctx context.Context) *gorm.DB {
 }
 
 func (c *Client) ListVersionArtifacts(ctx context.Context, parent names.Version, opts PageOptions) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -1182,13 +1409,12 @@ func (c *Client) ListVersionArtifacts(ctx context.Context, parent names.Version,
 		op = op.Where("version_id = ?", id)
 	}
 
-	if order, err := gormOrdering(opts.Order, "artifacts"); err != nil {
+	order, err := gormOrdering(opts.Order, "artifacts")
+	if err != nil {
 		return ArtifactList{}, err
-	} else {
-		op = op.Order(order)
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
+	return c.listArtifacts(ctx, op.Clauses(orderBy(order)), order, qualifier("artifacts"), opts, func(a *models.Artifact) bool {
 		return a.ProjectID != "" && a.ApiID != "" && a.VersionID != ""
 	})
 }
@@ -1198,7 +1424,7 @@ func (c *Client) ListDeploymentArtifacts(ctx context.Context, parent names.Deplo
 }
 
 func (c *Client) ListDeploymentRevisionArtifacts(ctx context.Context, parent names.DeploymentRevision, opts PageOptions) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -1251,7 +1477,7 @@ func (c *Client) ListDeploymentRevisionArtifacts(ctx context.Context, parent nam
 	if id := parent.RevisionID; id != "-" && id != "" { // select specific deployment revision
 		op = op.Where("artifacts.revision_id = ?", id)
 	}
-	orderTable := "artifacts"
+	orderTable, qualify := "artifacts", qualifier("artifacts")
 	if id := parent.RevisionID; id == "" { // select latest deployment revision
 		op = op.Select("artifacts.*,revision_create_time").Table("artifacts").
 			Where(`artifacts.spec_id = ''`).
@@ -1260,16 +1486,15 @@ func (c *Client) ListDeploymentRevisionArtifacts(ctx context.Context, parent nam
 			AND artifacts.api_id = latest.api_id
 			AND artifacts.deployment_id = latest.deployment_id
 			AND artifacts.revision_id = latest.revision_id`, c.latestDeploymentRevisionsQuery(ctx))
-		orderTable = "revisioned_artifacts"
+		orderTable, qualify = "revisioned_artifacts", qualifyRevisionedArtifacts
 	}
 
-	if order, err := gormOrdering(opts.Order, orderTable); err != nil {
+	order, err := gormOrdering(opts.Order, orderTable)
+	if err != nil {
 		return ArtifactList{}, err
-	} else {
-		op = op.Order(order)
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
+	return c.listArtifacts(ctx, op.Clauses(orderBy(order)), order, qualify, opts, func(a *models.Artifact) bool {
 		return a.ProjectID != "" && a.ApiID != "" && a.DeploymentID != "" && a.RevisionID != ""
 	})
 }
@@ -1287,7 +1512,7 @@ func (c *Client) latestDeploymentRevisionsQuery(ctx context.Context) *gorm.DB {
 }
 
 func (c *Client) ListApiArtifacts(ctx context.Context, parent names.Api, opts PageOptions) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -1326,19 +1551,18 @@ func (c *Client) ListApiArtifacts(ctx context.Context, parent names.Api, opts Pa
 		op = op.Where("api_id = ?", id)
 	}
 
-	if order, err := gormOrdering(opts.Order, "artifacts"); err != nil {
+	order, err := gormOrdering(opts.Order, "artifacts")
+	if err != nil {
 		return ArtifactList{}, err
-	} else {
-		op = op.Order(order)
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
+	return c.listArtifacts(ctx, op.Clauses(orderBy(order)), order, qualifier("artifacts"), opts, func(a *models.Artifact) bool {
 		return a.ProjectID != "" && a.ApiID != ""
 	})
 }
 
 func (c *Client) ListProjectArtifacts(ctx context.Context, parent names.Project, opts PageOptions) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	}
@@ -1368,69 +1592,87 @@ func (c *Client) ListProjectArtifacts(ctx context.Context, parent names.Project,
 		}
 	}
 
-	if order, err := gormOrdering(opts.Order, "artifacts"); err != nil {
+	order, err := gormOrdering(opts.Order, "artifacts")
+	if err != nil {
 		return ArtifactList{}, err
-	} else {
-		op = op.Order(order)
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
+	return c.listArtifacts(ctx, op.Clauses(orderBy(order)), order, qualifier("artifacts"), opts, func(a *models.Artifact) bool {
 		return a.ProjectID != ""
 	})
 }
 
-func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOptions, include func(*models.Artifact) bool) (ArtifactList, error) {
-	token, err := decodeToken(opts.Token)
+// artifactRow is a row of an artifact listing. Listings of the artifacts of the latest
+// revisions of specs and deployments also include the creation time of the revision,
+// which page tokens need when the listing is ordered by it.
+type artifactRow struct {
+	models.Artifact    `gorm:"embedded"`
+	RevisionCreateTime time.Time
+}
+
+func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, order string, qualify func(string) string, opts PageOptions, include func(*models.Artifact) bool) (ArtifactList, error) {
+	token, err := c.decodeToken(ctx, opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	} else {
 		token.Filter = opts.Filter
+		token.Order = opts.Order
 	}
 
 	filter, err := filtering.NewFilter(opts.Filter, artifactFields)
 	if err != nil {
 		return ArtifactList{}, err
 	}
+	op, filter = c.pushDownFilter(op, filter, "artifacts")
+	lim := limit(opts, filter)
+	op = op.Limit(lim)
 
 	response := ArtifactList{
 		Artifacts: make([]models.Artifact, 0, opts.Size),
 	}
 
+	var last *artifactRow
 	for {
-		var page []models.Artifact
-		op.Limit(limit(opts))
-		err := op.Offset(token.Offset).Find(&page).Error
-
+		var page []artifactRow
+		q, err := afterPosition(op.Table("artifacts"), order, token.Position, qualify)
 		if err != nil {
+			return ArtifactList{}, err
+		}
+		if err := q.Find(&page).Error; err != nil {
 			return ArtifactList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
 		} else if len(page) == 0 {
 			break
 		}
 
-		for _, v := range page {
-			m := artifactMap(v)
+		for i, v := range page {
+			m := artifactMap(v.Artifact)
 			match, err := filter.Matches(m)
 			if err != nil {
 				return ArtifactList{}, err
-			} else if !match || !include(&v) {
-				token.Offset++
+			} else if !match || !include(&v.Artifact) {
 				continue
 			}
 
 			if len(response.Artifacts) == int(opts.Size) {
-				response.Token, err = encodeToken(token)
+				if token.Position, err = c.positionOf(ctx, last, order); err != nil {
+					return ArtifactList{}, err
+				}
+				response.Token, err = c.encodeToken(ctx, token)
 				if err != nil {
 					return ArtifactList{}, status.Error(codes.Internal, err.Error())
 				}
 				return response, nil
 			}
 
-			token.Offset++
-			response.Artifacts = append(response.Artifacts, v)
+			response.Artifacts = append(response.Artifacts, v.Artifact)
+			last = &page[i]
 		}
-		if op.RowsAffected < int64(opts.Size) {
+		if len(page) < lim {
 			break
 		}
+		if token.Position, err = c.positionOf(ctx, &page[len(page)-1], order); err != nil {
+			return ArtifactList{}, err
+		}
 	}
 
 	return response, nil
@@ -17,39 +17,71 @@ package storage
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 
 	_ "github.com/GoogleCloudPlatform/cloudsql-proxy/proxy/dialers/postgres"
 )
 
-func (c *Client) lockTable(ctx context.Context, name string) *Client {
-	// The LOCK TABLE statement below is unavailable in SQLite.
-	// For SQLite, we instead lock all mutating transactions. See RegistryServer.runInTransaction().
-	if c.DatabaseName(ctx) == "sqlite" {
+// lockTable serializes the transactions that lock the rows of a table that belong to a project.
+// Transactions that lock rows of different projects don't block each other.
+// Locks are held until the transaction ends.
+func (c *Client) lockTable(ctx context.Context, name, project string) *Client {
+	switch c.DatabaseName(ctx) {
+	case "sqlite":
+		// SQLite write transactions hold the database write lock from when they begin.
+		// See sqliteSettings.
 		return c
+	case "mysql":
+		// In MySQL, LOCK TABLES commits the current transaction. Instead, a locking read of
+		// every row takes next-key locks on the whole table, which block other transactions
+		// from inserting, updating or deleting its rows until this transaction ends.
+		return &Client{db: c.db.Exec(fmt.Sprintf("SELECT COUNT(*) FROM %s FOR UPDATE", name)), cache: c.cache, blobs: c.blobs, changes: c.changes}
 	}
-	return &Client{db: c.db.Exec(fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", name))}
+	return &Client{db: c.db.Exec("SELECT pg_advisory_xact_lock(?)", lockKey(name, project)), cache: c.cache, blobs: c.blobs, changes: c.changes}
 }
 
-func (c *Client) LockProjects(ctx context.Context) *Client {
-	return c.lockTable(ctx, "projects")
+// lockKey returns the key of the Postgres advisory lock for the rows of a table in a project.
+// Keys of different tables and projects can collide, which only serializes more transactions.
+func lockKey(table, project string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(table + "/" + project))
+	return int64(h.Sum64())
 }
 
-func (c *Client) LockApis(ctx context.Context) *Client {
-	return c.lockTable(ctx, "apis")
+// LockQuota serializes the transactions that check and consume the quotas of a project.
+// Creations of APIs, specs and artifacts take different table locks, so quota checks take this one.
+// Blob contents are serialized separately by their rows (see acquireContents).
+func (c *Client) LockQuota(ctx context.Context, project string) *Client {
+	switch c.DatabaseName(ctx) {
+	case "sqlite":
+		return c
+	case "mysql":
+		// A locking read of the project row blocks other quota checks of the project.
+		return &Client{db: c.db.Exec("SELECT COUNT(*) FROM projects WHERE project_id = ? FOR UPDATE", project), cache: c.cache, blobs: c.blobs, changes: c.changes}
+	}
+	return &Client{db: c.db.Exec("SELECT pg_advisory_xact_lock(?)", lockKey("quota", project)), cache: c.cache, blobs: c.blobs, changes: c.changes}
+}
+
+func (c *Client) LockProjects(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "projects", project)
+}
+
+func (c *Client) LockApis(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "apis", project)
 }
 
-func (c *Client) LockVersions(ctx context.Context) *Client {
-	return c.lockTable(ctx, "versions")
+func (c *Client) LockVersions(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "versions", project)
 }
 
-func (c *Client) LockDeployments(ctx context.Context) *Client {
-	return c.lockTable(ctx, "deployments")
+func (c *Client) LockDeployments(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "deployments", project)
 }
 
-func (c *Client) LockSpecs(ctx context.Context) *Client {
-	return c.lockTable(ctx, "specs")
+func (c *Client) LockSpecs(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "specs", project)
 }
 
-func (c *Client) LockArtifacts(ctx context.Context) *Client {
-	return c.lockTable(ctx, "artifacts")
+func (c *Client) LockArtifacts(ctx context.Context, project string) *Client {
+	return c.lockTable(ctx, "artifacts", project)
 }
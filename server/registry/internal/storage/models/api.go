@@ -21,22 +21,24 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Api is the storage-side representation of an API.
 type Api struct {
-	Key                   string    `gorm:"primaryKey"`
-	ProjectID             string    // Uniquely identifies a project.
-	ApiID                 string    // Uniquely identifies an api within a project.
-	DisplayName           string    // A human-friendly name.
-	Description           string    // A detailed description.
-	CreateTime            time.Time // Creation time.
-	UpdateTime            time.Time // Time of last change.
-	Availability          string    // Availability of the API.
-	RecommendedVersion    string    // Recommended API version.
-	RecommendedDeployment string    // Recommended API deployment.
-	Labels                []byte    // Serialized labels.
-	Annotations           []byte    // Serialized annotations.
+	Key                   string         `gorm:"primaryKey"`
+	ProjectID             string         // Uniquely identifies a project.
+	ApiID                 string         // Uniquely identifies an api within a project.
+	DisplayName           string         // A human-friendly name.
+	Description           string         // A detailed description.
+	CreateTime            time.Time      // Creation time.
+	UpdateTime            time.Time      // Time of last change.
+	Availability          string         // Availability of the API.
+	RecommendedVersion    string         // Recommended API version.
+	RecommendedDeployment string         // Recommended API deployment.
+	Labels                []byte         // Serialized labels.
+	Annotations           []byte         // Serialized annotations.
+	DeleteTime            gorm.DeletedAt `gorm:"index"` // Time of deletion, if the API is deleted.
 	ParentProjectKey      string
 	ParentProject         *Project `gorm:"foreignKey:ParentProjectKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -101,6 +103,15 @@ func (api *Api) Message() (message *rpc.Api, err error) {
 		return nil, err
 	}
 
+	if api.DeleteTime.Valid {
+		message.DeleteTime = timestamppb.New(api.DeleteTime.Time)
+	}
+
+	message.Etag, err = etag(message)
+	if err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
@@ -0,0 +1,74 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditEntry is the storage-side representation of an entry of the audit log.
+// Entries are written in the same transaction as the changes they record and
+// are kept when the resources that they describe are deleted.
+type AuditEntry struct {
+	Sequence   int64     `gorm:"primaryKey;autoIncrement"`
+	CreateTime time.Time // Time of the change.
+	ProjectID  string    // Project of the changed resource.
+	Resource   string    // The name of the changed resource.
+	Change     string    // The type of change.
+	Method     string    // The method that made the change.
+	Principal  string    // The caller that made the change.
+	UpdateMask string    // Comma-separated paths of the updated fields.
+	BeforeHash string    // Hash of the resource before the change.
+	AfterHash  string    // Hash of the resource after the change.
+}
+
+// NewAuditEntry initializes a new audit entry.
+func NewAuditEntry(change rpc.Notification_Change, resource string, mask *fieldmaskpb.FieldMask) *AuditEntry {
+	projectID := ""
+	if rest, ok := strings.CutPrefix(resource, "projects/"); ok {
+		projectID, _, _ = strings.Cut(rest, "/")
+	}
+	return &AuditEntry{
+		CreateTime: time.Now().Round(time.Microsecond),
+		ProjectID:  projectID,
+		Resource:   resource,
+		Change:     change.String(),
+		UpdateMask: strings.Join(mask.GetPaths(), ","),
+	}
+}
+
+// Message returns a message representing an audit entry.
+func (e *AuditEntry) Message() *rpc.AuditEntry {
+	var paths []string
+	if e.UpdateMask != "" {
+		paths = strings.Split(e.UpdateMask, ",")
+	}
+	return &rpc.AuditEntry{
+		Sequence:        e.Sequence,
+		CreateTime:      timestamppb.New(e.CreateTime),
+		Principal:       e.Principal,
+		Method:          e.Method,
+		Resource:        e.Resource,
+		Change:          rpc.Notification_Change(rpc.Notification_Change_value[e.Change]),
+		UpdateMaskPaths: paths,
+		BeforeHash:      e.BeforeHash,
+		AfterHash:       e.AfterHash,
+	}
+}
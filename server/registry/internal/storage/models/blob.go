@@ -14,9 +14,15 @@
 
 package models
 
-import "time"
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
 
 // Blob is the storage-side representation of a blob.
+// Blobs are stored for each spec revision and artifact, but their contents are
+// stored once for each distinct value and shared by all blobs with that value.
 type Blob struct {
 	Key          string    `gorm:"primaryKey"`
 	ProjectID    string    // Uniquely identifies a project.
@@ -28,11 +34,43 @@ type Blob struct {
 	ArtifactID   string    // Uniquely identifies an artifact on a resource.
 	Hash         string    // Hash of the blob contents.
 	SizeInBytes  int32     // Size of the blob contents.
-	Contents     []byte    // The contents of the blob.
+	ContentsHash string    `gorm:"index"` // Key of the stored contents.
+	Contents     []byte    `gorm:"-"`     // The contents of the blob, which are stored as BlobContents.
 	CreateTime   time.Time // Creation time.
 	UpdateTime   time.Time // Time of last change.
 }
 
+// BlobContents is the storage-side representation of the contents of blobs.
+// Contents are stored once and counted references are held by each blob that uses them.
+// When an external blob store is configured, the contents are kept there and only their
+// hash, size and location are stored in the database.
+type BlobContents struct {
+	Hash        string    `gorm:"primaryKey"` // SHA-256 hash of the stored contents.
+	Contents    []byte    // The stored contents, which may be compressed; empty if they are stored at Location.
+	Location    string    // Location of contents kept in an external blob store; empty if they are in the database.
+	SizeInBytes int64     // Size of the stored contents.
+	RefCount    int64     // Number of blobs that use the contents.
+	CreateTime  time.Time // Creation time.
+}
+
+// NewBlobContents creates a new BlobContents object with a single reference.
+func NewBlobContents(contents []byte) *BlobContents {
+	return &BlobContents{
+		Hash:        ContentsHash(contents),
+		Contents:    contents,
+		SizeInBytes: int64(len(contents)),
+		RefCount:    1,
+		CreateTime:  time.Now().Round(time.Microsecond),
+	}
+}
+
+// ContentsHash returns the key of stored contents. Unlike the hashes of specs and
+// artifacts, it is computed from the stored bytes, so compressed and uncompressed
+// copies of the same contents are stored separately.
+func ContentsHash(contents []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(contents))
+}
+
 // NewBlobForSpec creates a new Blob object to store spec contents.
 func NewBlobForSpec(spec *Spec, contents []byte) *Blob {
 	now := time.Now().Round(time.Microsecond)
@@ -22,27 +22,29 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Deployment is the storage-side representation of a deployment.
 type Deployment struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    `gorm:"index:idx_latest"` // Uniquely identifies an api within a project.
-	DeploymentID       string    `gorm:"index:idx_latest"` // Uniquely identifies a deployment within an api.
-	RevisionID         string    // Uniquely identifies a revision of a deployment.
-	DisplayName        string    // A human-friendly name.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time `gorm:"index:idx_latest,sort:desc"` // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	ApiSpecRevision    string    // The spec being served by the deployment.
-	EndpointURI        string    // The address where the deployment is serving.
-	ExternalChannelURI string    // The address of the external channel of the API.
-	IntendedAudience   string    // The intended audience of the API.
-	AccessGuidance     string    // A brief description of how to access the endpoint.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         `gorm:"index:idx_latest"` // Uniquely identifies an api within a project.
+	DeploymentID       string         `gorm:"index:idx_latest"` // Uniquely identifies a deployment within an api.
+	RevisionID         string         // Uniquely identifies a revision of a deployment.
+	DisplayName        string         // A human-friendly name.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      `gorm:"index:idx_latest,sort:desc"` // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	ApiSpecRevision    string         // The spec being served by the deployment.
+	EndpointURI        string         // The address where the deployment is serving.
+	ExternalChannelURI string         // The address of the external channel of the API.
+	IntendedAudience   string         // The intended audience of the API.
+	AccessGuidance     string         // A brief description of how to access the endpoint.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Time of deletion, if the resource belongs to a deleted API.
 	ParentApiKey       string
 	ParentApi          *Api `gorm:"foreignKey:ParentApiKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -145,6 +147,11 @@ func (s *Deployment) BasicMessage(name string) (message *rpc.ApiDeployment, err
 		return nil, err
 	}
 
+	message.Etag, err = etag(message)
+	if err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
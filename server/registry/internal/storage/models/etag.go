@@ -0,0 +1,39 @@
+// Copyright 2021 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// etag computes a checksum of a resource message that changes whenever any
+// of its fields change. The name is excluded so that a resource has the same
+// etag however it is referenced (for example, with or without a revision ID).
+func etag(m proto.Message) (string, error) {
+	r := m.ProtoReflect()
+	field := r.Descriptor().Fields().ByName("name")
+	name := r.Get(field)
+	r.Clear(field)
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	r.Set(field, name)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
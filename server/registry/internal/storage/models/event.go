@@ -0,0 +1,54 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event is the storage-side representation of a notification.
+// Events are written in the same transaction as the changes they describe
+// and are delivered to notification sinks after the transaction commits.
+type Event struct {
+	Sequence     int64      `gorm:"primaryKey;autoIncrement"`
+	Change       int32      // The type of change.
+	Resource     string     // The name of the changed resource.
+	ChangeTime   time.Time  `gorm:"index"` // Time of the change.
+	Dispatched   bool       `gorm:"index"` // True once delivered to all sinks.
+	ClaimedBy    string     // The dispatcher that is delivering the event.
+	ClaimedUntil *time.Time // Time when the claim of the dispatcher expires.
+}
+
+// NewEvent initializes a new event.
+func NewEvent(change rpc.Notification_Change, resource string) *Event {
+	return &Event{
+		Change:     int32(change),
+		Resource:   resource,
+		ChangeTime: time.Now().Round(time.Microsecond),
+	}
+}
+
+// Message returns the notification represented by an event.
+func (e *Event) Message() *rpc.Notification {
+	return &rpc.Notification{
+		Change:     rpc.Notification_Change(e.Change),
+		Resource:   e.Resource,
+		ChangeTime: timestamppb.New(e.ChangeTime),
+		Sequence:   e.Sequence,
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxSearchContents is the largest number of bytes of spec contents that are indexed for search.
+// Postgres limits the size of text search vectors, so longer contents are truncated.
+const MaxSearchContents = 512 * 1024
+
+// SearchDocument is the storage-side representation of the searchable text of a resource.
+// Documents are kept for APIs, versions, specs and deployments and describe the latest
+// revision of resources that have revisions.
+type SearchDocument struct {
+	Key          string `gorm:"primaryKey"` // Resource name.
+	ProjectID    string `gorm:"index"`      // Uniquely identifies a project.
+	ApiID        string // Uniquely identifies an api within a project.
+	VersionID    string // Uniquely identifies a version within an api.
+	SpecID       string // Uniquely identifies a spec within a version.
+	DeploymentID string // Uniquely identifies a deployment within an api.
+	Title        string // Resource ID and human-friendly name.
+	Description  string // A detailed description.
+	Contents     string // Text of the spec contents.
+}
+
+// NewSearchDocumentForApi creates the search document of an API.
+func NewSearchDocumentForApi(api *Api) *SearchDocument {
+	return &SearchDocument{
+		Key:         api.Name(),
+		ProjectID:   api.ProjectID,
+		ApiID:       api.ApiID,
+		Title:       searchTitle(api.ApiID, api.DisplayName),
+		Description: api.Description,
+	}
+}
+
+// NewSearchDocumentForVersion creates the search document of a version.
+func NewSearchDocumentForVersion(version *Version) *SearchDocument {
+	return &SearchDocument{
+		Key:         version.Name(),
+		ProjectID:   version.ProjectID,
+		ApiID:       version.ApiID,
+		VersionID:   version.VersionID,
+		Title:       searchTitle(version.VersionID, version.DisplayName),
+		Description: version.Description,
+	}
+}
+
+// NewSearchDocumentForSpec creates the search document of a spec revision.
+// The contents are stored in the format given by the spec's MIME type.
+func NewSearchDocumentForSpec(spec *Spec, contents []byte) *SearchDocument {
+	return &SearchDocument{
+		Key:         spec.Name(),
+		ProjectID:   spec.ProjectID,
+		ApiID:       spec.ApiID,
+		VersionID:   spec.VersionID,
+		SpecID:      spec.SpecID,
+		Title:       searchTitle(spec.SpecID, spec.FileName),
+		Description: spec.Description,
+		Contents:    searchText(spec.MimeType, contents),
+	}
+}
+
+// NewSearchDocumentForDeployment creates the search document of a deployment revision.
+func NewSearchDocumentForDeployment(deployment *Deployment) *SearchDocument {
+	return &SearchDocument{
+		Key:          deployment.Name(),
+		ProjectID:    deployment.ProjectID,
+		ApiID:        deployment.ApiID,
+		DeploymentID: deployment.DeploymentID,
+		Title:        searchTitle(deployment.DeploymentID, deployment.DisplayName),
+		Description:  deployment.Description,
+	}
+}
+
+func searchTitle(id, name string) string {
+	if name == "" || name == id {
+		return id
+	}
+	return id + " " + name
+}
+
+// searchText returns the text of spec contents that can be indexed.
+// Compressed contents are uncompressed, text files are extracted from
+// zip archives, and contents that aren't text are skipped.
+func searchText(mimeType string, contents []byte) string {
+	if strings.Contains(mimeType, "+gzip") && len(contents) > 0 {
+		var err error
+		if contents, err = GUnzippedBytes(contents); err != nil {
+			return ""
+		}
+	}
+	if strings.Contains(mimeType, "+zip") {
+		return zipText(contents)
+	}
+	return text(contents)
+}
+
+// zipText returns the concatenated text of the files in a zip archive.
+func zipText(contents []byte) string {
+	r, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, f := range r.File {
+		if b.Len() >= MaxSearchContents {
+			break
+		}
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(rc, MaxSearchContents))
+		rc.Close()
+		if err != nil {
+			continue
+		}
+		if s := text(data); s != "" {
+			b.WriteString(f.Name)
+			b.WriteString("\n")
+			b.WriteString(s)
+			b.WriteString("\n")
+		}
+	}
+	return truncate(b.String())
+}
+
+// text returns contents as a string if they are valid UTF-8 text.
+func text(contents []byte) string {
+	if !utf8.Valid(contents) || bytes.IndexByte(contents, 0) >= 0 {
+		return ""
+	}
+	return truncate(string(contents))
+}
+
+// truncate shortens a string to MaxSearchContents bytes without splitting a character.
+func truncate(s string) string {
+	if len(s) <= MaxSearchContents {
+		return s
+	}
+	s = s[:MaxSearchContents]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -27,27 +27,29 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Spec is the storage-side representation of a spec.
 type Spec struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    `gorm:"index"` // Uniquely identifies an api within a project.
-	VersionID          string    // Uniquely identifies a version within an api.
-	SpecID             string    // Uniquely identifies a spec within a version.
-	RevisionID         string    // Uniquely identifies a revision of a spec.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	MimeType           string    // Spec format.
-	SizeInBytes        int32     // Size of the spec.
-	Hash               string    // A hash of the spec.
-	FileName           string    // Name of spec file.
-	SourceURI          string    // The original source URI of the spec.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         `gorm:"index"` // Uniquely identifies an api within a project.
+	VersionID          string         // Uniquely identifies a version within an api.
+	SpecID             string         // Uniquely identifies a spec within a version.
+	RevisionID         string         // Uniquely identifies a revision of a spec.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	MimeType           string         // Spec format.
+	SizeInBytes        int32          // Size of the spec.
+	Hash               string         // A hash of the spec.
+	FileName           string         // Name of spec file.
+	SourceURI          string         // The original source URI of the spec.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Time of deletion, if the resource belongs to a deleted API.
 	ParentVersionKey   string
 	ParentVersion      *Version `gorm:"foreignKey:ParentVersionKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -161,6 +163,11 @@ func (s *Spec) BasicMessage(name string) (message *rpc.ApiSpec, err error) {
 		return nil, err
 	}
 
+	message.Etag, err = etag(message)
+	if err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
@@ -0,0 +1,85 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// mysqlKeySize is the length of the columns that hold resource names.
+// Keys of the longest resource names (artifacts of spec revisions) fit
+// with room to spare, and an index of 512 utf8mb4 characters is within
+// the InnoDB limit of 3072 bytes.
+const mysqlKeySize = 512
+
+// mysqlDatetimePrecision stores timestamps with microseconds, like postgres.
+var mysqlDatetimePrecision = 6
+
+// openMySQL opens a MySQL or MariaDB database.
+// DSN Reference: See https://github.com/go-sql-driver/mysql#dsn-data-source-name
+func openMySQL(ctx context.Context, dsn string) (*gorm.DB, error) {
+	config, err := mysqldriver.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	// DATETIME columns have no time zone, so timestamps are always written
+	// and read in UTC and scanned into time.Time values.
+	config.ParseTime = true
+	config.Loc = time.UTC
+	dialector := mysql.New(mysql.Config{
+		DSNConfig:                config,
+		DefaultDatetimePrecision: &mysqlDatetimePrecision,
+	}).(*mysql.Dialector)
+	return gorm.Open(mysqlDialector{*dialector}, gormConfig(ctx))
+}
+
+// mysqlDialector adjusts the column types that gorm chooses for MySQL.
+type mysqlDialector struct {
+	mysql.Dialector
+}
+
+// Migrator returns a migrator that creates columns with the types of DataTypeOf.
+func (d mysqlDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return mysql.Migrator{
+		Migrator: migrator.Migrator{
+			Config: migrator.Config{
+				DB:        db,
+				Dialector: d,
+			},
+		},
+		Dialector: d.Dialector,
+	}
+}
+
+// DataTypeOf returns the column type of a field. Resource names are stored as
+// strings of unlimited length on other databases, but MySQL only indexes columns
+// of limited length, so keys and the foreign keys that refer to them are varchars.
+func (d mysqlDialector) DataTypeOf(field *schema.Field) string {
+	if field.DataType == schema.String && field.Size == 0 &&
+		(field.PrimaryKey || strings.HasSuffix(field.DBName, "_key")) {
+		key := *field
+		key.Size = mysqlKeySize
+		return d.Dialector.DataTypeOf(&key)
+	}
+	return d.Dialector.DataTypeOf(field)
+}
@@ -16,9 +16,25 @@ package storage
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/gob"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
 // PageOptions contains custom arguments for listing requests.
@@ -34,23 +50,25 @@ type PageOptions struct {
 	// If specified, listing will continue from the end of the previous page. Otherwise,
 	// the first page in a listing series will be returned.
 	Token string
+	// ShowDeleted includes deleted resources that have not yet been purged.
+	ShowDeleted bool
 }
 
 // token contains information to share between sequential page iterators.
 type token struct {
-	// Offset is the number of resources that should be skipped before the page begins.
-	// It should be set to the number of resources already returned.
-	Offset int
 	// Filter is the filter string for this listing request. It should be consistent between sequential pages.
 	Filter string
 	// Order is the sorting order for this listing request. It should be consistent between sequential pages.
 	Order string
+	// Position holds the values of the ordering columns of the last resource that was returned.
+	// The next page begins with the first resource that follows this position.
+	Position []interface{}
 }
 
 // ValidateFilter returns an error if the new filter doesn't match the token's encoded filter.
 // When the token represents the first page, any filter is valid and no error will be returned.
 func (t token) ValidateFilter(newFilter string) error {
-	if t.Offset > 0 && newFilter != t.Filter {
+	if t.Position != nil && newFilter != t.Filter {
 		return fmt.Errorf("new filter does not match previous filter %q", t.Filter)
 	}
 
@@ -61,15 +79,21 @@ func (t token) ValidateFilter(newFilter string) error {
 // if the format of the ordering string is invalid.
 // When the token represents the first page, any order is valid and no error will be returned.
 func (t token) ValidateOrder(newOrder string) error {
-	if t.Offset > 0 && newOrder != t.Order {
+	if t.Position != nil && newOrder != t.Order {
 		return fmt.Errorf("new order does not match previous order %q", t.Order)
 	}
 
 	return nil
 }
 
+func init() {
+	// Register the types of ordering columns that aren't registered by default.
+	gob.Register(time.Time{})
+}
+
 // encodeToken converts a token struct into an opaque string that can be converted back into struct form using decodeToken().
-func encodeToken(o token) (string, error) {
+// Tokens are signed so that decodeToken() can reject tokens that weren't created by a registry server using the same database.
+func (c *Client) encodeToken(ctx context.Context, o token) (string, error) {
 	var encoding bytes.Buffer
 
 	encoder := gob.NewEncoder(&encoding)
@@ -77,26 +101,146 @@ func encodeToken(o token) (string, error) {
 		return "", fmt.Errorf("failed to encode token: %s", err)
 	}
 
-	return base64.StdEncoding.EncodeToString(encoding.Bytes()), nil
+	key, err := c.pageTokenKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(encoding.Bytes())
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(encoding.Bytes())), nil
 }
 
 // decodeToken converts a string returned from encodeToken() back into an equivalent token struct.
 // Empty encoding strings are decoded without error to a zero-value token struct.
-func decodeToken(encoded string) (token, error) {
+func (c *Client) decodeToken(ctx context.Context, encoded string) (token, error) {
 	if encoded == "" {
 		return token{}, nil
 	}
 
-	decoding, err := base64.StdEncoding.DecodeString(encoded)
+	decoding, err := base64.RawURLEncoding.DecodeString(encoded)
 	if err != nil {
 		return token{}, fmt.Errorf("failed to decode token, expected base64: %s", err)
+	} else if len(decoding) < sha256.Size {
+		return token{}, errors.New("token is too short")
+	}
+
+	key, err := c.pageTokenKey(ctx)
+	if err != nil {
+		return token{}, err
+	}
+	payload, sum := decoding[:len(decoding)-sha256.Size], decoding[len(decoding)-sha256.Size:]
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return token{}, errors.New("token signature is invalid")
 	}
 
 	opts := token{}
-	encoder := gob.NewDecoder(bytes.NewReader(decoding))
+	encoder := gob.NewDecoder(bytes.NewReader(payload))
 	if err := encoder.Decode(&opts); err != nil {
 		return token{}, fmt.Errorf("failed to decode token bytes: %s", err)
 	}
 
 	return opts, nil
 }
+
+// pageTokenKeySetting is the name of the setting that holds the key used to sign page tokens.
+const pageTokenKeySetting = "page_token_key"
+
+// pageTokenKey returns the key used to sign page tokens. The key is stored in the
+// database so that tokens can be used with any server that shares the database.
+func (c *Client) pageTokenKey(ctx context.Context) ([]byte, error) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	if c.cache.pageTokenKey != nil {
+		return c.cache.pageTokenKey, nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// If another server has already created a key, keep it.
+	setting := &models.Setting{Name: pageTokenKeySetting, Value: key}
+	if err := c.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(setting).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "create page token key"))
+	}
+	if err := c.db.WithContext(ctx).Take(setting, "name = ?", pageTokenKeySetting).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "get page token key"))
+	}
+
+	c.cache.pageTokenKey = setting.Value
+	return c.cache.pageTokenKey, nil
+}
+
+// orderColumn is a column used to order a listing.
+type orderColumn struct {
+	Name       string
+	Descending bool
+}
+
+// orderColumns parses an ordering string returned by gormOrdering().
+func orderColumns(ordering string) []orderColumn {
+	columns := make([]orderColumn, 0)
+	for _, v := range strings.Split(ordering, ",") {
+		name, descending := strings.CutSuffix(v, " desc")
+		columns = append(columns, orderColumn{Name: name, Descending: descending})
+	}
+	return columns
+}
+
+// afterPosition restricts a query to the rows that follow a position in the given ordering.
+// The qualify function converts column names into names that can be used in a where clause.
+func afterPosition(op *gorm.DB, ordering string, position []interface{}, qualify func(string) string) (*gorm.DB, error) {
+	// Copy the query so that repeated calls don't accumulate conditions.
+	op = op.Session(&gorm.Session{})
+	if position == nil {
+		return op, nil
+	}
+
+	columns := orderColumns(ordering)
+	if len(columns) != len(position) {
+		return nil, status.Errorf(codes.InvalidArgument, "page token does not match order %q", ordering)
+	}
+
+	// The rows that follow (a, b) in the ordering "a, b desc" are (a > ?) OR (a = ? AND b < ?).
+	clauses := make([]string, 0, len(columns))
+	args := make([]interface{}, 0)
+	for i, column := range columns {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, qualify(columns[j].Name)+" = ?")
+			args = append(args, position[j])
+		}
+		comparison := " > ?"
+		if column.Descending {
+			comparison = " < ?"
+		}
+		terms = append(terms, qualify(column.Name)+comparison)
+		args = append(args, position[i])
+		clauses = append(clauses, "("+strings.Join(terms, " AND ")+")")
+	}
+	return op.Where("("+strings.Join(clauses, " OR ")+")", args...), nil
+}
+
+// schemas caches the parsed schemas of models.
+var schemas sync.Map
+
+// positionOf returns the values of the ordering columns for a row.
+func (c *Client) positionOf(ctx context.Context, row interface{}, ordering string) ([]interface{}, error) {
+	s, err := schema.Parse(row, &schemas, c.db.NamingStrategy)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	columns := orderColumns(ordering)
+	position := make([]interface{}, len(columns))
+	for i, column := range columns {
+		field := s.LookUpField(column.Name)
+		if field == nil {
+			return nil, status.Errorf(codes.Internal, "unknown order column %q", column.Name)
+		}
+		position[i], _ = field.ValueOf(ctx, reflect.Indirect(reflect.ValueOf(row)))
+	}
+	return position, nil
+}
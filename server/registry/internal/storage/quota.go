@@ -0,0 +1,68 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// CountApis returns the number of APIs in a project. Deleted APIs are not counted.
+func (c *Client) CountApis(ctx context.Context, projectID string) (int64, error) {
+	var count int64
+	err := c.db.WithContext(ctx).Model(&models.Api{}).
+		Where("project_id = ?", projectID).
+		Count(&count).Error
+	return count, grpcErrorForDBError(ctx, errors.Wrapf(err, "count apis of %s", projectID))
+}
+
+// CountSpecRevisions returns the number of revisions of a spec.
+func (c *Client) CountSpecRevisions(ctx context.Context, name names.Spec) (int64, error) {
+	var count int64
+	err := c.db.WithContext(ctx).Model(&models.Spec{}).
+		Where("project_id = ? AND api_id = ? AND version_id = ? AND spec_id = ?",
+			name.ProjectID, name.ApiID, name.VersionID, name.SpecID).
+		Count(&count).Error
+	return count, grpcErrorForDBError(ctx, errors.Wrapf(err, "count revisions of %s", name))
+}
+
+// MaxSpecRevisions returns the largest number of revisions of any spec in a project.
+func (c *Client) MaxSpecRevisions(ctx context.Context, projectID string) (int64, error) {
+	var max int64
+	counts := c.db.WithContext(ctx).Model(&models.Spec{}).
+		Select("count(*) as revisions").
+		Where("project_id = ?", projectID).
+		Group("api_id, version_id, spec_id")
+	err := c.db.WithContext(ctx).Table("(?) as counts", counts).
+		Select("coalesce(max(revisions), 0)").
+		Scan(&max).Error
+	return max, grpcErrorForDBError(ctx, errors.Wrapf(err, "count spec revisions of %s", projectID))
+}
+
+// BlobBytes returns the total size of the blobs of a project. The size of the
+// blob with the key that is being replaced, if any, is not included.
+func (c *Client) BlobBytes(ctx context.Context, projectID, replacing string) (int64, error) {
+	var size int64
+	err := c.db.WithContext(ctx).Model(&models.Blob{}).
+		Select("coalesce(sum(size_in_bytes), 0)").
+		Where("project_id = ?", projectID).
+		Where(clause.Neq{Column: clause.Column{Name: "key"}, Value: replacing}).
+		Scan(&size).Error
+	return size, grpcErrorForDBError(ctx, errors.Wrapf(err, "blob sizes of %s", projectID))
+}
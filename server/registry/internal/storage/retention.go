@@ -0,0 +1,235 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Revision describes a spec or deployment revision for retention policies.
+type Revision struct {
+	ApiID   string    // The API of the revision.
+	Parent  string    // The name of the spec or deployment.
+	Name    string    // The name of the revision.
+	Created time.Time // Revision creation time.
+	Tagged  bool      // True if the revision has at least one tag.
+}
+
+// ListSpecRevisionHistory returns the revisions of all specs of an API,
+// which may be "-" to include all APIs in its project.
+func (c *Client) ListSpecRevisionHistory(ctx context.Context, api names.Api) ([]Revision, error) {
+	var specs []models.Spec
+	op := c.db.WithContext(ctx).
+		Select("project_id", "api_id", "version_id", "spec_id", "revision_id", "revision_create_time").
+		Where("project_id = ?", api.ProjectID)
+	if api.ApiID != "-" {
+		op = op.Where("api_id = ?", api.ApiID)
+	}
+	if err := op.Find(&specs).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list spec revisions of %s", api))
+	}
+
+	var tags []models.SpecRevisionTag
+	op = c.db.WithContext(ctx).Where("project_id = ?", api.ProjectID)
+	if api.ApiID != "-" {
+		op = op.Where("api_id = ?", api.ApiID)
+	}
+	if err := op.Find(&tags).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list spec revision tags of %s", api))
+	}
+	tagged := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagged[names.SpecRevision{
+			ProjectID:  t.ProjectID,
+			ApiID:      t.ApiID,
+			VersionID:  t.VersionID,
+			SpecID:     t.SpecID,
+			RevisionID: t.RevisionID,
+		}.String()] = true
+	}
+
+	revisions := make([]Revision, len(specs))
+	for i, s := range specs {
+		name := names.SpecRevision{
+			ProjectID:  s.ProjectID,
+			ApiID:      s.ApiID,
+			VersionID:  s.VersionID,
+			SpecID:     s.SpecID,
+			RevisionID: s.RevisionID,
+		}
+		revisions[i] = Revision{
+			ApiID:   s.ApiID,
+			Parent:  name.Spec().String(),
+			Name:    name.String(),
+			Created: s.RevisionCreateTime,
+			Tagged:  tagged[name.String()],
+		}
+	}
+	return revisions, nil
+}
+
+// ListDeploymentRevisionHistory returns the revisions of all deployments of
+// an API, which may be "-" to include all APIs in its project.
+func (c *Client) ListDeploymentRevisionHistory(ctx context.Context, api names.Api) ([]Revision, error) {
+	var deployments []models.Deployment
+	op := c.db.WithContext(ctx).
+		Select("project_id", "api_id", "deployment_id", "revision_id", "revision_create_time").
+		Where("project_id = ?", api.ProjectID)
+	if api.ApiID != "-" {
+		op = op.Where("api_id = ?", api.ApiID)
+	}
+	if err := op.Find(&deployments).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list deployment revisions of %s", api))
+	}
+
+	var tags []models.DeploymentRevisionTag
+	op = c.db.WithContext(ctx).Where("project_id = ?", api.ProjectID)
+	if api.ApiID != "-" {
+		op = op.Where("api_id = ?", api.ApiID)
+	}
+	if err := op.Find(&tags).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list deployment revision tags of %s", api))
+	}
+	tagged := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagged[names.DeploymentRevision{
+			ProjectID:    t.ProjectID,
+			ApiID:        t.ApiID,
+			DeploymentID: t.DeploymentID,
+			RevisionID:   t.RevisionID,
+		}.String()] = true
+	}
+
+	revisions := make([]Revision, len(deployments))
+	for i, d := range deployments {
+		name := names.DeploymentRevision{
+			ProjectID:    d.ProjectID,
+			ApiID:        d.ApiID,
+			DeploymentID: d.DeploymentID,
+			RevisionID:   d.RevisionID,
+		}
+		revisions[i] = Revision{
+			ApiID:   d.ApiID,
+			Parent:  name.Deployment().String(),
+			Name:    name.String(),
+			Created: d.RevisionCreateTime,
+			Tagged:  tagged[name.String()],
+		}
+	}
+	return revisions, nil
+}
+
+// ListReferencedSpecRevisions returns the spec revisions of a project that
+// deployment revisions of any project refer to, including deployments of
+// deleted APIs. Tags and relative references are resolved to the revisions
+// that they currently refer to. References without revisions and references
+// to missing revisions are omitted.
+func (c *Client) ListReferencedSpecRevisions(ctx context.Context, project names.Project) ([]names.SpecRevision, error) {
+	refs, err := c.referencedSpecRevisions(ctx, project.String()+"/")
+	if err != nil {
+		return nil, err
+	}
+	revisions := refs[:0]
+	for _, ref := range refs {
+		if ref.ProjectID == project.ProjectID {
+			revisions = append(revisions, ref)
+		}
+	}
+	return revisions, nil
+}
+
+// referencedSpecRevisions returns the resolved spec revisions of the
+// references of deployment revisions that start with prefix.
+func (c *Client) referencedSpecRevisions(ctx context.Context, prefix string) ([]names.SpecRevision, error) {
+	var refs []string
+	op := c.db.WithContext(ctx).Unscoped().Model(&models.Deployment{}).
+		Distinct("api_spec_revision").
+		Where("api_spec_revision LIKE ?", prefix+"%")
+	if err := op.Pluck("api_spec_revision", &refs).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "list spec revision references starting with %s", prefix))
+	}
+
+	var revisions []names.SpecRevision
+	for _, ref := range refs {
+		name, err := names.ParseSpecRevision(ref)
+		if err != nil || name.RevisionID == "" {
+			continue
+		}
+		name, err = c.resolveSpecRevision(ctx, name)
+		if status.Code(err) == codes.NotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, name)
+	}
+	return revisions, nil
+}
+
+// SpecRevisionRetained returns true if a spec revision is tagged or is
+// referenced by a deployment revision of any project. Only references to
+// revisions of its spec are resolved.
+func (c *Client) SpecRevisionRetained(ctx context.Context, name names.SpecRevision) (bool, error) {
+	var count int64
+	err := c.db.WithContext(ctx).Model(&models.SpecRevisionTag{}).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID).
+		Where("version_id = ?", name.VersionID).
+		Where("spec_id = ?", name.SpecID).
+		Where("revision_id = ?", name.RevisionID).
+		Count(&count).Error
+	if err != nil {
+		return false, grpcErrorForDBError(ctx, errors.Wrapf(err, "count tags of %s", name))
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	// Exact references, tags and relative references all start with the
+	// name of the spec.
+	refs, err := c.referencedSpecRevisions(ctx, name.Spec().String()+"@")
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range refs {
+		if ref == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DeploymentRevisionTagged returns true if a deployment revision has at
+// least one tag.
+func (c *Client) DeploymentRevisionTagged(ctx context.Context, name names.DeploymentRevision) (bool, error) {
+	var count int64
+	err := c.db.WithContext(ctx).Model(&models.DeploymentRevisionTag{}).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID).
+		Where("deployment_id = ?", name.DeploymentID).
+		Where("revision_id = ?", name.RevisionID).
+		Count(&count).Error
+	if err != nil {
+		return false, grpcErrorForDBError(ctx, errors.Wrapf(err, "count tags of %s", name))
+	}
+	return count > 0, nil
+}
@@ -31,26 +31,44 @@ func (c *Client) SaveProject(ctx context.Context, v *models.Project) error {
 // SaveApi will return codes.NotFound if key not found
 func (c *Client) SaveApi(ctx context.Context, v *models.Api) error {
 	v.Key = v.Name()
-	return c.save(ctx, v)
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForApi(v))
 }
 
 // SaveVersion will return codes.NotFound if key not found
 func (c *Client) SaveVersion(ctx context.Context, v *models.Version) error {
 	v.Key = v.Name()
-	return c.save(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForVersion(v))
 }
 
 // SaveSpecRevision will upsert if key not found
 func (c *Client) SaveSpecRevision(ctx context.Context, v *models.Spec) error {
 	v.Key = v.RevisionName()
-	return c.save(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexSpecMetadata(ctx, v)
 }
 
 // SaveSpecRevisionContents will create a new blob for the spec
 func (c *Client) SaveSpecRevisionContents(ctx context.Context, spec *models.Spec, contents []byte) error {
 	v := models.NewBlobForSpec(spec, contents)
 	v.Key = spec.RevisionName()
-	return c.save(ctx, v)
+	if err := c.saveBlob(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForSpec(spec, contents))
 }
 
 // SaveSpecRevisionTag will upsert if key not found
@@ -62,7 +80,13 @@ func (c *Client) SaveSpecRevisionTag(ctx context.Context, v *models.SpecRevision
 // SaveDeploymentRevision will upsert if key not found
 func (c *Client) SaveDeploymentRevision(ctx context.Context, v *models.Deployment) error {
 	v.Key = v.RevisionName()
-	return c.save(ctx, v)
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForDeployment(v))
 }
 
 // SaveDeploymentRevisionTag will upsert if key not found
@@ -74,6 +98,9 @@ func (c *Client) SaveDeploymentRevisionTag(ctx context.Context, v *models.Deploy
 // SaveArtifact will upsert if key not found
 func (c *Client) SaveArtifact(ctx context.Context, v *models.Artifact) error {
 	v.Key = v.Name()
+	if err := c.checkApiNotDeleted(ctx, v.ProjectID, v.ApiID, v.Key); err != nil {
+		return err
+	}
 	return c.save(ctx, v)
 }
 
@@ -81,7 +108,7 @@ func (c *Client) SaveArtifact(ctx context.Context, v *models.Artifact) error {
 func (c *Client) SaveArtifactContents(ctx context.Context, artifact *models.Artifact, contents []byte) error {
 	v := models.NewBlobForArtifact(artifact, contents)
 	v.Key = artifact.Name()
-	return c.save(ctx, v)
+	return c.saveBlob(ctx, v)
 }
 
 func (c *Client) save(ctx context.Context, v interface{}) error {
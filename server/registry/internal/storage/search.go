@@ -0,0 +1,543 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The search index holds a models.SearchDocument for each API, version, spec and deployment.
+// On SQLite, the documents are indexed by an FTS4 table that uses the search_documents table
+// as its external content and is kept up to date by triggers. On Postgres, the documents have
+// a generated tsvector column with a GIN index. On MySQL, they have a FULLTEXT index.
+
+// searchTable is the name of the SQLite full-text index of search documents.
+const searchTable = "search_documents_fts"
+
+var sqliteSearchIndex = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS search_documents_fts USING fts4(content="search_documents", title, description, contents)`,
+	`CREATE TRIGGER IF NOT EXISTS search_documents_bu BEFORE UPDATE ON search_documents BEGIN
+		DELETE FROM search_documents_fts WHERE docid = old.rowid;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS search_documents_bd BEFORE DELETE ON search_documents BEGIN
+		DELETE FROM search_documents_fts WHERE docid = old.rowid;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS search_documents_au AFTER UPDATE ON search_documents BEGIN
+		INSERT INTO search_documents_fts(docid, title, description, contents) VALUES (new.rowid, new.title, new.description, new.contents);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS search_documents_ai AFTER INSERT ON search_documents BEGIN
+		INSERT INTO search_documents_fts(docid, title, description, contents) VALUES (new.rowid, new.title, new.description, new.contents);
+	END`,
+}
+
+var postgresSearchIndex = []string{
+	`ALTER TABLE search_documents ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(description, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce(contents, '')), 'C')) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_search_documents_search_vector ON search_documents USING GIN (search_vector)`,
+}
+
+var mysqlSearchIndex = []string{
+	// Full-text searches are case-insensitive only for columns with case-insensitive collations.
+	`ALTER TABLE search_documents
+		MODIFY title longtext COLLATE utf8mb4_unicode_ci,
+		MODIFY description longtext COLLATE utf8mb4_unicode_ci,
+		MODIFY contents longtext COLLATE utf8mb4_unicode_ci`,
+	`CREATE FULLTEXT INDEX idx_search_documents_text ON search_documents (title, description, contents)`,
+}
+
+// mysqlLongWord matches words that are longer than MySQL's maximum full-text token size.
+// MySQL doesn't index them, and go-mysql-server fails to store documents that contain them.
+var mysqlLongWord = regexp.MustCompile(`[\pL\pN_]{85,}`)
+
+// removeLongWords removes the words of a MySQL search document that can't be indexed.
+func removeLongWords(v *models.SearchDocument) {
+	v.Title = mysqlLongWord.ReplaceAllString(v.Title, " ")
+	v.Description = mysqlLongWord.ReplaceAllString(v.Description, " ")
+	v.Contents = mysqlLongWord.ReplaceAllString(v.Contents, " ")
+}
+
+// searchColumnWeights weight matches in the title, description and contents of SQLite and MySQL documents.
+var searchColumnWeights = []float64{3, 2, 1}
+
+// ensureSearchIndex creates the full-text index of search documents.
+// When the index is created for a database that already contains resources,
+// the index is filled with documents for those resources.
+func (c *Client) ensureSearchIndex(ctx context.Context) error {
+	exists, statements, err := c.searchIndex(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	for _, s := range statements {
+		if err := c.db.WithContext(ctx).Exec(s).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrap(err, "create search index"))
+		}
+	}
+	return c.rebuildSearchIndex(ctx)
+}
+
+// searchIndex returns whether the search index exists and the statements that create it.
+func (c *Client) searchIndex(ctx context.Context) (bool, []string, error) {
+	m := c.db.WithContext(ctx).Migrator()
+	switch c.db.WithContext(ctx).Name() {
+	case "sqlite":
+		return m.HasTable(searchTable), sqliteSearchIndex, nil
+	case "postgres":
+		return m.HasColumn(&models.SearchDocument{}, "search_vector"), postgresSearchIndex, nil
+	case "mysql":
+		return m.HasIndex(&models.SearchDocument{}, "idx_search_documents_text"), mysqlSearchIndex, nil
+	default:
+		return false, nil, status.Errorf(codes.Internal, "unsupported database %s", c.db.Name())
+	}
+}
+
+// rebuildSearchIndex replaces all search documents with documents for the resources in the database.
+// Soft-deleted resources are included so that they are searchable if they are restored.
+func (c *Client) rebuildSearchIndex(ctx context.Context) error {
+	op := c.db.WithContext(ctx).Unscoped().Session(&gorm.Session{})
+	if err := op.Where("1 = 1").Delete(&models.SearchDocument{}).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "clear search index"))
+	}
+
+	var apis []models.Api
+	if err := op.Find(&apis).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "index apis"))
+	}
+	for i := range apis {
+		if err := c.indexDocument(ctx, models.NewSearchDocumentForApi(&apis[i])); err != nil {
+			return err
+		}
+	}
+
+	var versions []models.Version
+	if err := op.Find(&versions).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "index versions"))
+	}
+	for i := range versions {
+		if err := c.indexDocument(ctx, models.NewSearchDocumentForVersion(&versions[i])); err != nil {
+			return err
+		}
+	}
+
+	// Revisions are read in creation order, so the last revision of each resource is indexed.
+	var specs []models.Spec
+	if err := op.Order("revision_create_time").Find(&specs).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "index specs"))
+	}
+	latest := make(map[string]*models.Spec)
+	for i := range specs {
+		latest[specs[i].Name()] = &specs[i]
+	}
+	for _, spec := range latest {
+		// Specs whose contents can't be read are indexed without them.
+		blob := new(models.Blob)
+		if op.Take(blob, keyIs(spec.RevisionName())).Error != nil || c.loadBlobContents(ctx, blob) != nil {
+			blob.Contents = nil
+		}
+		if err := c.indexDocument(ctx, models.NewSearchDocumentForSpec(spec, blob.Contents)); err != nil {
+			return err
+		}
+	}
+
+	var deployments []models.Deployment
+	if err := op.Order("revision_create_time").Find(&deployments).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "index deployments"))
+	}
+	for i := range deployments {
+		if err := c.indexDocument(ctx, models.NewSearchDocumentForDeployment(&deployments[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexDocument adds or replaces a search document.
+func (c *Client) indexDocument(ctx context.Context, v *models.SearchDocument) error {
+	if c.db.Name() != "mysql" {
+		return c.save(ctx, v)
+	}
+	// On MySQL, documents are deleted and inserted instead of upserted because
+	// go-mysql-server, which tests use in place of MySQL, can't upsert rows
+	// that have a FULLTEXT index.
+	removeLongWords(v)
+	if err := c.db.WithContext(ctx).Delete(&models.SearchDocument{}, keyIs(v.Key)).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+	}
+	err := c.db.WithContext(ctx).Create(v).Error
+	return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+}
+
+// indexSpecMetadata adds or updates the search document of a spec revision without changing
+// the indexed contents. Contents are indexed when they are saved with SaveSpecRevisionContents.
+func (c *Client) indexSpecMetadata(ctx context.Context, spec *models.Spec) error {
+	v := models.NewSearchDocumentForSpec(spec, nil)
+	if c.db.Name() == "mysql" {
+		// As in indexDocument, documents aren't upserted on MySQL.
+		removeLongWords(v)
+		op := c.db.WithContext(ctx).Model(&models.SearchDocument{}).Where(keyIs(v.Key))
+		var count int64
+		if err := op.Count(&count).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+		}
+		if count == 0 {
+			err := c.db.WithContext(ctx).Create(v).Error
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+		}
+		err := op.Updates(map[string]interface{}{"title": v.Title, "description": v.Description}).Error
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+	}
+	err := c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "description"}),
+	}).Create(v).Error
+	return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+}
+
+// reindexSpec indexes a spec revision and its stored contents.
+func (c *Client) reindexSpec(ctx context.Context, spec *models.Spec) error {
+	blob := new(models.Blob)
+	if err := c.db.WithContext(ctx).Take(blob, keyIs(spec.RevisionName())).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", spec.RevisionName()))
+	}
+	if err := c.loadBlobContents(ctx, blob); err != nil {
+		return err
+	}
+	return c.indexDocument(ctx, models.NewSearchDocumentForSpec(spec, blob.Contents))
+}
+
+// SearchResult is a resource that matches a search query.
+type SearchResult struct {
+	Name    string  // Resource name.
+	Snippet string  // Text of the resource around the matching terms.
+	Score   float64 // Relevance of the resource to the query; higher is better.
+}
+
+// SearchResultList contains a page of search results.
+type SearchResultList struct {
+	Results []SearchResult
+	Token   string
+}
+
+// searchTerms splits a query into words and quoted phrases that must all appear in matching resources.
+// Terms that can't match any indexed words are dropped.
+func searchTerms(query string) []string {
+	var terms []string
+	for i, part := range strings.Split(query, `"`) {
+		var candidates []string
+		if i%2 == 1 {
+			candidates = []string{part}
+		} else {
+			candidates = strings.Fields(part)
+		}
+		for _, term := range candidates {
+			if strings.IndexFunc(term, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+				terms = append(terms, strings.TrimSpace(term))
+			}
+		}
+	}
+	return terms
+}
+
+// SearchResources returns the resources in a project that contain all of the terms of a query,
+// ordered by decreasing relevance. Resources of deleted APIs are not included.
+func (c *Client) SearchResources(ctx context.Context, parent names.Project, query string, opts PageOptions) (SearchResultList, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: must contain at least one word", query)
+	}
+
+	token, err := c.decodeToken(ctx, opts.Token)
+	if err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+	if err := token.ValidateFilter(query); err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: %s", query, err)
+	}
+	token.Filter = query
+	offset := 0
+	if len(token.Position) == 1 {
+		if v, ok := token.Position[0].(int); ok {
+			offset = v
+		}
+	}
+
+	if _, err := c.GetProject(ctx, parent); err != nil {
+		return SearchResultList{}, err
+	}
+
+	var results []SearchResult
+	switch c.db.WithContext(ctx).Name() {
+	case "sqlite":
+		results, err = c.searchSQLite(ctx, parent, terms, offset, int(opts.Size)+1)
+	case "postgres":
+		results, err = c.searchPostgres(ctx, parent, terms, offset, int(opts.Size)+1)
+	case "mysql":
+		results, err = c.searchMySQL(ctx, parent, terms, offset, int(opts.Size)+1)
+	default:
+		err = status.Errorf(codes.Internal, "unsupported database %s", c.db.Name())
+	}
+	if err != nil {
+		return SearchResultList{}, err
+	}
+
+	response := SearchResultList{Results: results}
+	if len(results) > int(opts.Size) {
+		response.Results = results[:opts.Size]
+		token.Position = []interface{}{offset + int(opts.Size)}
+		response.Token, err = c.encodeToken(ctx, token)
+		if err != nil {
+			return SearchResultList{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	for i, r := range response.Results {
+		response.Results[i].Snippet = strings.Join(strings.Fields(r.Snippet), " ")
+	}
+	return response, nil
+}
+
+// notDeletedDocument restricts a search to documents whose API has not been deleted.
+const notDeletedDocument = `NOT EXISTS (SELECT 1 FROM apis WHERE apis.project_id = d.project_id
+	AND apis.api_id = d.api_id AND apis.delete_time IS NOT NULL)`
+
+func (c *Client) searchSQLite(ctx context.Context, parent names.Project, terms []string, offset, limit int) ([]SearchResult, error) {
+	phrases := make([]string, len(terms))
+	for i, term := range terms {
+		phrases[i] = `"` + term + `"`
+	}
+	rows, err := c.db.WithContext(ctx).Raw(`SELECT d.key,
+		snippet(search_documents_fts, '<b>', '</b>', '...', -1, 16),
+		matchinfo(search_documents_fts, 'pcnx')
+		FROM search_documents_fts JOIN search_documents AS d ON d.rowid = search_documents_fts.docid
+		WHERE search_documents_fts MATCH ? AND d.project_id = ? AND `+notDeletedDocument,
+		strings.Join(phrases, " "), parent.ProjectID).Rows()
+	if err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var r SearchResult
+		var info []byte
+		if err := rows.Scan(&r.Name, &r.Snippet, &info); err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+		}
+		r.Score = matchScore(info)
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+
+	// FTS4 doesn't rank matches, so results are ordered here.
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	if offset >= len(results) {
+		return nil, nil
+	}
+	results = results[offset:]
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// matchScore computes a BM25-like score from the output of the FTS4 matchinfo function
+// with the 'pcnx' format: the number of phrases and columns, the number of documents, and
+// for each phrase and column, the hits in this document, the hits in all documents, and
+// the number of documents with hits. Values are unsigned 32-bit integers in the byte order
+// of the host, which is little-endian on all supported platforms.
+func matchScore(info []byte) float64 {
+	value := func(i int) float64 {
+		if 4*i+4 > len(info) {
+			return 0
+		}
+		return float64(binary.LittleEndian.Uint32(info[4*i:]))
+	}
+	phrases, columns, documents := int(value(0)), int(value(1)), value(2)
+	score := 0.0
+	for p := 0; p < phrases; p++ {
+		for col := 0; col < columns && col < len(searchColumnWeights); col++ {
+			i := 3 + 3*(p*columns+col)
+			hits, withHits := value(i), value(i+2)
+			if hits == 0 {
+				continue
+			}
+			idf := math.Log(1 + (documents-withHits+0.5)/(withHits+0.5))
+			score += searchColumnWeights[col] * idf * hits / (hits + 1.2)
+		}
+	}
+	return score
+}
+
+func (c *Client) searchPostgres(ctx context.Context, parent names.Project, terms []string, offset, limit int) ([]SearchResult, error) {
+	queries := make([]string, len(terms))
+	args := make([]interface{}, 0, len(terms)+3)
+	for i, term := range terms {
+		queries[i] = "phraseto_tsquery('simple', ?)"
+		args = append(args, term)
+	}
+	args = append(args, parent.ProjectID, limit, offset)
+	rows, err := c.db.WithContext(ctx).Raw(`WITH query AS (SELECT `+strings.Join(queries, " && ")+` AS q),
+		matches AS (
+			SELECT d.key, d.title, d.description, d.contents, ts_rank(d.search_vector, query.q) AS score
+			FROM search_documents AS d, query
+			WHERE d.search_vector @@ query.q AND d.project_id = ? AND `+notDeletedDocument+`
+			ORDER BY score DESC, d.key
+			LIMIT ? OFFSET ?
+		)
+		SELECT matches.key,
+			ts_headline('simple', matches.title || ' ' || matches.description || ' ' || matches.contents, query.q,
+				'StartSel=<b>, StopSel=</b>, MaxWords=16, MinWords=8'),
+			matches.score
+		FROM matches, query
+		ORDER BY matches.score DESC, matches.key`, args...).Rows()
+	if err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var r SearchResult
+		if err := rows.Scan(&r.Name, &r.Snippet, &r.Score); err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+	return results, nil
+}
+
+func (c *Client) searchMySQL(ctx context.Context, parent names.Project, terms []string, offset, limit int) ([]SearchResult, error) {
+	// The full-text index finds documents that contain any of the terms. Each term (which may
+	// be a phrase) must appear in results, which are scored by the columns that contain them.
+	query := strings.Join(terms, " ")
+	columns := []string{"d.title", "d.description", "d.contents"}
+	var where, score []string
+	var whereArgs, scoreArgs []interface{}
+	for _, term := range terms {
+		term = strings.ToLower(term)
+		var any []string
+		for i, col := range columns {
+			any = append(any, fmt.Sprintf("instr(lower(%s), ?) > 0", col))
+			whereArgs = append(whereArgs, term)
+			score = append(score, fmt.Sprintf("%g * (instr(lower(%s), ?) > 0)", searchColumnWeights[i], col))
+			scoreArgs = append(scoreArgs, term)
+		}
+		where = append(where, "("+strings.Join(any, " OR ")+")")
+	}
+	args := append(scoreArgs, query, parent.ProjectID)
+	args = append(args, whereArgs...)
+	args = append(args, limit, offset)
+	rows, err := c.db.WithContext(ctx).Raw(`SELECT d.key, d.title, d.description, d.contents, `+strings.Join(score, " + ")+` AS score
+		FROM (SELECT `+"`key`"+`, project_id, api_id, title, description, contents
+			FROM search_documents
+			WHERE MATCH (title, description, contents) AGAINST (?) AND project_id = ?) AS d
+		WHERE `+strings.Join(where, " AND ")+` AND `+notDeletedDocument+`
+		ORDER BY score DESC, d.key
+		LIMIT ? OFFSET ?`, args...).Rows()
+	if err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var r SearchResult
+		var title, description, contents string
+		if err := rows.Scan(&r.Name, &title, &description, &contents, &r.Score); err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+		}
+		r.Snippet = highlight(title+" "+description+" "+contents, terms, 16)
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "search"))
+	}
+	return results, nil
+}
+
+// highlight returns up to n words of text around the first match of a term,
+// with the matching words marked as in the snippets of the other databases.
+// MySQL has no function that does this.
+func highlight(text string, terms []string, n int) string {
+	var words []string
+	for _, term := range terms {
+		words = append(words, strings.Fields(strings.ToLower(term))...)
+	}
+	// match returns the part of a word that matches a term, without surrounding punctuation.
+	match := func(word string) string {
+		core := strings.TrimFunc(word, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		for _, w := range words {
+			if strings.ToLower(core) == w {
+				return core
+			}
+		}
+		return ""
+	}
+
+	fields := strings.Fields(text)
+	start := 0
+	for i, field := range fields {
+		if match(field) != "" {
+			start = i - n/4
+			break
+		}
+	}
+	if start > len(fields)-n {
+		start = len(fields) - n
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + n
+	if end > len(fields) {
+		end = len(fields)
+	}
+	snippet := make([]string, 0, end-start)
+	for _, field := range fields[start:end] {
+		if core := match(field); core != "" {
+			field = strings.Replace(field, core, "<b>"+core+"</b>", 1)
+		}
+		snippet = append(snippet, field)
+	}
+	return strings.Join(snippet, " ")
+}
@@ -0,0 +1,164 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Soft-deleted APIs keep their rows, along with the rows of their versions,
+// specs, deployments and artifacts, but set the delete_time column. Gorm
+// excludes those rows from queries unless they are explicitly unscoped.
+// Blobs and tags are not marked; they are unreachable without their parents.
+
+// SoftDeleteApi marks an API and all of its child resources as deleted.
+// The API can be restored with UndeleteApi until it is purged with PurgeApi.
+func (c *Client) SoftDeleteApi(ctx context.Context, name names.Api, cascade bool) error {
+	op := c.db.WithContext(ctx).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID)
+	if err := op.Delete(&models.Api{}).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
+	}
+	if op.RowsAffected == 0 {
+		return status.Errorf(codes.NotFound, "%q not found in database", name)
+	}
+
+	// Count the same child resources that would block a non-cascading hard delete.
+	tables := []interface{}{
+		models.Blob{},
+		models.Artifact{},
+	}
+	counts := make([]int64, len(tables))
+	for i, model := range tables {
+		op := c.db.WithContext(ctx).Model(model).
+			Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID)
+		if err := op.Count(&counts[i]).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
+		}
+	}
+	if sum(counts) > 0 && !cascade {
+		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", name, reason(counts, tables))
+	}
+
+	for _, model := range []interface{}{
+		&models.Version{},
+		&models.Spec{},
+		&models.Deployment{},
+		&models.Artifact{},
+	} {
+		op := c.db.WithContext(ctx).
+			Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID)
+		if err := op.Delete(model).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
+		}
+	}
+
+	return nil
+}
+
+// UndeleteApi restores a soft-deleted API and all of its child resources.
+func (c *Client) UndeleteApi(ctx context.Context, name names.Api) (*models.Api, error) {
+	for _, model := range []interface{}{
+		&models.Api{},
+		&models.Version{},
+		&models.Spec{},
+		&models.Deployment{},
+		&models.Artifact{},
+	} {
+		op := c.db.WithContext(ctx).Unscoped().Model(model).
+			Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID).
+			Where("delete_time IS NOT NULL")
+		if err := op.Update("delete_time", nil).Error; err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "undelete %s", name))
+		}
+		if _, ok := model.(*models.Api); ok && op.RowsAffected == 0 {
+			if _, err := c.GetApi(ctx, name); err == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "%q is not deleted", name)
+			}
+			return nil, status.Errorf(codes.NotFound, "deleted API %q not found in database", name)
+		}
+	}
+
+	return c.GetApi(ctx, name)
+}
+
+// ListDeletedApis returns the APIs that were soft-deleted before the specified time.
+func (c *Client) ListDeletedApis(ctx context.Context, before time.Time) ([]models.Api, error) {
+	var apis []models.Api
+	op := c.db.WithContext(ctx).Unscoped().
+		Where("delete_time IS NOT NULL").
+		Where("delete_time < ?", before).
+		Order("delete_time")
+	if err := op.Find(&apis).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "find deleted apis"))
+	}
+	return apis, nil
+}
+
+// PurgeApi permanently deletes an API that was soft-deleted before the
+// specified time, along with all of its child resources.
+func (c *Client) PurgeApi(ctx context.Context, name names.Api, before time.Time) error {
+	var count int64
+	op := c.db.WithContext(ctx).Unscoped().Model(&models.Api{}).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID).
+		Where("delete_time < ?", before)
+	if err := op.Count(&count).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "purge %s", name))
+	}
+	if count == 0 {
+		return status.Errorf(codes.NotFound, "deleted API %q not found in database", name)
+	}
+	return c.deleteApi(ctx, name, true)
+}
+
+// apiIsDeleted returns true if the specified API exists and is soft-deleted.
+func (c *Client) apiIsDeleted(ctx context.Context, projectID, apiID string) (bool, error) {
+	if apiID == "" {
+		return false, nil
+	}
+	var count int64
+	op := c.db.WithContext(ctx).Unscoped().Model(&models.Api{}).
+		Where("project_id = ?", projectID).
+		Where("api_id = ?", apiID).
+		Where("delete_time IS NOT NULL")
+	if err := op.Count(&count).Error; err != nil {
+		return false, grpcErrorForDBError(ctx, errors.Wrap(err, "count deleted apis"))
+	}
+	return count > 0, nil
+}
+
+// checkApiNotDeleted returns a NotFound error for the named resource if it
+// belongs to a soft-deleted API. Resources of deleted APIs can't be changed,
+// and new resources can't be added to them.
+func (c *Client) checkApiNotDeleted(ctx context.Context, projectID, apiID string, name interface{}) error {
+	if deleted, err := c.apiIsDeleted(ctx, projectID, apiID); err != nil {
+		return err
+	} else if deleted {
+		return status.Errorf(codes.NotFound, "%q not found in database", name)
+	}
+	return nil
+}
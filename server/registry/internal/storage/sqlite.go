@@ -0,0 +1,76 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"net/url"
+	"strings"
+)
+
+// sqliteMaxConnections is the size of the connection pool of SQLite file databases.
+// Any number of connections can read concurrently, but only one can write at a time.
+const sqliteMaxConnections = 10
+
+// sqliteSettings are the driver settings that every SQLite connection uses.
+// See https://github.com/mattn/go-sqlite3#connection-string.
+var sqliteSettings = []struct {
+	names []string // The name of the setting and its aliases.
+	value string
+}{
+	// Write-ahead logging lets readers proceed while another connection writes.
+	{names: []string{"_journal_mode", "_journal"}, value: "WAL"},
+	// Connections wait this many milliseconds for locks held by other connections.
+	{names: []string{"_busy_timeout", "_timeout"}, value: "5000"},
+	// Transactions take the write lock when they begin, so that they wait for each other
+	// instead of failing when a transaction that has read tries to write.
+	{names: []string{"_txlock"}, value: "immediate"},
+	// PRAGMA statements only apply to the connection that runs them, so
+	// foreign keys are enabled for every connection by the driver.
+	{names: []string{"_foreign_keys", "_fk"}, value: "1"},
+}
+
+// sqliteDSN adds sqliteSettings to a DSN. Settings in the DSN are kept.
+func sqliteDSN(dsn string) string {
+	_, query, _ := strings.Cut(dsn, "?")
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return dsn
+	}
+	added := url.Values{}
+	for _, s := range sqliteSettings {
+		found := false
+		for _, name := range s.names {
+			if params.Has(name) {
+				found = true
+			}
+		}
+		if !found {
+			added.Set(s.names[0], s.value)
+		}
+	}
+	if len(added) == 0 {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&" + added.Encode()
+	}
+	return dsn + "?" + added.Encode()
+}
+
+// sqliteInMemory returns true if a DSN opens an in-memory database.
+// Each connection to an in-memory database opens a separate database.
+func sqliteInMemory(dsn string) bool {
+	return strings.Contains(dsn, ":memory:") || strings.Contains(dsn, "mode=memory")
+}
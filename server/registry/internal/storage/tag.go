@@ -16,16 +16,39 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// newestRevisionsFirst orders revisions like the List methods, from the newest
+// to the oldest, with ties broken by their keys.
+var newestRevisionsFirst = clause.OrderBy{Columns: []clause.OrderByColumn{
+	{Column: clause.Column{Name: "revision_create_time"}, Desc: true},
+	{Column: clause.Column{Name: "key"}},
+}}
+
+// notNewerThan selects the revisions that are ordered at or after a revision
+// by newestRevisionsFirst.
+func notNewerThan(created time.Time, key string) clause.Expression {
+	return clause.Or(
+		clause.Lt{Column: clause.Column{Name: "revision_create_time"}, Value: created},
+		clause.And(
+			clause.Eq{Column: clause.Column{Name: "revision_create_time"}, Value: created},
+			clause.Gte{Column: clause.Column{Name: "key"}, Value: key},
+		),
+	)
+}
+
 func (c *Client) unwrapSpecRevisionTag(ctx context.Context, name names.SpecRevision) (names.SpecRevision, error) {
 	v := new(models.SpecRevisionTag)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return name, nil
 	} else if err != nil {
 		return names.SpecRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -36,7 +59,7 @@ func (c *Client) unwrapSpecRevisionTag(ctx context.Context, name names.SpecRevis
 
 func (c *Client) unwrapDeploymentRevisionTag(ctx context.Context, name names.DeploymentRevision) (names.DeploymentRevision, error) {
 	v := new(models.DeploymentRevisionTag)
-	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := c.db.WithContext(ctx).Take(v, keyIs(name.String())).Error; err == gorm.ErrRecordNotFound {
 		return name, nil
 	} else if err != nil {
 		return names.DeploymentRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
@@ -44,3 +67,87 @@ func (c *Client) unwrapDeploymentRevisionTag(ctx context.Context, name names.Dep
 
 	return name.Deployment().Revision(v.RevisionID), nil
 }
+
+// resolveSpecRevision returns the name of the revision that a spec revision
+// name refers to with a tag or a relative reference. Revisions are counted in
+// the order of their creation, and revisions that were created at the same
+// time are counted in the order of their names, as they are listed. Tags take
+// precedence over relative references, so a revision tagged "latest" is
+// returned for "latest".
+func (c *Client) resolveSpecRevision(ctx context.Context, name names.SpecRevision) (names.SpecRevision, error) {
+	name, err := c.unwrapSpecRevisionTag(ctx, name)
+	if err != nil {
+		return names.SpecRevision{}, err
+	}
+	base, n, ok := names.RelativeRevision(name.RevisionID)
+	if !ok {
+		return name, nil
+	}
+
+	op := c.db.WithContext(ctx).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID).
+		Where("version_id = ?", name.VersionID).
+		Where("spec_id = ?", name.SpecID)
+	if base != "" {
+		b, err := c.unwrapSpecRevisionTag(ctx, name.Spec().Revision(base))
+		if err != nil {
+			return names.SpecRevision{}, err
+		}
+		v := new(models.Spec)
+		if err := c.db.WithContext(ctx).Take(v, keyIs(b.String())).Error; err == gorm.ErrRecordNotFound {
+			return names.SpecRevision{}, status.Errorf(codes.NotFound, "%q not found in database", name)
+		} else if err != nil {
+			return names.SpecRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", b))
+		}
+		op = op.Where(notNewerThan(v.RevisionCreateTime, v.Key))
+	}
+
+	v := new(models.Spec)
+	if err := op.Clauses(newestRevisionsFirst).Offset(n).Take(v).Error; err == gorm.ErrRecordNotFound {
+		return names.SpecRevision{}, status.Errorf(codes.NotFound, "%q not found in database", name)
+	} else if err != nil {
+		return names.SpecRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
+	}
+	return name.Spec().Revision(v.RevisionID), nil
+}
+
+// resolveDeploymentRevision returns the name of the revision that a deployment
+// revision name refers to with a tag or a relative reference, like
+// resolveSpecRevision.
+func (c *Client) resolveDeploymentRevision(ctx context.Context, name names.DeploymentRevision) (names.DeploymentRevision, error) {
+	name, err := c.unwrapDeploymentRevisionTag(ctx, name)
+	if err != nil {
+		return names.DeploymentRevision{}, err
+	}
+	base, n, ok := names.RelativeRevision(name.RevisionID)
+	if !ok {
+		return name, nil
+	}
+
+	op := c.db.WithContext(ctx).
+		Where("project_id = ?", name.ProjectID).
+		Where("api_id = ?", name.ApiID).
+		Where("deployment_id = ?", name.DeploymentID)
+	if base != "" {
+		b, err := c.unwrapDeploymentRevisionTag(ctx, name.Deployment().Revision(base))
+		if err != nil {
+			return names.DeploymentRevision{}, err
+		}
+		v := new(models.Deployment)
+		if err := c.db.WithContext(ctx).Take(v, keyIs(b.String())).Error; err == gorm.ErrRecordNotFound {
+			return names.DeploymentRevision{}, status.Errorf(codes.NotFound, "%q not found in database", name)
+		} else if err != nil {
+			return names.DeploymentRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", b))
+		}
+		op = op.Where(notNewerThan(v.RevisionCreateTime, v.Key))
+	}
+
+	v := new(models.Deployment)
+	if err := op.Clauses(newestRevisionsFirst).Offset(n).Take(v).Error; err == gorm.ErrRecordNotFound {
+		return names.DeploymentRevision{}, status.Errorf(codes.NotFound, "%q not found in database", name)
+	} else if err != nil {
+		return names.DeploymentRevision{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
+	}
+	return name.Deployment().Revision(v.RevisionID), nil
+}
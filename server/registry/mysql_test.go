@@ -0,0 +1,109 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"sync"
+	"testing"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	gms "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+)
+
+const mysqlTestDatabase = "registry_test"
+
+var (
+	useMySQL    = false
+	mysqlServer struct {
+		sync.Once
+		addr string
+		err  error
+	}
+)
+
+func init() {
+	flag.BoolVar(&useMySQL, "mysql", false, "perform server tests using an embedded MySQL-compatible server")
+}
+
+// startMySQL starts an in-memory MySQL-compatible server that is shared by all tests.
+func startMySQL() (string, error) {
+	mysqlServer.Do(func() {
+		// The server logs every connection and failed query.
+		logrus.SetLevel(logrus.ErrorLevel)
+		provider := memory.NewDBProviderWithOpts(memory.NativeIndexProvider(true)).(*memory.DbProvider)
+		engine := sqle.NewDefault(provider)
+		sessions := func(ctx context.Context, conn *mysql.Conn, addr string) (gms.Session, error) {
+			client := gms.Client{Address: addr, Capabilities: conn.Capabilities}
+			return memory.NewSession(gms.NewBaseSessionWithClientServer(addr, client, conn.ConnectionID), provider), nil
+		}
+		s, err := server.NewServer(server.Config{Protocol: "tcp", Address: "127.0.0.1:0"}, engine, sessions, nil)
+		if err != nil {
+			mysqlServer.err = err
+			return
+		}
+		go func() { _ = s.Start() }()
+		mysqlServer.addr = s.Listener.Addr().String()
+	})
+	return mysqlServer.addr, mysqlServer.err
+}
+
+// serverWithMySQL will call server.Close() when test completes
+func serverWithMySQL(t testing.TB) (*RegistryServer, error) {
+	sharedStorage.Lock()
+	t.Cleanup(sharedStorage.Unlock)
+
+	addr, err := startMySQL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start mysql server: %s", err)
+	}
+	if err := resetMySQL(addr); err != nil {
+		return nil, fmt.Errorf("failed to reset database: %s", err)
+	}
+
+	server, err := New(Config{
+		Database: "mysql",
+		DBConfig: fmt.Sprintf("root@tcp(%s)/%s", addr, mysqlTestDatabase),
+	})
+	if server != nil {
+		t.Cleanup(server.Close)
+	}
+	return server, err
+}
+
+func resetMySQL(addr string) error {
+	db, err := sql.Open("mysql", fmt.Sprintf("root@tcp(%s)/", addr))
+	if err != nil {
+		return fmt.Errorf("failed to connect: %s", err)
+	}
+	defer db.Close()
+	for _, s := range []string{
+		"DROP DATABASE IF EXISTS " + mysqlTestDatabase,
+		"CREATE DATABASE " + mysqlTestDatabase,
+	} {
+		if _, err := db.Exec(s); err != nil {
+			return fmt.Errorf("failed to recreate test database: %s", err)
+		}
+	}
+	return nil
+}
@@ -16,55 +16,66 @@ package registry
 
 import (
 	"context"
+	"errors"
 
-	"cloud.google.com/go/pubsub"
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/rpc"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
 )
 
 const TopicName = "registry-events"
 
-func (s *RegistryServer) notify(ctx context.Context, change rpc.Notification_Change, resource string) {
+// notify records a notification of a change. It must be called in the
+// transaction that makes the change so that the notification is stored if
+// and only if the change is committed. Stored notifications are delivered
+// by the dispatcher. They are stored even when this server has no watchers,
+// because watchers of other servers that share the database read them too.
+func (s *RegistryServer) notify(ctx context.Context, db *storage.Client, change rpc.Notification_Change, resource string) error {
+	event := models.NewEvent(change, resource)
+	// Without sinks, events are only read by watchers.
+	event.Dispatched = !s.hasSinks()
+	return db.CreateEvent(ctx, event)
+}
+
+// hasSinks returns true if notifications are delivered to any sinks.
+func (s *RegistryServer) hasSinks() bool {
+	return s.notifyEnabled || len(s.sinks) > 0
+}
+
+// publish delivers a notification to all notification sinks.
+// It returns an error if delivery to any sink failed.
+func (s *RegistryServer) publish(ctx context.Context, notification *rpc.Notification) error {
+	logger := log.FromContext(ctx)
+	var errs []error
+	for _, sink := range s.notificationSinks(ctx) {
+		if err := sink.Publish(ctx, notification); err != nil {
+			logger.WithError(err).Error("Failed to publish notification.")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notificationSinks returns the sinks that should receive notifications,
+// including the default Pub/Sub topic if notifications are enabled.
+func (s *RegistryServer) notificationSinks(ctx context.Context) []NotificationSink {
 	if !s.notifyEnabled {
-		return
+		return s.sinks
 	}
 
 	logger := log.FromContext(ctx)
 	if s.projectID == "" {
 		logger.Warn("Notifications are enabled but project ID is not set. Skipping notification.")
-		return
+		return s.sinks
 	}
 
 	client, err := s.getPubSubClient(ctx)
 	if err != nil {
 		logger.WithError(err).Error("Failed to get PubSub client.")
-		return
-	}
-
-	notification := &rpc.Notification{
-		Change:     change,
-		Resource:   resource,
-		ChangeTime: timestamppb.Now(),
-	}
-
-	msg, err := protojson.Marshal(notification)
-	if err != nil {
-		logger.WithError(err).Errorf("Failed to serialize notification: %v", notification)
-		return
-	}
-
-	topic := client.Topic(TopicName)
-	result := topic.Publish(ctx, &pubsub.Message{
-		Data: msg,
-	})
-
-	id, err := result.Get(ctx)
-	if err != nil {
-		logger.WithError(err).Error("Failed to publish notification.")
-		return
+		return s.sinks
 	}
 
-	logger.Infof("Published notification with message ID: %s", id)
+	pubSubSink := &PubSubSink{client: client, topic: client.Topic(TopicName)}
+	return append([]NotificationSink{pubSubSink}, s.sinks...)
 }
@@ -57,7 +57,7 @@ func TestNotifications(t *testing.T) {
 		t.Errorf("Topic %q not found", TopicName)
 	}
 
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	server.publish(ctx, &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "resource"})
 	pubSubTest.Wait()
 
 	ms := pubSubTest.Messages()
@@ -73,7 +73,7 @@ func TestNotificationErrors(t *testing.T) {
 	server := RegistryServer{
 		notifyEnabled: true,
 	}
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	server.publish(ctx, &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "resource"})
 
 	entry := rec.LastEntry()
 	want := "Notifications are enabled but project ID is not set. Skipping notification."
@@ -82,7 +82,7 @@ func TestNotificationErrors(t *testing.T) {
 	}
 
 	server.projectID = "id"
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	server.publish(ctx, &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "resource"})
 	entry = rec.LastEntry()
 	want = "Failed to get PubSub client."
 	if want != entry.Message() {
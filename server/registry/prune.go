@@ -0,0 +1,98 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+// pruner deletes the revisions that retention policies don't keep.
+type pruner struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// startPruner starts pruning revisions in the background if a prune
+// interval is configured.
+func (s *RegistryServer) startPruner() {
+	if s.pruneInterval <= 0 {
+		return
+	}
+	s.pruner = &pruner{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(s.pruner.done)
+		ctx := context.Background()
+		ticker := time.NewTicker(s.pruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.pruner.stop:
+				return
+			case <-ticker.C:
+			}
+			s.prune(ctx, time.Now())
+		}
+	}()
+}
+
+// stopPruner stops the pruner, waiting for any pruning in progress.
+func (s *RegistryServer) stopPruner() {
+	if s.pruner == nil {
+		return
+	}
+	close(s.pruner.stop)
+	<-s.pruner.done
+}
+
+// prune deletes the revisions of all projects that their retention policies
+// don't keep. Projects with invalid policies are logged and skipped.
+func (s *RegistryServer) prune(ctx context.Context, now time.Time) {
+	logger := log.FromContext(ctx)
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return
+	}
+	opts := storage.PageOptions{Size: 1000}
+	for {
+		listing, err := db.ListProjects(ctx, opts)
+		if err != nil {
+			logger.WithError(err).Error("Failed to list projects.")
+			return
+		}
+		for _, project := range listing.Projects {
+			api := names.Project{ProjectID: project.ProjectID}.Api("-")
+			pruned, err := s.pruneApiRevisions(ctx, api, now, false)
+			if err != nil {
+				logger.WithError(err).Errorf("Failed to prune revisions of %s.", api.Project())
+				continue
+			}
+			if len(pruned) > 0 {
+				logger.Infof("Pruned %d revisions of %s.", len(pruned), api.Project())
+			}
+		}
+		if listing.Token == "" {
+			return
+		}
+		opts.Token = listing.Token
+	}
+}
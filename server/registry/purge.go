@@ -0,0 +1,95 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+// purgeInterval is the time between checks for deleted APIs that have
+// outlived the purge window.
+const purgeInterval = time.Hour
+
+// purger permanently deletes APIs after the purge window has passed.
+type purger struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// startPurger starts purging deleted APIs in the background.
+func (s *RegistryServer) startPurger() {
+	s.purger = &purger{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(s.purger.done)
+		ctx := context.Background()
+		ticker := time.NewTicker(purgeInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.purger.stop:
+				return
+			case <-ticker.C:
+			}
+			s.purge(ctx, time.Now().Add(-s.purgeWindow))
+		}
+	}()
+}
+
+// stopPurger stops the purger, waiting for any purge in progress.
+func (s *RegistryServer) stopPurger() {
+	if s.purger == nil {
+		return
+	}
+	close(s.purger.stop)
+	<-s.purger.done
+}
+
+// purge permanently deletes APIs that were deleted before the specified time.
+// Each API is purged in its own transaction so that purging a large backlog
+// doesn't block other requests for long.
+func (s *RegistryServer) purge(ctx context.Context, before time.Time) {
+	logger := log.FromContext(ctx)
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return
+	}
+	apis, err := db.ListDeletedApis(ctx, before)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list deleted APIs.")
+		return
+	}
+	for _, api := range apis {
+		name := names.Api{ProjectID: api.ProjectID, ApiID: api.ApiID}
+		err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			return db.PurgeApi(ctx, name, before)
+		})
+		if isNotFound(err) {
+			// The API was restored after it was listed.
+			continue
+		} else if err != nil {
+			logger.WithError(err).Errorf("Failed to purge %s.", name)
+			return
+		}
+		logger.Infof("Purged %s.", name)
+	}
+}
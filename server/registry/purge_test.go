@@ -0,0 +1,278 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	deletedApi        = "projects/my-project/locations/global/apis/a"
+	deletedVersion    = deletedApi + "/versions/v1"
+	deletedSpec       = deletedVersion + "/specs/s"
+	deletedDeployment = deletedApi + "/deployments/d"
+	deletedArtifact   = deletedApi + "/artifacts/x"
+	keptApi           = "projects/my-project/locations/global/apis/b"
+)
+
+// purgeTestServer returns a server with the specified purge window that
+// holds an API with child resources and another API without children.
+func purgeTestServer(t *testing.T, window time.Duration) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database:    "sqlite3",
+		DBConfig:    fmt.Sprintf("%s/registry.db", t.TempDir()),
+		PurgeWindow: window,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+
+	if err := seeder.SeedRegistry(context.Background(), server,
+		&rpc.ApiSpec{Name: deletedSpec, MimeType: "text/plain", Contents: []byte("hello")},
+		&rpc.ApiDeployment{Name: deletedDeployment},
+		&rpc.Artifact{Name: deletedArtifact},
+		&rpc.Api{Name: keptApi},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	return server
+}
+
+func listApiNames(ctx context.Context, t *testing.T, server *RegistryServer, showDeleted bool) []string {
+	t.Helper()
+	resp, err := server.ListApis(ctx, &rpc.ListApisRequest{
+		Parent:      "projects/my-project/locations/global",
+		ShowDeleted: showDeleted,
+	})
+	if err != nil {
+		t.Fatalf("ListApis(show_deleted=%t) returned error: %s", showDeleted, err)
+	}
+	names := make([]string, len(resp.GetApis()))
+	for i, api := range resp.GetApis() {
+		names[i] = api.GetName()
+	}
+	return names
+}
+
+func TestSoftDeleteApi(t *testing.T) {
+	ctx := context.Background()
+	server := purgeTestServer(t, time.Hour)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteApi(%q) without force returned status code %q, want %q: %v", deletedApi, status.Code(err), codes.FailedPrecondition, err)
+	}
+	if _, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: deletedApi}); err != nil {
+		t.Errorf("GetApi(%q) after failed delete returned error: %s", deletedApi, err)
+	}
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi(%q) returned error: %s", deletedApi, err)
+	}
+
+	// The API and its children are hidden.
+	gets := map[string]func() error{
+		"GetApi": func() error {
+			_, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: deletedApi})
+			return err
+		},
+		"GetApiVersion": func() error {
+			_, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: deletedVersion})
+			return err
+		},
+		"GetApiSpec": func() error {
+			_, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: deletedSpec})
+			return err
+		},
+		"GetApiSpecContents": func() error {
+			_, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: deletedSpec})
+			return err
+		},
+		"GetApiDeployment": func() error {
+			_, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: deletedDeployment})
+			return err
+		},
+		"GetArtifact": func() error {
+			_, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: deletedArtifact})
+			return err
+		},
+		"DeleteApi": func() error {
+			_, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true})
+			return err
+		},
+		"DeleteApiVersion": func() error {
+			_, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: deletedVersion, Force: true})
+			return err
+		},
+		"DeleteArtifact": func() error {
+			_, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: deletedArtifact})
+			return err
+		},
+		"CreateApiVersion": func() error {
+			_, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+				Parent:       deletedApi,
+				ApiVersionId: "v2",
+				ApiVersion:   &rpc.ApiVersion{},
+			})
+			return err
+		},
+		"UpdateApiSpec": func() error {
+			_, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+				ApiSpec:      &rpc.ApiSpec{Name: deletedSpec},
+				AllowMissing: true,
+			})
+			return err
+		},
+	}
+	for desc, get := range gets {
+		if err := get(); status.Code(err) != codes.NotFound {
+			t.Errorf("%s for deleted API returned status code %q, want %q: %v", desc, status.Code(err), codes.NotFound, err)
+		}
+	}
+
+	if got := listApiNames(ctx, t, server, false); len(got) != 1 || got[0] != keptApi {
+		t.Errorf("ListApis() returned %v, want only %q", got, keptApi)
+	}
+	resp, err := server.ListApis(ctx, &rpc.ListApisRequest{
+		Parent:      "projects/my-project/locations/global",
+		Filter:      fmt.Sprintf("name == %q", deletedApi),
+		ShowDeleted: true,
+	})
+	if err != nil {
+		t.Fatalf("ListApis(show_deleted=true) returned error: %s", err)
+	}
+	if len(resp.GetApis()) != 1 || resp.Apis[0].GetDeleteTime() == nil {
+		t.Errorf("ListApis(show_deleted=true) returned %v, want %q with delete_time", resp.GetApis(), deletedApi)
+	}
+
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateApi() for deleted API returned status code %q, want %q: %v", status.Code(err), codes.AlreadyExists, err)
+	}
+}
+
+func TestUndeleteApi(t *testing.T) {
+	ctx := context.Background()
+	server := purgeTestServer(t, time.Hour)
+
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UndeleteApi(%q) before delete returned status code %q, want %q: %v", deletedApi, status.Code(err), codes.FailedPrecondition, err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi(%q) returned error: %s", deletedApi, err)
+	}
+
+	api, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi})
+	if err != nil {
+		t.Fatalf("UndeleteApi(%q) returned error: %s", deletedApi, err)
+	}
+	if api.GetName() != deletedApi || api.GetDeleteTime() != nil {
+		t.Errorf("UndeleteApi(%q) returned %v, want restored API", deletedApi, api)
+	}
+
+	if got := listApiNames(ctx, t, server, false); len(got) != 2 {
+		t.Errorf("ListApis() returned %v, want both APIs", got)
+	}
+	contents, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: deletedSpec})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents(%q) returned error: %s", deletedSpec, err)
+	}
+	if string(contents.GetData()) != "hello" {
+		t.Errorf("GetApiSpecContents(%q) returned %q, want %q", deletedSpec, contents.GetData(), "hello")
+	}
+	if _, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: deletedDeployment}); err != nil {
+		t.Errorf("GetApiDeployment(%q) returned error: %s", deletedDeployment, err)
+	}
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: deletedArtifact}); err != nil {
+		t.Errorf("GetArtifact(%q) returned error: %s", deletedArtifact, err)
+	}
+
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UndeleteApi(%q) after undelete returned status code %q, want %q: %v", deletedApi, status.Code(err), codes.FailedPrecondition, err)
+	}
+	missing := "projects/my-project/locations/global/apis/missing"
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: missing}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteApi(%q) returned status code %q, want %q: %v", missing, status.Code(err), codes.NotFound, err)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: "invalid"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UndeleteApi(%q) returned status code %q, want %q: %v", "invalid", status.Code(err), codes.InvalidArgument, err)
+	}
+}
+
+func TestPurgeDeletedApis(t *testing.T) {
+	ctx := context.Background()
+	server := purgeTestServer(t, time.Hour)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi(%q) returned error: %s", deletedApi, err)
+	}
+
+	// The API was deleted inside the purge window, so it is kept.
+	server.purge(ctx, time.Now().Add(-server.purgeWindow))
+	if got := listApiNames(ctx, t, server, true); len(got) != 2 {
+		t.Errorf("ListApis(show_deleted=true) returned %v before the purge window passed, want both APIs", got)
+	}
+
+	server.purge(ctx, time.Now().Add(time.Second))
+	if got := listApiNames(ctx, t, server, true); len(got) != 1 || got[0] != keptApi {
+		t.Errorf("ListApis(show_deleted=true) returned %v after purge, want only %q", got, keptApi)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteApi(%q) after purge returned status code %q, want %q: %v", deletedApi, status.Code(err), codes.NotFound, err)
+	}
+	for _, table := range []string{"versions", "specs", "deployments", "artifacts", "blobs", "blob_contents"} {
+		if count, err := server.storageClient.RowCount(ctx, table); err != nil {
+			t.Errorf("RowCount(%q) returned error: %s", table, err)
+		} else if count != 0 {
+			t.Errorf("RowCount(%q) returned %d after purge, want 0", table, count)
+		}
+	}
+
+	// The name can be reused after the API is purged.
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Errorf("CreateApi() after purge returned error: %s", err)
+	}
+}
+
+func TestDeleteApiWithoutPurgeWindow(t *testing.T) {
+	ctx := context.Background()
+	server := purgeTestServer(t, 0)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: deletedApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi(%q) returned error: %s", deletedApi, err)
+	}
+	if got := listApiNames(ctx, t, server, true); len(got) != 1 || got[0] != keptApi {
+		t.Errorf("ListApis(show_deleted=true) returned %v, want only %q", got, keptApi)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: deletedApi}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteApi(%q) returned status code %q, want %q: %v", deletedApi, status.Code(err), codes.NotFound, err)
+	}
+}
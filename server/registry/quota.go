@@ -0,0 +1,142 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the metrics of project quotas.
+const (
+	apisMetric          = "apis"
+	specRevisionsMetric = "spec_revisions"
+	blobBytesMetric     = "blob_bytes"
+)
+
+// Limits are the quotas of a project. Zero values are unlimited.
+type Limits struct {
+	// MaxApis is the largest number of APIs in the project.
+	MaxApis int64
+	// MaxSpecRevisions is the largest number of revisions of each spec.
+	MaxSpecRevisions int64
+	// MaxBlobBytes is the largest total size of the contents of the specs
+	// and artifacts of the project.
+	MaxBlobBytes int64
+}
+
+// QuotaConfig configures the limits of projects and the rates of calls.
+type QuotaConfig struct {
+	// Limits apply to every project. Projects can have their own limits.
+	Limits Limits
+	// Projects holds the limits of individual projects, keyed by project ID.
+	// Their zero values are taken from Limits.
+	Projects map[string]Limits
+	// RequestsPerSecond is the rate of calls that each caller can make.
+	// If zero, calls are not rate limited.
+	RequestsPerSecond float64
+	// RequestBurst is the number of calls that each caller can make at once.
+	// If zero, it is the rate rounded up.
+	RequestBurst int
+}
+
+// limits returns the limits of a project.
+func (q QuotaConfig) limits(projectID string) Limits {
+	l := q.Limits
+	if p, ok := q.Projects[projectID]; ok {
+		if p.MaxApis != 0 {
+			l.MaxApis = p.MaxApis
+		}
+		if p.MaxSpecRevisions != 0 {
+			l.MaxSpecRevisions = p.MaxSpecRevisions
+		}
+		if p.MaxBlobBytes != 0 {
+			l.MaxBlobBytes = p.MaxBlobBytes
+		}
+	}
+	return l
+}
+
+// quotaExceeded returns a RESOURCE_EXHAUSTED error with the details of an exceeded quota.
+func quotaExceeded(subject, metric string, limit int64) error {
+	description := fmt.Sprintf("the %s quota of %s is %d", metric, subject, limit)
+	st, err := status.New(codes.ResourceExhausted, "quota exceeded: "+description).WithDetails(
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     subject,
+				Description: description,
+			}},
+		},
+	)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
+
+// checkApiQuota returns an error if another API can't be added to a project.
+func (s *RegistryServer) checkApiQuota(ctx context.Context, db *storage.Client, project names.Project) error {
+	limit := s.quotas.limits(project.ProjectID).MaxApis
+	if limit == 0 {
+		return nil
+	}
+	count, err := db.LockQuota(ctx, project.ProjectID).CountApis(ctx, project.ProjectID)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return quotaExceeded(project.String(), apisMetric, limit)
+	}
+	return nil
+}
+
+// checkSpecRevisionQuota returns an error if another revision can't be added to a spec.
+func (s *RegistryServer) checkSpecRevisionQuota(ctx context.Context, db *storage.Client, spec names.Spec) error {
+	limit := s.quotas.limits(spec.ProjectID).MaxSpecRevisions
+	if limit == 0 {
+		return nil
+	}
+	count, err := db.LockQuota(ctx, spec.ProjectID).CountSpecRevisions(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return quotaExceeded(spec.String(), specRevisionsMetric, limit)
+	}
+	return nil
+}
+
+// checkBlobQuota returns an error if contents of the given size can't be stored
+// in a project with the given key. Contents that the key already holds are replaced.
+func (s *RegistryServer) checkBlobQuota(ctx context.Context, db *storage.Client, project names.Project, key string, size int) error {
+	limit := s.quotas.limits(project.ProjectID).MaxBlobBytes
+	if limit == 0 {
+		return nil
+	}
+	used, err := db.LockQuota(ctx, project.ProjectID).BlobBytes(ctx, project.ProjectID, key)
+	if err != nil {
+		return err
+	}
+	if used+int64(size) > limit {
+		return quotaExceeded(project.String(), blobBytesMetric, limit)
+	}
+	return nil
+}
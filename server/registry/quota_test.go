@@ -0,0 +1,462 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/authz"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func serverWithQuotas(t *testing.T, quotas QuotaConfig) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Quotas:   quotas,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// checkQuotaFailure fails the test unless err is a RESOURCE_EXHAUSTED error
+// with a quota failure of the subject.
+func checkQuotaFailure(t *testing.T, err error, subject string) {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("returned status code %s (%v), want %s", st.Code(), err, codes.ResourceExhausted)
+	}
+	for _, d := range st.Details() {
+		if f, ok := d.(*errdetails.QuotaFailure); ok {
+			if len(f.GetViolations()) != 1 || f.GetViolations()[0].GetSubject() != subject {
+				t.Errorf("returned quota failure %v, want one violation of %q", f, subject)
+			}
+			return
+		}
+	}
+	t.Errorf("returned details %v, want a quota failure", st.Details())
+}
+
+func TestApiQuota(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithQuotas(t, QuotaConfig{
+		Limits:   Limits{MaxApis: 2},
+		Projects: map[string]Limits{"large": {MaxApis: 3}},
+	})
+	createProjects(t, server, "small", "large")
+
+	createApi := func(project, id string) error {
+		_, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+			Parent: "projects/" + project + "/locations/global",
+			ApiId:  id,
+			Api:    &rpc.Api{},
+		})
+		return err
+	}
+	for _, id := range []string{"a", "b"} {
+		if err := createApi("small", id); err != nil {
+			t.Fatalf("CreateApi(%q) returned error: %s", id, err)
+		}
+	}
+	checkQuotaFailure(t, createApi("small", "c"), "projects/small")
+
+	// Projects can have their own limits.
+	for _, id := range []string{"a", "b", "c"} {
+		if err := createApi("large", id); err != nil {
+			t.Fatalf("CreateApi(%q) returned error: %s", id, err)
+		}
+	}
+	checkQuotaFailure(t, createApi("large", "d"), "projects/large")
+
+	// Updates of existing APIs are allowed, but creations with allow_missing are not.
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{Name: "projects/small/locations/global/apis/a", DisplayName: "A"},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	_, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:          &rpc.Api{Name: "projects/small/locations/global/apis/c"},
+		AllowMissing: true,
+	})
+	checkQuotaFailure(t, err, "projects/small")
+
+	// Deleting an API frees its quota.
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: "projects/small/locations/global/apis/a"}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if err := createApi("small", "c"); err != nil {
+		t.Fatalf("CreateApi() after DeleteApi() returned error: %s", err)
+	}
+}
+
+func TestSpecRevisionQuota(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithQuotas(t, QuotaConfig{Limits: Limits{MaxSpecRevisions: 2}})
+	createProjects(t, server, "my-project")
+	spec := "projects/my-project/locations/global/apis/a/versions/v1/specs/s"
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/my-project/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       "projects/my-project/locations/global/apis/a",
+		ApiVersionId: "v1",
+		ApiVersion:   &rpc.ApiVersion{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiVersion() returned error: %s", err)
+	}
+	first, err := server.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+		Parent:    "projects/my-project/locations/global/apis/a/versions/v1",
+		ApiSpecId: "s",
+		ApiSpec:   &rpc.ApiSpec{Contents: []byte("one")},
+	})
+	if err != nil {
+		t.Fatalf("Setup: CreateApiSpec() returned error: %s", err)
+	}
+
+	update := func(contents string) error {
+		_, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec:    &rpc.ApiSpec{Name: spec, Contents: []byte(contents)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+		})
+		return err
+	}
+	if err := update("two"); err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	checkQuotaFailure(t, update("three"), spec)
+
+	// Updates that don't create revisions are allowed.
+	if _, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: spec, Description: "described"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	}); err != nil {
+		t.Fatalf("UpdateApiSpec(description) returned error: %s", err)
+	}
+
+	_, err = server.RollbackApiSpec(ctx, &rpc.RollbackApiSpecRequest{
+		Name:       spec,
+		RevisionId: first.GetRevisionId(),
+	})
+	checkQuotaFailure(t, err, spec)
+
+	// Deleting a revision frees its quota.
+	if _, err := server.DeleteApiSpecRevision(ctx, &rpc.DeleteApiSpecRevisionRequest{
+		Name: spec + "@" + first.GetRevisionId(),
+	}); err != nil {
+		t.Fatalf("DeleteApiSpecRevision() returned error: %s", err)
+	}
+	if err := update("three"); err != nil {
+		t.Fatalf("UpdateApiSpec() after DeleteApiSpecRevision() returned error: %s", err)
+	}
+}
+
+func TestConcurrentQuotaChecks(t *testing.T) {
+	ctx := context.Background()
+	server, ok := defaultTestServer(t).(*RegistryServer)
+	if !ok {
+		t.Skip("quotas are configured in the server")
+	}
+	if useMySQL {
+		t.Skip("the embedded MySQL server doesn't isolate concurrent transactions")
+	}
+	const limit = 3
+	server.quotas = QuotaConfig{Limits: Limits{MaxApis: limit, MaxSpecRevisions: limit}}
+	spec := "projects/my-project/locations/global/apis/a/versions/v1/specs/s"
+	if err := seeder.SeedRegistry(ctx, server, &rpc.ApiSpec{Name: spec, Contents: []byte("seed")}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Creations that are checked at the same time can't exceed the limits.
+	run := func(f func(i int) error) int {
+		var created int64
+		var wg sync.WaitGroup
+		wg.Add(concurrency)
+		for i := 0; i < concurrency; i++ {
+			go func(i int) {
+				defer wg.Done()
+				err := f(i)
+				if code := status.Code(err); code == codes.OK {
+					atomic.AddInt64(&created, 1)
+				} else if code != codes.ResourceExhausted && !isRetryable(code) {
+					t.Errorf("returned status code %s (%v), want %s", code, err, codes.ResourceExhausted)
+				}
+			}(i)
+		}
+		wg.Wait()
+		return int(created)
+	}
+	apis := run(func(i int) error {
+		_, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+			Parent: "projects/my-project/locations/global",
+			ApiId:  fmt.Sprintf("api-%d", i),
+			Api:    &rpc.Api{},
+		})
+		return err
+	})
+	if apis != limit-1 {
+		t.Errorf("Concurrent CreateApi() calls created %d APIs, want %d", apis, limit-1)
+	}
+	revisions := run(func(i int) error {
+		_, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec:    &rpc.ApiSpec{Name: spec, Contents: []byte(fmt.Sprint(i))},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+		})
+		return err
+	})
+	if revisions > limit-1 {
+		t.Errorf("Concurrent UpdateApiSpec() calls created %d revisions, want at most %d", revisions, limit-1)
+	}
+}
+
+func TestBlobQuota(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithQuotas(t, QuotaConfig{Limits: Limits{MaxBlobBytes: 10}})
+	createProjects(t, server, "my-project", "other-project")
+	parent := "projects/my-project/locations/global"
+
+	createArtifact := func(parent, id, contents string) error {
+		_, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+			Parent:     parent,
+			ArtifactId: id,
+			Artifact:   &rpc.Artifact{Contents: []byte(contents)},
+		})
+		return err
+	}
+	if err := createArtifact(parent, "a", "123456"); err != nil {
+		t.Fatalf("CreateArtifact() returned error: %s", err)
+	}
+	checkQuotaFailure(t, createArtifact(parent, "b", "12345"), "projects/my-project")
+	if err := createArtifact(parent, "b", "1234"); err != nil {
+		t.Fatalf("CreateArtifact() returned error: %s", err)
+	}
+
+	// Other projects have their own quotas.
+	if err := createArtifact("projects/other-project/locations/global", "a", "1234567890"); err != nil {
+		t.Fatalf("CreateArtifact() in other project returned error: %s", err)
+	}
+
+	// Replacements only count the new contents.
+	if _, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: parent + "/artifacts/a", Contents: []byte("654321")},
+	}); err != nil {
+		t.Fatalf("ReplaceArtifact() returned error: %s", err)
+	}
+	_, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: parent + "/artifacts/a", Contents: []byte("7654321")},
+	})
+	checkQuotaFailure(t, err, "projects/my-project")
+
+	// Spec contents count towards the same quota.
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{Parent: parent, ApiId: "a", Api: &rpc.Api{}}); err != nil {
+		t.Fatalf("Setup: CreateApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       parent + "/apis/a",
+		ApiVersionId: "v1",
+		ApiVersion:   &rpc.ApiVersion{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiVersion() returned error: %s", err)
+	}
+	_, err = server.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+		Parent:    parent + "/apis/a/versions/v1",
+		ApiSpecId: "s",
+		ApiSpec:   &rpc.ApiSpec{Contents: []byte("x")},
+	})
+	checkQuotaFailure(t, err, "projects/my-project")
+}
+
+func TestGetProjectQuota(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithQuotas(t, QuotaConfig{
+		Limits:            Limits{MaxApis: 10, MaxBlobBytes: 1000},
+		Projects:          map[string]Limits{"my-project": {MaxSpecRevisions: 5}},
+		RequestsPerSecond: 2.5,
+	})
+	createProjects(t, server, "my-project")
+	parent := "projects/my-project/locations/global"
+	for _, id := range []string{"a", "b"} {
+		if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{Parent: parent, ApiId: id, Api: &rpc.Api{}}); err != nil {
+			t.Fatalf("Setup: CreateApi(%q) returned error: %s", id, err)
+		}
+	}
+	if _, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       parent + "/apis/a",
+		ApiVersionId: "v1",
+		ApiVersion:   &rpc.ApiVersion{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiVersion() returned error: %s", err)
+	}
+	for _, contents := range []string{"one", "two", "three"} {
+		if _, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec:      &rpc.ApiSpec{Name: parent + "/apis/a/versions/v1/specs/s", Contents: []byte(contents)},
+			AllowMissing: true,
+		}); err != nil {
+			t.Fatalf("Setup: UpdateApiSpec() returned error: %s", err)
+		}
+	}
+
+	got, err := server.GetProjectQuota(ctx, &rpc.GetProjectQuotaRequest{Name: "projects/my-project"})
+	if err != nil {
+		t.Fatalf("GetProjectQuota() returned error: %s", err)
+	}
+	want := &rpc.ProjectQuota{
+		Name: "projects/my-project",
+		Metrics: []*rpc.QuotaMetric{
+			{Metric: "apis", Limit: 10, Usage: 2},
+			{Metric: "spec_revisions", Limit: 5, Usage: 3},
+			{Metric: "blob_bytes", Limit: 1000, Usage: 11},
+		},
+		RequestsPerSecond: 2.5,
+		RequestBurst:      3,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetProjectQuota() returned unexpected diff: (-want +got):\n%s", diff)
+	}
+
+	_, err = server.GetProjectQuota(ctx, &rpc.GetProjectQuotaRequest{Name: "projects/missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetProjectQuota(missing) returned status code %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	if newRateLimiter(0, 10) != nil {
+		t.Errorf("newRateLimiter(0, 10) returned a limiter, want nil")
+	}
+
+	// A rate this slow doesn't refill during the test.
+	interceptor := newRateLimiter(0.001, 2).unaryInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(caller, method string) error {
+		ctx := authz.NewContext(context.Background(), caller)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	getApi := "/google.cloud.apigeeregistry.v1.Registry/GetApi"
+
+	for i := 0; i < 2; i++ {
+		if err := call("alice", getApi); err != nil {
+			t.Fatalf("call %d returned error: %s", i, err)
+		}
+	}
+	err := call("alice", getApi)
+	checkQuotaFailure(t, err, "alice")
+	var retry *errdetails.RetryInfo
+	for _, d := range status.Convert(err).Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	if retry == nil || retry.GetRetryDelay().AsDuration() <= 0 {
+		t.Errorf("returned retry info %v, want a positive delay", retry)
+	}
+
+	// Callers are limited separately.
+	if err := call("bob", getApi); err != nil {
+		t.Errorf("call by other caller returned error: %s", err)
+	}
+	// Only registry methods are limited.
+	if err := call("alice", "/grpc.health.v1.Health/Check"); err != nil {
+		t.Errorf("call of other service returned error: %s", err)
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	r := newRateLimiter(100, 1)
+	if err := r.allow(authz.NewContext(context.Background(), "alice")); err != nil {
+		t.Fatalf("allow() returned error: %s", err)
+	}
+	later := r.swept.Add(sweepInterval)
+	r.sweep(later)
+	if len(r.callers) != 0 {
+		t.Errorf("sweep() kept %d idle callers, want 0", len(r.callers))
+	}
+}
+
+func TestRateLimitedCaller(t *testing.T) {
+	withPeer := func(addr string, md ...string) context.Context {
+		tcp, err := net.ResolveTCPAddr("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcp})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+	}
+	tests := []struct {
+		desc string
+		ctx  context.Context
+		want string
+	}{
+		{
+			desc: "identified caller",
+			ctx:  authz.NewContext(withPeer("10.0.0.1:1234"), "alice"),
+			want: "alice",
+		},
+		{
+			desc: "remote caller",
+			ctx:  withPeer("10.0.0.1:1234", "x-forwarded-for", "10.0.0.2"),
+			want: "10.0.0.1",
+		},
+		{
+			desc: "gateway caller",
+			ctx:  withPeer("127.0.0.1:1234", "x-forwarded-for", "10.0.0.2, 10.0.0.3", gatewayTokenKey, gatewayToken),
+			want: "10.0.0.3",
+		},
+		{
+			desc: "local caller with forwarded address",
+			ctx:  withPeer("127.0.0.1:1234", "x-forwarded-for", "10.0.0.2"),
+			want: "127.0.0.1",
+		},
+		{
+			desc: "caller with wrong gateway token",
+			ctx:  withPeer("10.0.0.1:1234", "x-forwarded-for", "10.0.0.2", gatewayTokenKey, "guess"),
+			want: "10.0.0.1",
+		},
+		{
+			desc: "local caller",
+			ctx:  withPeer("[::1]:1234"),
+			want: "::1",
+		},
+	}
+	for _, test := range tests {
+		if got := rateLimitedCaller(test.ctx); got != test.want {
+			t.Errorf("%s: rateLimitedCaller() returned %q, want %q", test.desc, got, test.want)
+		}
+	}
+}
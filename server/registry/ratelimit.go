@@ -0,0 +1,166 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/server/registry/authz"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// rateLimitedPrefix is the prefix of the methods that are rate limited.
+const rateLimitedPrefix = "/google.cloud.apigeeregistry.v1."
+
+// sweepInterval is the minimum time between removals of idle callers.
+const sweepInterval = time.Minute
+
+// rateLimiter limits the rate of calls of each caller.
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu      sync.Mutex
+	callers map[string]*rate.Limiter
+	swept   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	return &rateLimiter{
+		limit:   rate.Limit(requestsPerSecond),
+		burst:   burst,
+		callers: make(map[string]*rate.Limiter),
+		swept:   time.Now(),
+	}
+}
+
+// allow returns an error if a caller has exceeded its rate.
+func (r *rateLimiter) allow(ctx context.Context) error {
+	caller := rateLimitedCaller(ctx)
+	now := time.Now()
+
+	r.mu.Lock()
+	r.sweep(now)
+	l, ok := r.callers[caller]
+	if !ok {
+		l = rate.NewLimiter(r.limit, r.burst)
+		r.callers[caller] = l
+	}
+	r.mu.Unlock()
+
+	if l.AllowN(now, 1) {
+		return nil
+	}
+	description := fmt.Sprintf("the rate limit of %s is %g requests per second", caller, float64(r.limit))
+	st, err := status.New(codes.ResourceExhausted, "quota exceeded: "+description).WithDetails(
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     caller,
+				Description: description,
+			}},
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(time.Duration(float64(time.Second) / float64(r.limit))),
+		},
+	)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
+
+// sweep removes the callers that have been idle long enough to have their full burst,
+// since they are limited like new callers. It must be called with the lock held.
+func (r *rateLimiter) sweep(now time.Time) {
+	if now.Sub(r.swept) < sweepInterval {
+		return
+	}
+	r.swept = now
+	for caller, l := range r.callers {
+		if l.TokensAt(now) >= float64(r.burst) {
+			delete(r.callers, caller)
+		}
+	}
+}
+
+// rateLimitedCaller returns the identity that a call is rate limited by. Callers
+// without identities are limited by their addresses. Calls of the REST gateway
+// of this process, which are marked with gatewayToken, are limited by the
+// address that was last added to their x-forwarded-for metadata.
+func rateLimitedCaller(ctx context.Context) string {
+	if caller := authz.Identify(ctx); caller != "" {
+		return caller
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if token := md.Get(gatewayTokenKey); len(token) == 1 && token[0] == gatewayToken {
+			if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+				addrs := strings.Split(fwd[len(fwd)-1], ",")
+				return strings.TrimSpace(addrs[len(addrs)-1])
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// unaryInterceptor returns a gRPC server interceptor that limits the rate of calls.
+func (r *rateLimiter) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, rateLimitedPrefix) {
+			if err := r.allow(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor returns a gRPC server interceptor that limits the rate of streaming calls.
+func (r *rateLimiter) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, rateLimitedPrefix) {
+			if err := r.allow(ss.Context()); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
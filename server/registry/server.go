@@ -19,13 +19,14 @@ import (
 	"errors"
 	"log"
 	"net"
-	"sync"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
@@ -39,6 +40,25 @@ type Config struct {
 	Notify    bool
 	ProjectID string
 	NoMigrate bool
+	// Sinks receive notifications of changes in addition to the
+	// Pub/Sub topic used when Notify is set. They are closed by Close.
+	Sinks []NotificationSink
+	// PurgeWindow is how long deleted APIs are kept before they are purged.
+	// Until then, they can be restored with UndeleteApi.
+	// If zero, APIs are permanently deleted immediately.
+	PurgeWindow time.Duration
+	// PruneInterval is the time between deletions of the spec and
+	// deployment revisions that retention policies don't keep.
+	// If zero, revisions are only pruned by PruneApiRevisions calls.
+	PruneInterval time.Duration
+	// BlobStore configures where the contents of spec revisions and
+	// artifacts are stored. By default, they are stored in the database.
+	BlobStore BlobStoreConfig
+	// Quotas limit the resources of projects and the rates of calls.
+	Quotas QuotaConfig
+	// MaxContentsBytes is the largest contents that upload streams accept.
+	// If zero, 256 MiB is used.
+	MaxContentsBytes int
 }
 
 // RegistryServer implements a Registry server.
@@ -49,6 +69,17 @@ type RegistryServer struct {
 	projectID     string
 	storageClient *storage.Client
 	pubSubClient  *pubsub.Client
+	sinks         []NotificationSink
+	watchers      watchers
+	dispatcher    *dispatcher
+	purgeWindow   time.Duration
+	purger        *purger
+	pruneInterval time.Duration
+	pruner        *pruner
+	quotas        QuotaConfig
+	rateLimiter   *rateLimiter
+	maxContents   int
+	health        *healthChecker
 
 	rpc.UnimplementedRegistryServer
 	rpc.UnimplementedAdminServer
@@ -60,6 +91,15 @@ func New(config Config) (*RegistryServer, error) {
 		dbConfig:      config.DBConfig,
 		notifyEnabled: config.Notify,
 		projectID:     config.ProjectID,
+		sinks:         config.Sinks,
+		purgeWindow:   config.PurgeWindow,
+		pruneInterval: config.PruneInterval,
+		quotas:        config.Quotas,
+		rateLimiter:   newRateLimiter(config.Quotas.RequestsPerSecond, config.Quotas.RequestBurst),
+		maxContents:   config.MaxContentsBytes,
+	}
+	if s.maxContents <= 0 {
+		s.maxContents = defaultMaxContentsBytes
 	}
 
 	if s.database == "" {
@@ -73,6 +113,14 @@ func New(config Config) (*RegistryServer, error) {
 	if err != nil {
 		return nil, err
 	}
+	blobs, err := newBlobStore(config.BlobStore)
+	if err != nil {
+		s.storageClient.Close()
+		return nil, err
+	}
+	if blobs != nil {
+		s.storageClient.UseBlobStore(blobs)
+	}
 	if err := s.storageClient.EnsureTables(ctx); err != nil {
 		return nil, err
 	}
@@ -92,6 +140,11 @@ func New(config Config) (*RegistryServer, error) {
 		}
 	}
 
+	s.startDispatcher()
+	s.startPurger()
+	s.startPruner()
+	s.startHealthChecker()
+
 	return s, nil
 }
 
@@ -102,28 +155,17 @@ func (s *RegistryServer) getStorageClient(ctx context.Context) (*storage.Client,
 	return s.storageClient, nil
 }
 
-var mutex sync.Mutex
-
 func (s *RegistryServer) runInTransaction(ctx context.Context, fn func(ctx context.Context, db *storage.Client) error) error {
-	s.begin()
-	defer s.end()
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return status.Error(codes.Unavailable, err.Error())
 	}
-	return db.Transaction(ctx, fn)
-}
-
-func (s *RegistryServer) begin() {
-	if s.database == "sqlite3" {
-		mutex.Lock()
-	}
-}
-
-func (s *RegistryServer) end() {
-	if s.database == "sqlite3" {
-		mutex.Unlock()
+	if err := db.Transaction(ctx, fn); err != nil {
+		return err
 	}
+	// Committed transactions may have stored notifications.
+	s.dispatcher.wake()
+	return nil
 }
 
 func (s *RegistryServer) getPubSubClient(ctx context.Context) (*pubsub.Client, error) {
@@ -134,10 +176,18 @@ func (s *RegistryServer) getPubSubClient(ctx context.Context) (*pubsub.Client, e
 }
 
 func (s *RegistryServer) Close() {
+	s.stopHealthChecker()
+	s.stopPruner()
+	s.stopPurger()
+	s.stopDispatcher()
+	s.watchers.closeAll()
 	s.storageClient.Close()
 	if s.pubSubClient != nil {
 		s.pubSubClient.Topic(TopicName).Flush()
 	}
+	for _, sink := range s.sinks {
+		sink.Close()
+	}
 }
 
 func isNotFound(err error) bool {
@@ -145,17 +195,25 @@ func isNotFound(err error) bool {
 }
 
 // GRPCListen starts a net.Listener and grpc.Server for this RegistryServer.
-// Caller is responsible for stopping server.
+// Caller is responsible for stopping server. Calls are rate limited after
+// the interceptors of the options, which can identify callers.
 func (rs *RegistryServer) ServeGRPC(addr *net.TCPAddr, opt ...grpc.ServerOption) (net.Listener, *grpc.Server, error) {
 	l, err := net.ListenTCP("tcp", addr)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if rs.rateLimiter != nil {
+		opt = append(opt,
+			grpc.ChainUnaryInterceptor(rs.rateLimiter.unaryInterceptor()),
+			grpc.ChainStreamInterceptor(rs.rateLimiter.streamInterceptor()),
+		)
+	}
 	s := grpc.NewServer(opt...)
 	reflection.Register(s)
 	rpc.RegisterRegistryServer(s, rs)
 	rpc.RegisterAdminServer(s, rs)
+	healthpb.RegisterHealthServer(s, rs.health.server)
 
 	go func() {
 		if err := s.Serve(l); err != nil {
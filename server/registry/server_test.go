@@ -57,7 +57,7 @@ type TestServer interface {
 }
 
 // defaultTestServer will call server.Close() when test completes
-func defaultTestServer(t *testing.T) TestServer {
+func defaultTestServer(t testing.TB) TestServer {
 	t.Helper()
 	var err error
 	var server *RegistryServer
@@ -82,6 +82,12 @@ func defaultTestServer(t *testing.T) TestServer {
 			t.Fatalf("Setup: failed to get server with postgres: %s", err)
 		}
 	}
+	if useMySQL {
+		server, err = serverWithMySQL(t)
+		if err != nil {
+			t.Fatalf("Setup: failed to get server with mysql: %s", err)
+		}
+	}
 	if server == nil {
 		if server, err = serverWithSQLite(t); err != nil {
 			t.Fatalf("Setup: failed to get server with SQLite: %s", err)
@@ -92,7 +98,7 @@ func defaultTestServer(t *testing.T) TestServer {
 }
 
 // serverWithSQLite will call server.Close() when test completes
-func serverWithSQLite(t *testing.T) (*RegistryServer, error) {
+func serverWithSQLite(t testing.TB) (*RegistryServer, error) {
 	server, err := New(Config{
 		Database: "sqlite3",
 		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
@@ -104,7 +110,7 @@ func serverWithSQLite(t *testing.T) (*RegistryServer, error) {
 }
 
 // serverWithPostgres will call server.Close() when test completes
-func serverWithPostgres(t *testing.T) (*RegistryServer, error) {
+func serverWithPostgres(t testing.TB) (*RegistryServer, error) {
 	sharedStorage.Lock()
 	t.Cleanup(sharedStorage.Unlock)
 
@@ -0,0 +1,235 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NotificationSink receives notifications of changes to registry resources.
+// Publish is called once for each change and should return only after the
+// notification has been delivered or has permanently failed.
+type NotificationSink interface {
+	Publish(ctx context.Context, n *rpc.Notification) error
+	Close() error
+}
+
+// PubSubSink publishes notifications to a Google Cloud Pub/Sub topic.
+type PubSubSink struct {
+	client *pubsub.Client
+	topic  *pubsub.Topic
+}
+
+func (s *PubSubSink) Publish(ctx context.Context, n *rpc.Notification) error {
+	msg, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	id, err := s.topic.Publish(ctx, &pubsub.Message{Data: msg}).Get(ctx)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("Published notification with message ID: %s", id)
+	return nil
+}
+
+func (s *PubSubSink) Close() error {
+	s.topic.Flush()
+	return nil
+}
+
+// FileSink appends notifications to a local file, one JSON object per line.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens (or creates) the file at path for appending notifications.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+func (s *FileSink) Publish(ctx context.Context, n *rpc.Notification) error {
+	line, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	// Write each entry with a single call so that entries are never interleaved.
+	line = append(line, '\n')
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// Headers set on each webhook request.
+const (
+	WebhookSignatureHeader = "X-Registry-Signature"
+	WebhookTimestampHeader = "X-Registry-Timestamp"
+)
+
+// WebhookConfig configures a WebhookSink.
+type WebhookConfig struct {
+	// URL that receives notifications as JSON POST requests.
+	URL string
+	// Secret used to sign requests. If empty, requests are unsigned.
+	Secret string
+	// MaxAttempts is the number of times delivery is attempted (default 5).
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry (default 500ms).
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries (default 30s).
+	MaxBackoff time.Duration
+	// Timeout applies to each delivery attempt (default 10s).
+	Timeout time.Duration
+}
+
+// WebhookSink posts notifications to an HTTP endpoint.
+//
+// When a secret is configured, each request carries a timestamp header and
+// a signature header with the value "sha256=" followed by the hex-encoded
+// HMAC-SHA256 of the timestamp, a period, and the request body. Receivers
+// should recompute the signature and reject stale timestamps.
+//
+// Requests that fail with a network error, a 429, or a 5xx response are
+// retried with exponential backoff.
+type WebhookSink struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink that posts notifications to config.URL.
+func NewWebhookSink(config WebhookConfig) (*WebhookSink, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook url %q: %s", config.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid webhook url %q: scheme must be http or https", config.URL)
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = 500 * time.Millisecond
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 30 * time.Second
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &WebhookSink{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, n *rpc.Notification) error {
+	body, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	backoff := gax.Backoff{
+		Initial:    s.config.InitialBackoff,
+		Max:        s.config.MaxBackoff,
+		Multiplier: 2,
+	}
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= s.config.MaxAttempts {
+			return fmt.Errorf("webhook %s failed after %d attempt(s): %w", s.config.URL, attempt, err)
+		}
+		log.FromContext(ctx).WithError(err).Debugf("Retrying webhook %s.", s.config.URL)
+		if err := gax.Sleep(ctx, backoff.Pause()); err != nil {
+			return err
+		}
+	}
+}
+
+// post makes a single delivery attempt and reports whether a failure can be retried.
+func (s *WebhookSink) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, WebhookSignature(s.config.Secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch code := resp.StatusCode; {
+	case code >= 200 && code < 300:
+		return false, nil
+	case code == http.StatusTooManyRequests || code >= 500:
+		return true, fmt.Errorf("unexpected status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// WebhookSignature computes the signature of a webhook request body.
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// recordingSink is a NotificationSink that keeps everything it receives.
+type recordingSink struct {
+	mu     sync.Mutex
+	got    []*rpc.Notification
+	closed bool
+}
+
+func (s *recordingSink) Publish(ctx context.Context, n *rpc.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.got = append(s.got, n)
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestNotificationSinks(t *testing.T) {
+	ctx := context.Background()
+	sink := &recordingSink{}
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Sinks:    []NotificationSink{sink},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: "my-project",
+		Project:   &rpc.Project{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateProject() error = %v", err)
+	}
+	server.Close()
+
+	if len(sink.got) != 1 {
+		t.Fatalf("Expected 1 notification, got %d", len(sink.got))
+	}
+	want := &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "projects/my-project", Sequence: 1}
+	opts := protocmp.IgnoreFields(want, "change_time")
+	if diff := cmp.Diff(want, sink.got[0], protocmp.Transform(), opts); diff != "" {
+		t.Errorf("Unexpected notification (-want +got):\n%s", diff)
+	}
+	if !sink.closed {
+		t.Errorf("Sink was not closed when the server was closed")
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/a"},
+		{Change: rpc.Notification_DELETED, Resource: "projects/b"},
+	}
+
+	// Publish from two sinks in turn to check that existing entries are kept.
+	for _, n := range want {
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatalf("NewFileSink(%q) returned error: %v", path, err)
+		}
+		if err := sink.Publish(ctx, n); err != nil {
+			t.Fatalf("Publish() returned error: %v", err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatalf("Close() returned error: %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	got := make([]*rpc.Notification, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n := &rpc.Notification{}
+		if err := protojson.Unmarshal(scanner.Bytes(), n); err != nil {
+			t.Fatalf("Failed to parse line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, n)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected file contents (-want +got):\n%s", diff)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	const secret = "shh"
+	tests := []struct {
+		desc     string
+		statuses []int
+		attempts int
+		wantErr  bool
+	}{
+		{
+			desc:     "success",
+			statuses: []int{http.StatusOK},
+			attempts: 1,
+		},
+		{
+			desc:     "retried",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNoContent},
+			attempts: 3,
+		},
+		{
+			desc:     "not retried",
+			statuses: []int{http.StatusBadRequest},
+			attempts: 1,
+			wantErr:  true,
+		},
+		{
+			desc:     "too many failures",
+			statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			attempts: 3,
+			wantErr:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			attempts := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				timestamp := r.Header.Get(WebhookTimestampHeader)
+				if got, want := r.Header.Get(WebhookSignatureHeader), WebhookSignature(secret, timestamp, body); got != want {
+					t.Errorf("Request signature is %q, want %q", got, want)
+				}
+				n := &rpc.Notification{}
+				if err := protojson.Unmarshal(body, n); err != nil || n.GetResource() != "projects/p" {
+					t.Errorf("Request body %q is not the expected notification: %v", body, err)
+				}
+				w.WriteHeader(test.statuses[attempts])
+				attempts++
+			}))
+			defer ts.Close()
+
+			sink, err := NewWebhookSink(WebhookConfig{
+				URL:            ts.URL,
+				Secret:         secret,
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("NewWebhookSink() returned error: %v", err)
+			}
+			defer sink.Close()
+
+			err = sink.Publish(context.Background(), &rpc.Notification{Change: rpc.Notification_UPDATED, Resource: "projects/p"})
+			if test.wantErr != (err != nil) {
+				t.Errorf("Publish() returned error %v, want error %t", err, test.wantErr)
+			}
+			if attempts != test.attempts {
+				t.Errorf("Publish() made %d attempts, want %d", attempts, test.attempts)
+			}
+		})
+	}
+}
+
+func TestWebhookSinkInvalidURL(t *testing.T) {
+	for _, u := range []string{"", "ftp://example.com", "://"} {
+		if _, err := NewWebhookSink(WebhookConfig{URL: u}); err == nil {
+			t.Errorf("NewWebhookSink(%q) succeeded, expected error", u)
+		}
+	}
+}
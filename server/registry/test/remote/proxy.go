@@ -17,6 +17,7 @@ package remote
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
 	"strings"
 
@@ -120,6 +121,20 @@ func (p *Proxy) MigrateDatabase(ctx context.Context, req *rpc.MigrateDatabaseReq
 	return p.adminClient.GrpcClient().MigrateDatabase(ctx, req)
 }
 
+func (p *Proxy) ReplayNotifications(ctx context.Context, req *rpc.ReplayNotificationsRequest) (*rpc.ReplayNotificationsResponse, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().ReplayNotifications(ctx, req)
+}
+
+func (p *Proxy) ListAuditEntries(ctx context.Context, req *rpc.ListAuditEntriesRequest) (*rpc.ListAuditEntriesResponse, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().ListAuditEntries(ctx, req)
+}
+
 // Projects
 
 func (p *Proxy) GetProject(ctx context.Context, req *rpc.GetProjectRequest) (*rpc.Project, error) {
@@ -161,6 +176,27 @@ func (p *Proxy) DeleteProject(ctx context.Context, req *rpc.DeleteProjectRequest
 	return p.adminClient.GrpcClient().DeleteProject(ctx, req)
 }
 
+func (p *Proxy) GetProjectQuota(ctx context.Context, req *rpc.GetProjectQuotaRequest) (*rpc.ProjectQuota, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().GetProjectQuota(ctx, req)
+}
+
+func (p *Proxy) ExportProject(ctx context.Context, req *rpc.ExportProjectRequest) (*longrunning.Operation, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().ExportProject(ctx, req)
+}
+
+func (p *Proxy) ImportProject(ctx context.Context, req *rpc.ImportProjectRequest) (*longrunning.Operation, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().ImportProject(ctx, req)
+}
+
 // Apis
 
 func (p *Proxy) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*rpc.Api, error) {
@@ -212,6 +248,24 @@ func (p *Proxy) UpdateApi(ctx context.Context, req *rpc.UpdateApiRequest) (*rpc.
 	return response, err
 }
 
+func (p *Proxy) BatchUpdateApis(ctx context.Context, req *rpc.BatchUpdateApisRequest) (*rpc.BatchUpdateApisResponse, error) {
+	req, _ = proto.Clone(req).(*rpc.BatchUpdateApisRequest)
+	req.Parent = p.hostedResourceName(req.Parent)
+	for _, r := range req.Requests {
+		if r.Api != nil {
+			r.Api.Name = p.hostedResourceName(r.Api.Name)
+		}
+	}
+	response, err := p.registryClient.GrpcClient().BatchUpdateApis(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, api := range response.Apis {
+		api.Name = p.testResourceName(api.Name)
+	}
+	return response, err
+}
+
 func (p *Proxy) DeleteApi(ctx context.Context, req *rpc.DeleteApiRequest) (*emptypb.Empty, error) {
 	req, _ = proto.Clone(req).(*rpc.DeleteApiRequest)
 	req.Name = p.hostedResourceName(req.Name)
@@ -288,6 +342,22 @@ func (p *Proxy) GetApiSpec(ctx context.Context, req *rpc.GetApiSpecRequest) (*rp
 	return response, err
 }
 
+func (p *Proxy) BatchGetApiSpecs(ctx context.Context, req *rpc.BatchGetApiSpecsRequest) (*rpc.BatchGetApiSpecsResponse, error) {
+	req, _ = proto.Clone(req).(*rpc.BatchGetApiSpecsRequest)
+	req.Parent = p.hostedResourceName(req.Parent)
+	for i, name := range req.Names {
+		req.Names[i] = p.hostedResourceName(name)
+	}
+	response, err := p.registryClient.GrpcClient().BatchGetApiSpecs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, spec := range response.ApiSpecs {
+		spec.Name = p.testResourceName(spec.Name)
+	}
+	return response, err
+}
+
 func (p *Proxy) ListApiSpecs(ctx context.Context, req *rpc.ListApiSpecsRequest) (*rpc.ListApiSpecsResponse, error) {
 	req, _ = proto.Clone(req).(*rpc.ListApiSpecsRequest)
 	req.Parent = p.hostedResourceName(req.Parent)
@@ -517,6 +587,22 @@ func (p *Proxy) CreateArtifact(ctx context.Context, req *rpc.CreateArtifactReque
 	return response, err
 }
 
+func (p *Proxy) BatchCreateArtifacts(ctx context.Context, req *rpc.BatchCreateArtifactsRequest) (*rpc.BatchCreateArtifactsResponse, error) {
+	req, _ = proto.Clone(req).(*rpc.BatchCreateArtifactsRequest)
+	req.Parent = p.hostedResourceName(req.Parent)
+	for _, r := range req.Requests {
+		r.Parent = p.hostedResourceName(r.Parent)
+	}
+	response, err := p.registryClient.GrpcClient().BatchCreateArtifacts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, artifact := range response.Artifacts {
+		artifact.Name = p.testResourceName(artifact.Name)
+	}
+	return response, err
+}
+
 func (p *Proxy) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceArtifactRequest) (*rpc.Artifact, error) {
 	req, _ = proto.Clone(req).(*rpc.ReplaceArtifactRequest)
 	if req.Artifact != nil {
@@ -542,8 +628,142 @@ func (p *Proxy) GetArtifactContents(ctx context.Context, req *rpc.GetArtifactCon
 	return p.registryClient.GrpcClient().GetArtifactContents(ctx, req)
 }
 
+func (p *Proxy) WatchResources(req *rpc.WatchResourcesRequest, stream rpc.Registry_WatchResourcesServer) error {
+	req, _ = proto.Clone(req).(*rpc.WatchResourcesRequest)
+	req.Parent = p.hostedResourceName(req.Parent)
+	req.Filter = p.hostedFilter(req.Filter)
+	client, err := p.registryClient.GrpcClient().WatchResources(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		n, err := client.Recv()
+		if err != nil {
+			return err
+		}
+		n.Resource = p.testResourceName(n.Resource)
+		if err := stream.Send(n); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *Proxy) SearchResources(ctx context.Context, req *rpc.SearchResourcesRequest) (*rpc.SearchResourcesResponse, error) {
+	req, _ = proto.Clone(req).(*rpc.SearchResourcesRequest)
+	req.Parent = p.hostedResourceName(req.Parent)
+	response, err := p.registryClient.GrpcClient().SearchResources(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range response.Results {
+		result.Name = p.testResourceName(result.Name)
+	}
+	return response, err
+}
+
+func (p *Proxy) PruneApiRevisions(ctx context.Context, req *rpc.PruneApiRevisionsRequest) (*rpc.PruneApiRevisionsResponse, error) {
+	req, _ = proto.Clone(req).(*rpc.PruneApiRevisionsRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().PruneApiRevisions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range response.RevisionNames {
+		response.RevisionNames[i] = p.testResourceName(name)
+	}
+	return response, err
+}
+
+func (p *Proxy) UploadApiSpecContents(stream rpc.Registry_UploadApiSpecContentsServer) error {
+	client, err := p.registryClient.GrpcClient().UploadApiSpecContents(stream.Context())
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if req.ApiSpec != nil {
+			req.ApiSpec.Name = p.hostedResourceName(req.ApiSpec.Name)
+		}
+		if err := client.Send(req); err != nil {
+			return err
+		}
+	}
+	response, err := client.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return stream.SendAndClose(response)
+}
+
+func (p *Proxy) StreamApiSpecContents(req *rpc.GetApiSpecContentsRequest, stream rpc.Registry_StreamApiSpecContentsServer) error {
+	req, _ = proto.Clone(req).(*rpc.GetApiSpecContentsRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	client, err := p.registryClient.GrpcClient().StreamApiSpecContents(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return proxyContents(client.Recv, stream.Send)
+}
+
+func (p *Proxy) UploadArtifactContents(stream rpc.Registry_UploadArtifactContentsServer) error {
+	client, err := p.registryClient.GrpcClient().UploadArtifactContents(stream.Context())
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if req.Artifact != nil {
+			req.Artifact.Name = p.hostedResourceName(req.Artifact.Name)
+		}
+		if err := client.Send(req); err != nil {
+			return err
+		}
+	}
+	response, err := client.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return stream.SendAndClose(response)
+}
+
+func (p *Proxy) StreamArtifactContents(req *rpc.GetArtifactContentsRequest, stream rpc.Registry_StreamArtifactContentsServer) error {
+	req, _ = proto.Clone(req).(*rpc.GetArtifactContentsRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	client, err := p.registryClient.GrpcClient().StreamArtifactContents(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return proxyContents(client.Recv, stream.Send)
+}
+
 // helpers
 
+func proxyContents(recv func() (*rpc.ContentsChunk, error), send func(*rpc.ContentsChunk) error) error {
+	for {
+		chunk, err := recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
 func (p *Proxy) hostedResourceName(name string) string {
 	if p.projectID != "" {
 		return strings.Replace(name, "projects/my-project", "projects/"+p.projectID, 1)
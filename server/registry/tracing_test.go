@@ -0,0 +1,125 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/apigee/registry/pkg/tracing"
+	"github.com/apigee/registry/rpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// recordSpans installs a global tracer provider that records ended spans
+// until the test ends.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	if _, err := tracing.Start(context.Background(), tracing.Config{}); err != nil {
+		t.Fatalf("Start() returned error: %s", err)
+	}
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestTracing(t *testing.T) {
+	recorder := recordSpans(t)
+	server := serverWithSinks(t)
+	l, s, err := server.ServeGRPC(&net.TCPAddr{IP: net.ParseIP("127.0.0.1")},
+		grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	if err != nil {
+		t.Fatalf("ServeGRPC() returned error: %s", err)
+	}
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial(l.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	if err != nil {
+		t.Fatalf("Dial() returned error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, root := tracing.Tracer().Start(context.Background(), "test")
+	_, err = rpc.NewAdminClient(conn).CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: "my-project",
+		Project:   &rpc.Project{},
+	})
+	root.End()
+	if err != nil {
+		t.Fatalf("CreateProject() returned error: %s", err)
+	}
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID() != root.SpanContext().TraceID() {
+			t.Errorf("span %q has trace %s, want %s", span.Name(), span.SpanContext().TraceID(), root.SpanContext().TraceID())
+		}
+		if _, ok := spans[span.Name()]; !ok {
+			spans[span.Name()] = span
+		}
+	}
+	// Each span is a child of the previous one.
+	parent := root.SpanContext().SpanID()
+	for _, name := range []string{
+		"google.cloud.apigeeregistry.v1.Admin/CreateProject", // client
+		"google.cloud.apigeeregistry.v1.Admin/CreateProject", // server
+		"storage.Transaction",
+		"storage.create",
+	} {
+		var span sdktrace.ReadOnlySpan
+		for _, s := range recorder.Ended() {
+			if s.Name() == name && s.Parent().SpanID() == parent {
+				span = s
+			}
+		}
+		if span == nil {
+			t.Fatalf("no %q span is a child of %s, recorded %v", name, parent, spanNames(recorder.Ended()))
+		}
+		parent = span.SpanContext().SpanID()
+	}
+	attributes := map[string]string{}
+	for _, kv := range spans["storage.create"].Attributes() {
+		attributes[string(kv.Key)] = kv.Value.Emit()
+	}
+	if attributes["db.system"] != "sqlite" || attributes["db.sql.table"] != "projects" {
+		t.Errorf("storage.create has attributes %v, want db.system sqlite and db.sql.table projects", attributes)
+	}
+}
+
+func TestTracingUntracedCalls(t *testing.T) {
+	recorder := recordSpans(t)
+	server := serverWithSinks(t)
+	createProjects(t, server, "my-project")
+	if spans := recorder.Ended(); len(spans) != 0 {
+		t.Errorf("calls without spans recorded %v, want none", spanNames(spans))
+	}
+}
+
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	var n []string
+	for _, s := range spans {
+		n = append(n, s.Name())
+	}
+	return n
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchBufferSize is the number of notifications that can be queued for a
+// watcher before it is considered to have fallen behind.
+const watchBufferSize = 256
+
+var watchFields = map[string]filtering.FieldType{
+	"change":   filtering.String,
+	"resource": filtering.String,
+}
+
+// WatchResources handler method.
+func (s *RegistryServer) WatchResources(req *rpc.WatchResourcesRequest, stream rpc.Registry_WatchResourcesServer) error {
+	ctx := stream.Context()
+	w, err := newWatcher(req.GetParent(), req.GetFilter())
+	if err != nil {
+		return err
+	}
+	s.watchers.add(w)
+	defer s.watchers.remove(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.done:
+			return w.err
+		case n := <-w.events:
+			if err := stream.Send(n); err != nil {
+				log.FromContext(ctx).WithError(err).Debug("Failed to send notification to watcher.")
+				return err
+			}
+		}
+	}
+}
+
+// watcher is a single WatchResources subscription.
+type watcher struct {
+	parent []string
+	filter filtering.Filter
+	events chan *rpc.Notification
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+func newWatcher(parent, filter string) (*watcher, error) {
+	segments := strings.Split(parent, "/")
+	if len(segments) < 2 || len(segments)%2 != 0 || segments[0] != "projects" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent %q", parent)
+	}
+	for _, s := range segments {
+		if s == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent %q", parent)
+		}
+	}
+	f, err := filtering.NewFilter(filter, watchFields)
+	if err != nil {
+		return nil, err
+	}
+	return &watcher{
+		parent: segments,
+		filter: f,
+		events: make(chan *rpc.Notification, watchBufferSize),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// matches returns true if the notification should be sent to the watcher.
+func (w *watcher) matches(n *rpc.Notification) bool {
+	segments := strings.Split(n.GetResource(), "/")
+	if len(segments) < len(w.parent) {
+		return false
+	}
+	for i, p := range w.parent {
+		if p == "-" || p == segments[i] {
+			continue
+		}
+		// A parent without a revision matches all revisions of a resource.
+		if strings.HasPrefix(segments[i], p+"@") && !strings.Contains(p, "@") {
+			continue
+		}
+		return false
+	}
+	match, err := w.filter.Matches(map[string]interface{}{
+		"change":   n.GetChange().String(),
+		"resource": n.GetResource(),
+	})
+	return err == nil && match
+}
+
+// close ends the subscription, causing WatchResources to return err.
+func (w *watcher) close(err error) {
+	w.once.Do(func() {
+		w.err = err
+		close(w.done)
+	})
+}
+
+// watchers holds the active WatchResources subscriptions of a server.
+// Its zero value is ready to use.
+type watchers struct {
+	mu  sync.Mutex
+	set map[*watcher]bool
+}
+
+func (ws *watchers) add(w *watcher) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.set == nil {
+		ws.set = make(map[*watcher]bool)
+	}
+	ws.set[w] = true
+}
+
+func (ws *watchers) remove(w *watcher) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	delete(ws.set, w)
+}
+
+// active returns true if there are any subscriptions.
+func (ws *watchers) active() bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return len(ws.set) > 0
+}
+
+// publish delivers a notification to all matching watchers without blocking.
+// Watchers that have fallen too far behind are closed with an error so that
+// clients know to resynchronize.
+func (ws *watchers) publish(n *rpc.Notification) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for w := range ws.set {
+		if !w.matches(n) {
+			continue
+		}
+		select {
+		case w.events <- n:
+		default:
+			w.close(status.Error(codes.ResourceExhausted, "watcher fell behind, notifications were dropped"))
+			delete(ws.set, w)
+		}
+	}
+}
+
+// closeAll ends all subscriptions, typically when the server is shutting down.
+func (ws *watchers) closeAll() {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for w := range ws.set {
+		w.close(status.Error(codes.Unavailable, "server is shutting down"))
+		delete(ws.set, w)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream is a fake Registry_WatchResourcesServer that records notifications.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *rpc.Notification
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(n *rpc.Notification) error {
+	w.events <- n
+	return nil
+}
+
+func (ws *watchers) count() int {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return len(ws.set)
+}
+
+// startWatch calls WatchResources in the background and waits until the watcher is registered.
+func startWatch(t *testing.T, server *RegistryServer, req *rpc.WatchResourcesRequest) (*watchStream, <-chan error, context.CancelFunc) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, events: make(chan *rpc.Notification, 100)}
+	before := server.watchers.count()
+	result := make(chan error, 1)
+	go func() {
+		result <- server.WatchResources(req, stream)
+	}()
+	for deadline := time.Now().Add(5 * time.Second); server.watchers.count() == before; {
+		if time.Now().After(deadline) {
+			cancel()
+			t.Fatal("Timed out waiting for watcher to be registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return stream, result, cancel
+}
+
+func TestWatchResources(t *testing.T) {
+	ctx := context.Background()
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: "my-project",
+		Project:   &rpc.Project{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateProject() error = %v", err)
+	}
+
+	stream, result, cancel := startWatch(t, server, &rpc.WatchResourcesRequest{
+		Parent: "projects/my-project/locations/-/apis/a",
+		Filter: "change != 'UPDATED'",
+	})
+
+	parent := "projects/my-project/locations/global"
+	for _, id := range []string{"a", "b"} {
+		if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{Parent: parent, ApiId: id, Api: &rpc.Api{}}); err != nil {
+			t.Fatalf("Setup: CreateApi(%q) error = %v", id, err)
+		}
+	}
+	if _, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{Parent: parent + "/apis/a", ApiVersionId: "v1", ApiVersion: &rpc.ApiVersion{}}); err != nil {
+		t.Fatalf("Setup: CreateApiVersion() error = %v", err)
+	}
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{Api: &rpc.Api{Name: parent + "/apis/a", DisplayName: "A"}}); err != nil {
+		t.Fatalf("Setup: UpdateApi() error = %v", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: parent + "/apis/a", Force: true}); err != nil {
+		t.Fatalf("Setup: DeleteApi() error = %v", err)
+	}
+
+	want := []string{
+		"CREATED " + parent + "/apis/a",
+		"CREATED " + parent + "/apis/a/versions/v1",
+		"DELETED " + parent + "/apis/a",
+	}
+	got := make([]string, 0, len(want))
+	for range want {
+		select {
+		case n := <-stream.events:
+			if n.GetChangeTime() == nil {
+				t.Errorf("Notification for %q is missing change_time", n.GetResource())
+			}
+			got = append(got, n.GetChange().String()+" "+n.GetResource())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for notifications, got %v", got)
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WatchResources returned unexpected notifications (-want +got):\n%s", diff)
+	}
+
+	cancel()
+	if err := <-result; err != nil {
+		t.Errorf("WatchResources() returned error %v after cancellation", err)
+	}
+	if n := server.watchers.count(); n != 0 {
+		t.Errorf("Server has %d watchers after cancellation, expected none", n)
+	}
+}
+
+func TestWatchResourcesShutdown(t *testing.T) {
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, result, cancel := startWatch(t, server, &rpc.WatchResourcesRequest{Parent: "projects/-"})
+	defer cancel()
+
+	server.Close()
+	if err := <-result; status.Code(err) != codes.Unavailable {
+		t.Errorf("WatchResources() returned status code %s after Close(), want %s", status.Code(err), codes.Unavailable)
+	}
+}
+
+func TestWatchResourcesErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		req  *rpc.WatchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "missing parent",
+			req:  &rpc.WatchResourcesRequest{},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid parent",
+			req:  &rpc.WatchResourcesRequest{Parent: "projects/my-project/locations"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "empty parent segment",
+			req:  &rpc.WatchResourcesRequest{Parent: "projects//locations/global"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid filter",
+			req:  &rpc.WatchResourcesRequest{Parent: "projects/my-project", Filter: "invalid"},
+			want: codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := &RegistryServer{}
+			stream := &watchStream{ctx: context.Background()}
+			if err := server.WatchResources(test.req, stream); status.Code(err) != test.want {
+				t.Errorf("WatchResources(%+v) returned status code %s, want %s: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestWatcherMatches(t *testing.T) {
+	tests := []struct {
+		parent   string
+		resource string
+		want     bool
+	}{
+		{"projects/p", "projects/p", true},
+		{"projects/p", "projects/q", false},
+		{"projects/-", "projects/q", true},
+		{"projects/p", "projects/p/locations/global/apis/a", true},
+		{"projects/p/locations/global/apis/a", "projects/p", false},
+		{"projects/p/locations/global/apis/a", "projects/p/locations/global/apis/ab", false},
+		{"projects/p/locations/global/apis/-/versions/v1", "projects/p/locations/global/apis/b/versions/v1/specs/s", true},
+		{"projects/p/locations/global/apis/a/versions/v1/specs/s", "projects/p/locations/global/apis/a/versions/v1/specs/s@1234", true},
+		{"projects/p/locations/global/apis/a/versions/v1/specs/s@1234", "projects/p/locations/global/apis/a/versions/v1/specs/s@5678", false},
+	}
+	for _, test := range tests {
+		t.Run(test.parent+" "+test.resource, func(t *testing.T) {
+			w, err := newWatcher(test.parent, "")
+			if err != nil {
+				t.Fatalf("newWatcher(%q) returned error: %v", test.parent, err)
+			}
+			n := &rpc.Notification{Change: rpc.Notification_CREATED, Resource: test.resource}
+			if got := w.matches(n); got != test.want {
+				t.Errorf("matches(%q) = %t, want %t", test.resource, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWatcherFallsBehind(t *testing.T) {
+	var ws watchers
+	w, err := newWatcher("projects/p", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws.add(w)
+	for i := 0; i <= watchBufferSize; i++ {
+		ws.publish(&rpc.Notification{Change: rpc.Notification_UPDATED, Resource: "projects/p"})
+	}
+	select {
+	case <-w.done:
+		if status.Code(w.err) != codes.ResourceExhausted {
+			t.Errorf("Watcher closed with status code %s, want %s", status.Code(w.err), codes.ResourceExhausted)
+		}
+	default:
+		t.Errorf("Watcher was not closed after falling behind")
+	}
+	if n := ws.count(); n != 0 {
+		t.Errorf("Watcher was not removed after falling behind, %d remain", n)
+	}
+}
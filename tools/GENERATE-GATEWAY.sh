@@ -0,0 +1,26 @@
+#!/bin/bash
+#
+# Copyright 2023 Google LLC.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+
+set -e
+
+. tools/PROTOS.sh
+clone_common_protos
+
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@v2.19.1
+
+echo "Generating Go REST/JSON gateway for ${GATEWAY_PROTOS[@]}"
+protoc ${GATEWAY_PROTOS[*]} --proto_path='.' --proto_path=$COMMON_PROTOS_PATH --grpc-gateway_opt='module=github.com/apigee/registry' --grpc-gateway_out='.'
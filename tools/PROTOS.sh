@@ -33,6 +33,11 @@ SERVICE_PROTOS=(
 	google/cloud/apigeeregistry/v1/provisioning_service.proto
 )
 
+GATEWAY_PROTOS=(
+	google/cloud/apigeeregistry/v1/registry_service.proto
+	google/cloud/apigeeregistry/v1/admin_service.proto
+)
+
 HOSTED_PROTOS=(
 	google/cloud/apigeeregistry/v1/registry_models.proto
 	google/cloud/apigeeregistry/v1/registry_service.proto